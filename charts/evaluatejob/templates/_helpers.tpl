@@ -30,3 +30,11 @@ Create chart name and version as used by the chart label.
 {{- define "evaluatejob.chart" -}}
 {{- printf "%s-%s" .Chart.Name .Chart.Version | replace "+" "_" | trunc 63 | trimSuffix "-" -}}
 {{- end -}}
+
+{{/*
+Copy the metrics file at METRICS_PATH to the termination message of the container after the command succeeds,
+so that the metrics can be read from the status of the pod. If METRICS_PATH is a directory, metrics.json in it is copied.
+*/}}
+{{- define "evaluatejob.saveMetrics" -}}
+{ f="$METRICS_PATH"; if [ -d "$f" ]; then f="$f/metrics.json"; fi; if [ -f "$f" ]; then head -c 4096 "$f" > /dev/termination-log; fi; }
+{{- end -}}
@@ -0,0 +1,201 @@
+{{/* vim: set filetype=mustache: */}}
+{{/*
+Expand the name of the chart.
+*/}}
+{{- define "rayjob.name" -}}
+{{- default .Chart.Name .Values.nameOverride | trunc 63 | trimSuffix "-" -}}
+{{- end -}}
+
+{{/*
+Create a default fully qualified app name.
+We truncate at 63 chars because some Kubernetes name fields are limited to this (by the DNS naming spec).
+If release name contains chart name it will be used as a full name.
+*/}}
+{{- define "rayjob.fullname" -}}
+{{- if .Values.fullnameOverride -}}
+{{- .Values.fullnameOverride | trunc 63 | trimSuffix "-" -}}
+{{- else -}}
+{{- $name := default .Chart.Name .Values.nameOverride -}}
+{{- if contains $name .Release.Name -}}
+{{- .Release.Name | trunc 63 | trimSuffix "-" -}}
+{{- else -}}
+{{- printf "%s-%s" .Release.Name $name | trunc 63 | trimSuffix "-" -}}
+{{- end -}}
+{{- end -}}
+{{- end -}}
+
+{{/*
+Create chart name and version as used by the chart label.
+*/}}
+{{- define "rayjob.chart" -}}
+{{- printf "%s-%s" .Chart.Name .Chart.Version | replace "+" "_" | trunc 63 | trimSuffix "-" -}}
+{{- end -}}
+
+{{/*
+Create the labels of the pods of rayjob.
+*/}}
+{{- define "rayjob.podLabels" -}}
+app: {{ template "rayjob.name" . }}
+chart: {{ template "rayjob.chart" . }}
+release: {{ .Release.Name }}
+heritage: {{ .Release.Service }}
+createdBy: "RayJob"
+{{- range $key, $value := .Values.labels }}
+{{ $key }}: {{ $value | quote }}
+{{- end }}
+{{- end -}}
+
+{{/*
+Create the common pod spec of the ray head and workers, excluding containers.
+*/}}
+{{- define "rayjob.podSpec" -}}
+{{- if ne (len .Values.nodeSelectors) 0 }}
+nodeSelector:
+{{- range $nodeKey,$nodeVal := .Values.nodeSelectors }}
+  {{ $nodeKey }}: "{{ $nodeVal }}"
+{{- end }}
+{{- end }}
+{{- if ne (len .Values.tolerations) 0 }}
+tolerations:
+{{- range $tolerationKey := .Values.tolerations }}
+- {{- if $tolerationKey.key }}
+  key: "{{ $tolerationKey.key }}"
+  {{- end }}
+  {{- if $tolerationKey.value }}
+  value: "{{ $tolerationKey.value }}"
+  {{- end }}
+  {{- if $tolerationKey.effect }}
+  effect: "{{ $tolerationKey.effect }}"
+  {{- end }}
+  {{- if $tolerationKey.operator }}
+  operator: "{{ $tolerationKey.operator }}"
+  {{- end }}
+{{- end }}
+{{- end }}
+{{- if .Values.schedulerName }}
+schedulerName: {{ .Values.schedulerName }}
+{{- end }}
+{{- if .Values.priorityClassName }}
+priorityClassName: {{ .Values.priorityClassName }}
+{{- end }}
+{{- if .Values.useHostNetwork }}
+hostNetwork: {{ .Values.useHostNetwork }}
+dnsPolicy: ClusterFirstWithHostNet
+{{- end }}
+{{- if .Values.useHostPID }}
+hostPID: {{ .Values.useHostPID }}
+{{- end }}
+{{- if .Values.useHostIPC }}
+hostIPC: {{ .Values.useHostIPC }}
+{{- end }}
+{{- if .Values.enablePodSecurityContext }}
+{{- if .Values.isNonRoot }}
+securityContext:
+  runAsUser: {{ .Values.podSecurityContext.runAsUser }}
+  runAsGroup: {{ .Values.podSecurityContext.runAsGroup }}
+  runAsNonRoot: {{ .Values.podSecurityContext.runAsNonRoot }}
+  supplementalGroups:
+  {{- range $group := .Values.podSecurityContext.supplementalGroups }}
+  - {{ $group }}
+  {{- end }}
+{{- end }}
+{{- end }}
+{{- if ne (len .Values.imagePullSecrets) 0 }}
+imagePullSecrets:
+{{- range $imagePullSecret := .Values.imagePullSecrets }}
+- name: "{{ $imagePullSecret }}"
+{{- end }}
+{{- end }}
+volumes:
+{{- if ne (len .Values.configFiles) 0 }}
+{{- $releaseName := .Release.Name }}
+{{- range $containerPathKey,$configFileInfos := .Values.configFiles }}
+- name: {{ $containerPathKey }}
+  configMap:
+    name: {{ $releaseName }}-{{ $containerPathKey }}
+{{- end }}
+{{- end }}
+{{- if .Values.dataset }}
+{{- range $pvcName, $destPath := .Values.dataset }}
+- name: "{{ $pvcName }}"
+  persistentVolumeClaim:
+    claimName: "{{ $pvcName }}"
+{{- end }}
+{{- end }}
+{{- if .Values.dataDirs }}
+{{- range .Values.dataDirs }}
+- hostPath:
+    path: {{ .hostPath }}
+  name: {{ .name }}
+{{- end }}
+{{- end }}
+{{- if .Values.shmSize }}
+- name: dshm
+  emptyDir:
+    medium: Memory
+    sizeLimit: {{ .Values.shmSize }}
+{{- end }}
+{{- end -}}
+
+{{/*
+Create the envs and volume mounts of the containers of the ray head and workers.
+*/}}
+{{- define "rayjob.containerEnvsAndMounts" -}}
+env:
+{{- range $key, $value := .Values.envs }}
+- name: "{{ $key }}"
+  value: "{{ $value }}"
+{{- end }}
+{{- if .Values.privileged }}
+securityContext:
+  privileged: true
+{{- end }}
+volumeMounts:
+{{- if ne (len .Values.configFiles) 0 }}
+{{- range $containerPathKey,$configFileInfos := .Values.configFiles }}
+{{- $visit := "false" }}
+{{- range $cofigFileKey,$configFileInfo := $configFileInfos }}
+{{- if eq "false" $visit }}
+- mountPath: {{ $configFileInfo.containerFilePath }}
+  name: {{ $containerPathKey }}
+{{- $visit = "true" }}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- if .Values.dataset }}
+{{- range $pvcName, $destPath := .Values.dataset }}
+- name: "{{ $pvcName }}"
+  mountPath: "{{ $destPath }}"
+{{- end }}
+{{- end }}
+{{- if .Values.dataDirs }}
+{{- range .Values.dataDirs }}
+- mountPath: {{ .containerPath }}
+  name: {{ .name }}
+{{- end }}
+{{- end }}
+{{- if .Values.shmSize }}
+- mountPath: /dev/shm
+  name: dshm
+{{- end }}
+{{- end -}}
+
+{{/*
+Create the resources of a ray container with the given cpu, memory and gpu count.
+*/}}
+{{- define "rayjob.resources" -}}
+{{- $resources := dict -}}
+{{- if .cpu }}{{- $_ := set $resources "cpu" (.cpu | toString) }}{{- end }}
+{{- if .memory }}{{- $_ := set $resources "memory" (.memory | toString) }}{{- end }}
+{{- if gt (int .gpuCount) 0 }}{{- $_ := set $resources "nvidia.com/gpu" (.gpuCount | toString) }}{{- end }}
+resources:
+  limits:
+{{- range $key, $value := $resources }}
+    {{ $key }}: {{ $value | quote }}
+{{- end }}
+  requests:
+{{- range $key, $value := $resources }}
+    {{ $key }}: {{ $value | quote }}
+{{- end }}
+{{- end -}}
@@ -15,6 +15,7 @@
 package main
 
 import (
+	"errors"
 	"os"
 	"runtime"
 	"runtime/pprof"
@@ -25,6 +26,7 @@ import (
 
 	"github.com/kubeflow/arena/pkg/apis/utils"
 	"github.com/kubeflow/arena/pkg/commands"
+	"github.com/kubeflow/arena/pkg/util"
 )
 
 func main() {
@@ -62,6 +64,10 @@ func main() {
 
 	if err := commands.NewCommand().Execute(); err != nil {
 		utils.PrintErrorMessage(err.Error())
+		var exitErr *util.ExitError
+		if errors.As(err, &exitErr) {
+			os.Exit(exitErr.Code)
+		}
 		os.Exit(1)
 	}
 }
@@ -1,9 +1,10 @@
 package arenaclient
 
 import (
-	"errors"
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
@@ -11,46 +12,67 @@ import (
 
 	"github.com/kubeflow/arena/pkg/apis/config"
 	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/evaluate"
 	"github.com/kubeflow/arena/pkg/k8saccesser"
 	"github.com/kubeflow/arena/pkg/model"
+	"github.com/kubeflow/arena/pkg/model/lineage"
 )
 
+const (
+	// modelRegistryConfigKey selects the model registry in the arena configuration file,mlflow is used if not set
+	modelRegistryConfigKey = "model_registry"
+	// ociModelRegistryAddressConfigKey is the address of the oci registry,like: https://registry.example.com
+	ociModelRegistryAddressConfigKey = "model_registry_oci_address"
+	// ociModelRegistryRepositoryPrefixConfigKey is the prefix of the repositories which store the models
+	ociModelRegistryRepositoryPrefixConfigKey = "model_registry_oci_repository_prefix"
+	// ociModelRegistryUsernameConfigKey and ociModelRegistryPasswordConfigKey are the credentials of the oci registry,
+	// they can be overridden by the env OCI_REGISTRY_USERNAME and OCI_REGISTRY_PASSWORD
+	ociModelRegistryUsernameConfigKey = "model_registry_oci_username"
+	ociModelRegistryPasswordConfigKey = "model_registry_oci_password"
+
+	// modelArtifactUriPrefixConfigKey is the prefix of the uris which the models are pushed to,
+	// like s3://my-bucket/models,mlflow-artifacts:/models is used if not set with the mlflow model registry
+	modelArtifactUriPrefixConfigKey = "model_artifact_uri_prefix"
+	// modelArtifactS3EndpointConfigKey is the endpoint of the s3 compatible storage,like https://minio.example.com,
+	// aws s3 is used if not set
+	modelArtifactS3EndpointConfigKey = "model_artifact_s3_endpoint"
+	modelArtifactS3RegionConfigKey   = "model_artifact_s3_region"
+	// modelArtifactS3AccessKeyIdConfigKey and modelArtifactS3SecretAccessKeyConfigKey are the credentials of the s3 storage,
+	// they can be overridden by the env AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY
+	modelArtifactS3AccessKeyIdConfigKey     = "model_artifact_s3_access_key_id"
+	modelArtifactS3SecretAccessKeyConfigKey = "model_artifact_s3_secret_access_key"
+)
+
+// ModelClient embeds the model registry selected by the arena configuration file,
+// it embedded model.MlflowClient before the model registry is configurable,use MlflowClient() to get it
 type ModelClient struct {
 	namespace string
 	configr   *config.ArenaConfiger
 
-	model.MlflowClient
+	model.ModelRegistry
 }
 
 func NewModelClient(namespace string, configer *config.ArenaConfiger) (*ModelClient, error) {
-	trackingUri := os.Getenv("MLFLOW_TRACKING_URI")
-	username := os.Getenv("MLFLOW_TRACKING_USERNAME")
-	password := os.Getenv("MLFLOW_TRACKING_PASSWORD")
-
-	var mlflowClient *model.MlflowClient
-	if trackingUri != "" {
-		// Construct a non-proxied MLflow client if `MLFLOW_TRACKING_URI` is specified
-		mlflowClient = model.NewMlflowClient(trackingUri, username, password)
-	} else {
-		// Construct a MLflow client proxied by api server
-		mlflowServices, err := listMlflowServices()
-		if err != nil {
-			return nil, fmt.Errorf("failed to create proxied model client: %v", err)
-		}
-		if len(mlflowServices) == 0 {
-			return nil, fmt.Errorf("failed to create proxied model client: no mlflow service in any namespace found")
-		}
-		mlflowService := mlflowServices[0].DeepCopy()
-		if len(mlflowServices) > 1 {
-			log.Warnf("there are multiple mlflow services found, use %s/%s", mlflowService.ObjectMeta.Namespace, mlflowService.ObjectMeta.Name)
-		}
-		mlflowClient = model.NewProxiedMlflowClient(configer, mlflowService, username, password)
+	configs := configer.GetConfigsFromConfigFile()
+	var registry model.ModelRegistry
+	var err error
+	registryType := types.ModelRegistryType(configs[modelRegistryConfigKey])
+	switch registryType {
+	case "", types.MlflowModelRegistry:
+		registry, err = newMlflowModelRegistry(configer)
+	case types.OCIModelRegistry:
+		registry, err = newOCIModelRegistry(configs)
+	default:
+		err = fmt.Errorf("unknown model registry %v,only support: [%v,%v]", registryType, types.MlflowModelRegistry, types.OCIModelRegistry)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create model client: %v", err)
 	}
 
 	modelClient := &ModelClient{
-		namespace:    namespace,
-		configr:      configer,
-		MlflowClient: *mlflowClient,
+		namespace:     namespace,
+		configr:       configer,
+		ModelRegistry: registry,
 	}
 
 	health, err := modelClient.CheckHealth()
@@ -58,11 +80,239 @@ func NewModelClient(namespace string, configer *config.ArenaConfiger) (*ModelCli
 		return nil, fmt.Errorf("failed to create model client: %v", err)
 	}
 	if !health {
-		return nil, errors.New("failed to create model client: mlflow tracking server is not healthy")
+		return nil, fmt.Errorf("failed to create model client: %v model registry is not healthy", registry.Type())
 	}
 	return modelClient, nil
 }
 
+// MlflowClient returns the mlflow client of the model registry,it is kept for the callers of the embedded
+// model.MlflowClient,nil is returned if the model registry is not mlflow.
+//
+// Deprecated: use the methods of model.ModelRegistry instead.
+func (m *ModelClient) MlflowClient() *model.MlflowClient {
+	mlflowClient, _ := m.ModelRegistry.(*model.MlflowClient)
+	return mlflowClient
+}
+
+// Promote moves the alias to the model version,if the evaluate job is given,the promotion is gated on
+// its metrics meeting the thresholds. It returns the version which the alias pointed to before,empty if none.
+func (m *ModelClient) Promote(args *types.ModelPromoteArgs) (string, error) {
+	thresholds, err := model.ParseMetricThresholds(args.Thresholds)
+	if err != nil {
+		return "", err
+	}
+	if len(thresholds) != 0 && args.EvaluateJob == "" {
+		return "", fmt.Errorf("--evaluate-job must be specified when --threshold is set")
+	}
+	if _, err := m.GetModelVersion(args.Name, args.Version); err != nil {
+		return "", err
+	}
+	if args.EvaluateJob != "" {
+		job, err := evaluate.GetEvaluateJob(args.EvaluateJob, m.namespace)
+		if err != nil {
+			return "", fmt.Errorf("failed to get the evaluate job %v: %v", args.EvaluateJob, err)
+		}
+		if (job.ModelName != "" && job.ModelName != args.Name) || (job.ModelVersion != "" && job.ModelVersion != args.Version) {
+			return "", fmt.Errorf("the evaluate job %v evaluates the model version %v/%v,not %v/%v", args.EvaluateJob, job.ModelName, job.ModelVersion, args.Name, args.Version)
+		}
+		metrics, err := evaluate.GetEvaluateJobMetrics(args.EvaluateJob, m.namespace)
+		if err != nil {
+			return "", err
+		}
+		if err := model.CheckMetricThresholds(metrics, thresholds); err != nil {
+			return "", fmt.Errorf("the model version %v/%v is not promoted to %v: %v", args.Name, args.Version, args.Alias, err)
+		}
+	}
+	aliases, err := m.ListRegisteredModelAliases(args.Name)
+	if err != nil {
+		return "", err
+	}
+	previous := ""
+	for _, alias := range aliases {
+		if alias.Alias == args.Alias {
+			previous = alias.Version
+		}
+	}
+	return previous, m.SetRegisteredModelAlias(args.Name, args.Version, args.Alias)
+}
+
+// ListRegisteredModelAliases returns the aliases of the registered model
+func (m *ModelClient) ListRegisteredModelAliases(name string) ([]*types.RegisteredModelAlias, error) {
+	registeredModel, err := m.GetRegisteredModel(name)
+	if err != nil {
+		return nil, err
+	}
+	return registeredModel.Aliases, nil
+}
+
+// DiffModelVersions returns the differences of the description,source and tags between two model versions
+func (m *ModelClient) DiffModelVersions(name, from, to string) ([]*types.ModelVersionDiff, error) {
+	fromVersion, err := m.GetModelVersion(name, from)
+	if err != nil {
+		return nil, err
+	}
+	toVersion, err := m.GetModelVersion(name, to)
+	if err != nil {
+		return nil, err
+	}
+	return model.DiffModelVersions(fromVersion, toVersion), nil
+}
+
+// Lineage returns the jobs which produced,evaluated,analyzed and served the versions of the model,
+// the registered versions without jobs are included. If version is not empty,only the version is returned.
+func (m *ModelClient) Lineage(name, version string, allNamespaces bool) (*types.ModelLineage, error) {
+	modelLineage := lineage.BuildModelLineage(name, version, m.namespace, allNamespaces)
+	registered, err := m.registeredModelVersions(name, version)
+	if err != nil {
+		// the jobs are still useful when the model registry is unavailable
+		log.Warnf("failed to get the versions of model %v: %v", name, err)
+		return modelLineage, nil
+	}
+	if version != "" && len(registered) == 0 && len(modelLineage.Versions) == 0 {
+		return nil, fmt.Errorf("%v: model version %v/%v", model.RESOURCE_DOES_NOT_EXIST_ERROR, name, version)
+	}
+	lineage.AddModelVersions(modelLineage, registered)
+	return modelLineage, nil
+}
+
+// registeredModelVersions returns the registered version of the model if version is not empty,
+// otherwise all the registered versions of the model
+func (m *ModelClient) registeredModelVersions(name, version string) ([]*types.ModelVersion, error) {
+	if version != "" {
+		modelVersion, err := m.GetModelVersion(name, version)
+		if err != nil {
+			if strings.Contains(err.Error(), model.RESOURCE_DOES_NOT_EXIST_ERROR) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return []*types.ModelVersion{modelVersion}, nil
+	}
+	// no max results,so that all the versions are returned
+	return m.SearchModelVersions(fmt.Sprintf("name='%s'", name), 0, []string{"version_number"})
+}
+
+// Pull downloads the artifacts of the model version to the dest directory,the checksum recorded
+// by 'arena model push' is verified. It returns the checksum of the downloaded artifacts.
+func (m *ModelClient) Pull(name, version, dest string) (*model.ArtifactChecksum, error) {
+	modelVersion, err := m.GetModelVersion(name, version)
+	if err != nil {
+		return nil, err
+	}
+	uri, err := m.GetDownloadUri(name, version)
+	if err != nil {
+		return nil, err
+	}
+	store, err := m.artifactStore(uri)
+	if err != nil {
+		return nil, err
+	}
+	checksum, err := model.PullArtifacts(store, uri, dest)
+	if err != nil {
+		return nil, err
+	}
+	if err := checksum.Verify(modelVersion.Tags); err != nil {
+		return checksum, fmt.Errorf("failed to verify the artifacts of model version %v/%v: %v", name, version, err)
+	}
+	return checksum, nil
+}
+
+// Push uploads the file or directory to the uri and creates a model version of it,the checksum of the artifacts
+// is recorded as the version tags. If the uri is empty,the artifacts are pushed under model_artifact_uri_prefix.
+func (m *ModelClient) Push(name, localPath, uri, versionDescription string) (*types.ModelVersion, error) {
+	if uri == "" {
+		prefix := m.configr.GetConfigsFromConfigFile()[modelArtifactUriPrefixConfigKey]
+		if prefix == "" && m.Type() == types.MlflowModelRegistry {
+			prefix = model.MlflowArtifactsScheme + ":/models"
+		}
+		if prefix == "" {
+			return nil, fmt.Errorf("the uri to push is not given,please set --uri or %v in the arena configuration file", modelArtifactUriPrefixConfigKey)
+		}
+		// the version number is unknown before the model version is created
+		uri = fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(prefix, "/"), name, time.Now().UTC().Format("20060102T150405Z"))
+	}
+	store, err := m.artifactStore(uri)
+	if err != nil {
+		return nil, err
+	}
+	checksum, err := model.PushArtifacts(store, localPath, uri)
+	if err != nil {
+		return nil, err
+	}
+	tags := []*types.RegisteredModelTag{{Key: "createdBy", Value: "arena"}}
+	versionTags := append([]*types.ModelVersionTag{{Key: "createdBy", Value: "arena"}}, checksum.Tags()...)
+	_, modelVersion, err := m.CreateRegisteredModelAndModelVersion(name, "", tags, "auto", versionDescription, versionTags, uri, "")
+	return modelVersion, err
+}
+
+// artifactStore returns the store transferring the artifacts of the uri
+func (m *ModelClient) artifactStore(uri string) (model.ArtifactStore, error) {
+	scheme, err := model.ArtifactScheme(uri)
+	if err != nil {
+		return nil, err
+	}
+	switch scheme {
+	case model.MlflowArtifactsScheme:
+		mlflowClient := m.MlflowClient()
+		if mlflowClient == nil {
+			return nil, fmt.Errorf("the artifacts of %v are transferred by the mlflow tracking server,but the model registry is %v", uri, m.Type())
+		}
+		return model.NewMlflowArtifactStore(mlflowClient), nil
+	case model.S3ArtifactsScheme:
+		configs := m.configr.GetConfigsFromConfigFile()
+		accessKeyId := configs[modelArtifactS3AccessKeyIdConfigKey]
+		if os.Getenv("AWS_ACCESS_KEY_ID") != "" {
+			accessKeyId = os.Getenv("AWS_ACCESS_KEY_ID")
+		}
+		secretAccessKey := configs[modelArtifactS3SecretAccessKeyConfigKey]
+		if os.Getenv("AWS_SECRET_ACCESS_KEY") != "" {
+			secretAccessKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+		}
+		return model.NewS3ArtifactStore(configs[modelArtifactS3EndpointConfigKey], configs[modelArtifactS3RegionConfigKey], accessKeyId, secretAccessKey)
+	}
+	return nil, fmt.Errorf("unsupported artifact uri %v,only support: [%v://, %v:/]", uri, model.S3ArtifactsScheme, model.MlflowArtifactsScheme)
+}
+
+func newMlflowModelRegistry(configer *config.ArenaConfiger) (model.ModelRegistry, error) {
+	trackingUri := os.Getenv("MLFLOW_TRACKING_URI")
+	username := os.Getenv("MLFLOW_TRACKING_USERNAME")
+	password := os.Getenv("MLFLOW_TRACKING_PASSWORD")
+
+	if trackingUri != "" {
+		// Construct a non-proxied MLflow client if `MLFLOW_TRACKING_URI` is specified
+		return model.NewMlflowClient(trackingUri, username, password), nil
+	}
+	// Construct a MLflow client proxied by api server
+	mlflowServices, err := listMlflowServices()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create proxied model client: %v", err)
+	}
+	if len(mlflowServices) == 0 {
+		return nil, fmt.Errorf("failed to create proxied model client: no mlflow service in any namespace found")
+	}
+	mlflowService := mlflowServices[0].DeepCopy()
+	if len(mlflowServices) > 1 {
+		log.Warnf("there are multiple mlflow services found, use %s/%s", mlflowService.ObjectMeta.Namespace, mlflowService.ObjectMeta.Name)
+	}
+	return model.NewProxiedMlflowClient(configer, mlflowService, username, password), nil
+}
+
+func newOCIModelRegistry(configs map[string]string) (model.ModelRegistry, error) {
+	address := configs[ociModelRegistryAddressConfigKey]
+	if address == "" {
+		return nil, fmt.Errorf("%v is not set in the arena configuration file", ociModelRegistryAddressConfigKey)
+	}
+	username := configs[ociModelRegistryUsernameConfigKey]
+	if os.Getenv("OCI_REGISTRY_USERNAME") != "" {
+		username = os.Getenv("OCI_REGISTRY_USERNAME")
+	}
+	password := configs[ociModelRegistryPasswordConfigKey]
+	if os.Getenv("OCI_REGISTRY_PASSWORD") != "" {
+		password = os.Getenv("OCI_REGISTRY_PASSWORD")
+	}
+	return model.NewOCIRegistryClient(address, configs[ociModelRegistryRepositoryPrefixConfigKey], username, password)
+}
+
 func searchModelVersionByJobLabels(namespace string, configer *config.ArenaConfiger, labels map[string]string) *types.ModelVersion {
 	var mv *types.ModelVersion
 	modelName := labels["modelName"]
@@ -71,9 +321,7 @@ func searchModelVersionByJobLabels(namespace string, configer *config.ArenaConfi
 		modelClient, err := NewModelClient(namespace, configer)
 		if err != nil {
 			log.Warnf("failed to search model version by job labels: %v", err)
-		}
-		mv, err = modelClient.GetModelVersion(modelName, modelVersion)
-		if err != nil {
+		} else if mv, err = modelClient.GetModelVersion(modelName, modelVersion); err != nil {
 			log.Warnf("%v", err)
 		}
 	}
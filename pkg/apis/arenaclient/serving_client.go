@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strings"
 
+	log "github.com/sirupsen/logrus"
+
 	"github.com/kubeflow/arena/pkg/apis/config"
 	apiserving "github.com/kubeflow/arena/pkg/apis/serving"
 	"github.com/kubeflow/arena/pkg/apis/types"
@@ -59,6 +61,9 @@ func (t *ServingJobClient) Submit(job *apiserving.Job) error {
 	case types.TritonServingJob:
 		args := job.Args().(*types.TritonServingArgs)
 		return serving.SubmitTritonServingJob(args.Namespace, args)
+	case types.VLLMServingJob:
+		args := job.Args().(*types.VLLMServingArgs)
+		return serving.SubmitVLLMServingJob(args.Namespace, args)
 	}
 	return nil
 }
@@ -90,6 +95,28 @@ func (t *ServingJobClient) GetAndPrint(jobName, version string, jobType types.Se
 	return nil
 }
 
+// Invoke sends the request to the serving job through the service proxy of the api server
+func (t *ServingJobClient) Invoke(jobName, version string, jobType types.ServingJobType, args *types.ServingInvokeArgs) (*types.ServingInvokeResult, error) {
+	job, err := serving.SearchServingJob(t.namespace, jobName, version, jobType)
+	if err != nil {
+		return nil, err
+	}
+	return serving.InvokeServingJob(job, args)
+}
+
+// InvokeAndPrint sends the request to the serving job and prints the latency and the response
+func (t *ServingJobClient) InvokeAndPrint(jobName, version string, jobType types.ServingJobType, args *types.ServingInvokeArgs) error {
+	result, err := t.Invoke(jobName, version, jobType, args)
+	if err != nil {
+		return err
+	}
+	serving.PrintServingInvokeResult(result)
+	if result.StatusCode >= 400 {
+		return fmt.Errorf("the serving job %v responded with %v", jobName, result.Status)
+	}
+	return nil
+}
+
 // List returns all serving jobs
 func (t *ServingJobClient) List(allNamespaces bool, servingType types.ServingJobType) ([]*types.ServingJobInfo, error) {
 	jobs, err := serving.ListServingJobs(t.namespace, allNamespaces, servingType)
@@ -168,24 +195,185 @@ func (t *ServingJobClient) Update(job *apiserving.Job) error {
 	switch job.Type() {
 	case types.TFServingJob:
 		args := job.Args().(*types.UpdateTensorFlowServingArgs)
+		if err := t.setRegisteredModelPath(&args.CommonUpdateServingArgs, &args.ModelPath); err != nil {
+			return err
+		}
 		return serving.UpdateTensorflowServing(args)
 	case types.TritonServingJob:
 		args := job.Args().(*types.UpdateTritonServingArgs)
+		if err := t.setRegisteredModelPath(&args.CommonUpdateServingArgs, &args.ModelRepository); err != nil {
+			return err
+		}
 		return serving.UpdateTritonServing(args)
 	case types.CustomServingJob:
 		args := job.Args().(*types.UpdateCustomServingArgs)
+		if err := t.setRegisteredModelPath(&args.CommonUpdateServingArgs, nil); err != nil {
+			return err
+		}
 		return serving.UpdateCustomServing(args)
 	case types.KServeJob:
 		args := job.Args().(*types.UpdateKServeArgs)
+		if err := t.setRegisteredModelPath(&args.CommonUpdateServingArgs, &args.StorageUri); err != nil {
+			return err
+		}
 		return serving.UpdateKServe(args)
+	case types.SeldonServingJob:
+		args := job.Args().(*types.UpdateSeldonServingArgs)
+		if err := t.setRegisteredModelPath(&args.CommonUpdateServingArgs, &args.ModelUri); err != nil {
+			return err
+		}
+		return serving.UpdateSeldonServing(args)
+	case types.TRTServingJob:
+		args := job.Args().(*types.UpdateTensorRTServingArgs)
+		if err := t.setRegisteredModelPath(&args.CommonUpdateServingArgs, &args.ModelStore); err != nil {
+			return err
+		}
+		return serving.UpdateTensorRTServing(args)
+	case types.KFServingJob:
+		args := job.Args().(*types.UpdateKFServingArgs)
+		if err := t.setRegisteredModelPath(&args.CommonUpdateServingArgs, &args.StorageUri); err != nil {
+			return err
+		}
+		return serving.UpdateKFServing(args)
 	}
 	return nil
 }
 
+// setRegisteredModelPath resolves --model-version or --model-alias to the version of the registered model
+// given by the label modelName of the serving job,the model path is set to the download uri of the version
+// and the version is recorded in the label modelVersion
+func (t *ServingJobClient) setRegisteredModelPath(args *types.CommonUpdateServingArgs, modelPath *string) error {
+	if args.ModelVersion == "" && args.ModelAlias == "" {
+		return nil
+	}
+	if modelPath == nil {
+		return fmt.Errorf("--model-version and --model-alias are not supported by %v job", args.Type)
+	}
+	job, err := serving.SearchServingJob(args.Namespace, args.Name, args.Version, args.Type)
+	if err != nil {
+		return err
+	}
+	modelName := job.GetLabels()["modelName"]
+	if modelName == "" {
+		return fmt.Errorf("the serving job %v has no registered model,please submit it with --model-name", args.Name)
+	}
+	modelClient, err := NewModelClient(args.Namespace, t.configer)
+	if err != nil {
+		return err
+	}
+	var mv *types.ModelVersion
+	if args.ModelAlias != "" {
+		mv, err = modelClient.GetModelVersionByAlias(modelName, args.ModelAlias)
+	} else {
+		mv, err = modelClient.GetModelVersion(modelName, args.ModelVersion)
+	}
+	if err != nil {
+		return err
+	}
+	uri, err := modelClient.GetDownloadUri(modelName, mv.Version)
+	if err != nil {
+		return err
+	}
+	log.Infof("serve version %v of registered model %v from %v", mv.Version, modelName, uri)
+	*modelPath = uri
+	if args.Labels == nil {
+		args.Labels = map[string]string{}
+	}
+	args.Labels["modelVersion"] = mv.Version
+	return nil
+}
+
 func (t *ServingJobClient) TrafficRouterSplit(args *types.TrafficRouterSplitArgs) error {
 	return serving.RunTrafficRouterSplit(args.Namespace, args)
 }
 
+// Rollout shifts the traffic from a serving version to another step by step,it blocks until the rollout is finished
+func (t *ServingJobClient) Rollout(args *types.ServingRolloutArgs) error {
+	args.Namespace = t.namespace
+	return serving.RunServingRollout(args)
+}
+
+// History returns the revisions of a serving job
+func (t *ServingJobClient) History(jobName, version string, jobType types.ServingJobType) ([]types.ServingRevision, error) {
+	job, err := serving.SearchServingJob(t.namespace, jobName, version, jobType)
+	if err != nil {
+		return nil, err
+	}
+	return serving.GetServingJobHistory(job)
+}
+
+// HistoryAndPrint prints the revisions of a serving job
+func (t *ServingJobClient) HistoryAndPrint(jobName, version string, jobType types.ServingJobType, format string) error {
+	if utils.TransferPrintFormat(format) == types.UnknownFormat {
+		return fmt.Errorf("unknown output format,only support:[wide|json|yaml]")
+	}
+	revisions, err := t.History(jobName, version, jobType)
+	if err != nil {
+		return err
+	}
+	serving.PrintServingJobHistory(revisions, utils.TransferPrintFormat(format))
+	return nil
+}
+
+// Rollback rolls back a serving job to the revision,the previous revision is used if the revision is 0
+func (t *ServingJobClient) Rollback(jobName, version string, jobType types.ServingJobType, revision int64) error {
+	job, err := serving.SearchServingJob(t.namespace, jobName, version, jobType)
+	if err != nil {
+		return err
+	}
+	return serving.RollbackServingJob(job, revision)
+}
+
+// TritonModels returns the models in the model repository of a triton serving job
+func (t *ServingJobClient) TritonModels(jobName, version string) ([]types.TritonModel, error) {
+	job, err := serving.SearchServingJob(t.namespace, jobName, version, types.TritonServingJob)
+	if err != nil {
+		return nil, err
+	}
+	return serving.ListTritonModels(job)
+}
+
+// TritonModelsAndPrint prints the models in the model repository of a triton serving job
+func (t *ServingJobClient) TritonModelsAndPrint(jobName, version string, format string) error {
+	if utils.TransferPrintFormat(format) == types.UnknownFormat {
+		return fmt.Errorf("unknown output format,only support:[wide|json|yaml]")
+	}
+	models, err := t.TritonModels(jobName, version)
+	if err != nil {
+		return err
+	}
+	serving.PrintTritonModels(models, utils.TransferPrintFormat(format))
+	return nil
+}
+
+// LoadTritonModels loads the models into a running triton serving job without redeploying it
+func (t *ServingJobClient) LoadTritonModels(jobName, version string, models []string) error {
+	job, err := serving.SearchServingJob(t.namespace, jobName, version, types.TritonServingJob)
+	if err != nil {
+		return err
+	}
+	for _, model := range models {
+		if err := serving.LoadTritonModel(job, model); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnloadTritonModels unloads the models from a running triton serving job
+func (t *ServingJobClient) UnloadTritonModels(jobName, version string, models []string) error {
+	job, err := serving.SearchServingJob(t.namespace, jobName, version, types.TritonServingJob)
+	if err != nil {
+		return err
+	}
+	for _, model := range models {
+		if err := serving.UnloadTritonModel(job, model); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func moreThanOneInstanceHelpInfo(instances []types.ServingInstance) string {
 	header := fmt.Sprintf("There is %d instances have been found:", len(instances))
 	lines := []string{}
@@ -196,3 +384,8 @@ func moreThanOneInstanceHelpInfo(instances []types.ServingInstance) string {
 	return fmt.Sprintf("%s\n\n%s\n\n%s\n", header, strings.Join(lines, "\n"), footer)
 
 }
+
+// Top displays the live metrics(qps,latency,error rate and gpu utilization) of the serving jobs
+func (t *ServingJobClient) Top(args []string, allNamespaces bool, servingType types.ServingJobType, version string, notStop bool, format types.FormatStyle) error {
+	return serving.TopServingJobs(args, t.namespace, allNamespaces, servingType, version, notStop, format)
+}
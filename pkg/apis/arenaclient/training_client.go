@@ -1,6 +1,7 @@
 package arenaclient
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -70,6 +71,18 @@ func (t *TrainingJobClient) Submit(job *apistraining.Job) error {
 	case types.DeepSpeedTrainingJob:
 		args := job.Args().(*types.SubmitDeepSpeedJobArgs)
 		return training.SubmitDeepSpeedJob(t.namespace, args)
+	case types.RayTrainingJob:
+		args := job.Args().(*types.SubmitRayJobArgs)
+		return training.SubmitRayJob(t.namespace, args)
+	case types.XGBoostTrainingJob:
+		args := job.Args().(*types.SubmitXGBoostJobArgs)
+		return training.SubmitXGBoostJob(t.namespace, args)
+	case types.PaddleTrainingJob:
+		args := job.Args().(*types.SubmitPaddleJobArgs)
+		return training.SubmitPaddleJob(t.namespace, args)
+	case types.JAXTrainingJob:
+		args := job.Args().(*types.SubmitJAXJobArgs)
+		return training.SubmitJAXJob(t.namespace, args)
 	}
 	return nil
 }
@@ -125,6 +138,138 @@ func (t *TrainingJobClient) GetAndPrint(jobName string, jobType types.TrainingJo
 	return nil
 }
 
+// Diagnose inspects the pods and the events of the training job,and returns the ranked root-cause hints
+func (t *TrainingJobClient) Diagnose(jobName string, jobType types.TrainingJobType) (*types.TrainingJobDiagnosis, error) {
+	job, err := training.SearchTrainingJob(jobName, t.namespace, jobType)
+	if err != nil {
+		if err == types.ErrTrainingJobNotFound {
+			return nil, fmt.Errorf(errJobNotFoundMessage, jobName, t.namespace)
+		}
+		return nil, err
+	}
+	return training.DiagnoseTrainingJob(job), nil
+}
+
+// DiagnoseAndPrint prints the diagnosis report of the training job
+func (t *TrainingJobClient) DiagnoseAndPrint(jobName string, jobType types.TrainingJobType, format string) error {
+	printFormat := utils.TransferPrintFormat(format)
+	if printFormat == types.UnknownFormat {
+		return fmt.Errorf("Unknown output format,only support:[wide|json|yaml]")
+	}
+	diagnosis, err := t.Diagnose(jobName, jobType)
+	if err != nil {
+		return err
+	}
+	return training.PrintTrainingJobDiagnosis(diagnosis, printFormat)
+}
+
+// Export returns the spec of the training job,it can be submitted again by 'arena submit -f'
+func (t *TrainingJobClient) Export(jobName string, jobType types.TrainingJobType) (*types.TrainingJobSpec, error) {
+	spec, err := training.GetTrainingJobSpec(jobName, t.namespace, jobType)
+	if err != nil {
+		if err == types.ErrTrainingJobNotFound {
+			return nil, fmt.Errorf(errJobNotFoundMessage, jobName, t.namespace)
+		}
+		return nil, err
+	}
+	return spec, nil
+}
+
+// Wait blocks until the training job reaches the expected status,it returns the last observed status of job.
+// If the job has finished with another status,types.ErrTrainingJobStatusUnreachable is returned.
+func (t *TrainingJobClient) Wait(ctx context.Context, jobName string, jobType types.TrainingJobType, status types.TrainingJobStatus) (types.TrainingJobStatus, error) {
+	jobStatus, err := training.WaitTrainingJob(ctx, jobName, t.namespace, jobType, status)
+	if err == types.ErrTrainingJobNotFound {
+		return jobStatus, fmt.Errorf(errJobNotFoundMessage, jobName, t.namespace)
+	}
+	return jobStatus, err
+}
+
+// RegisterModelOnSuccess registers the model version of the succeeded job which is submitted with --register-model-on-success,
+// it returns nil if the job is not succeeded,is not submitted with the option or its model version has been registered
+func (t *TrainingJobClient) RegisterModelOnSuccess(jobName string, jobType types.TrainingJobType) (*types.ModelVersion, error) {
+	registration, err := training.GetModelVersionRegistration(jobName, t.namespace, jobType)
+	if err == types.ErrTrainingJobNotFound {
+		return nil, fmt.Errorf(errJobNotFoundMessage, jobName, t.namespace)
+	}
+	if registration == nil || err != nil {
+		return nil, err
+	}
+	modelClient, err := NewModelClient(t.namespace, t.configer)
+	if err != nil {
+		return nil, err
+	}
+	// the version is created but the job is not labeled if the previous observer fails to patch the job
+	modelVersion, err := findRegisteredModelVersion(modelClient, registration)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register the model of job %v/%v: %v", registration.JobNamespace, registration.JobName, err)
+	}
+	if modelVersion == nil {
+		tags := []*types.RegisteredModelTag{{Key: "createdBy", Value: "arena"}}
+		_, modelVersion, err = modelClient.CreateRegisteredModelAndModelVersion(registration.ModelName, "", tags, "auto", registration.Description, registration.Tags, registration.Source, registration.RunLink)
+		if err != nil {
+			return nil, fmt.Errorf("failed to register the model of job %v/%v: %v", registration.JobNamespace, registration.JobName, err)
+		}
+	}
+	if err := training.LabelRegisteredModelVersion(registration, modelVersion.Version); err != nil {
+		return modelVersion, fmt.Errorf("failed to patch label `modelVersion=%s` to job %s/%s: %v", modelVersion.Version, registration.JobType, registration.JobName, err)
+	}
+	return modelVersion, nil
+}
+
+// findRegisteredModelVersion returns the model version which is registered for the job,
+// it returns nil if the model version is not found
+func findRegisteredModelVersion(modelClient *ModelClient, registration *types.ModelVersionRegistration) (*types.ModelVersion, error) {
+	// no max results,so that all the versions are searched
+	modelVersions, err := modelClient.SearchModelVersions(fmt.Sprintf("name='%s'", registration.ModelName), 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	jobTags := map[string]string{}
+	for _, tag := range registration.Tags {
+		switch tag.Key {
+		case "jobName", "jobNamespace", "jobType", "jobUid":
+			jobTags[tag.Key] = tag.Value
+		}
+	}
+	for _, modelVersion := range modelVersions {
+		matched := 0
+		for _, tag := range modelVersion.Tags {
+			if value, ok := jobTags[tag.Key]; ok && value == tag.Value {
+				matched++
+			}
+		}
+		if matched == len(jobTags) {
+			return modelVersion, nil
+		}
+	}
+	return nil, nil
+}
+
+// Suspend suspends the training job,the pods of the job are deleted but the job is kept
+func (t *TrainingJobClient) Suspend(jobName string, jobType types.TrainingJobType) error {
+	err := training.SuspendTrainingJob(jobName, t.namespace, jobType, true)
+	if err == types.ErrTrainingJobNotFound {
+		return fmt.Errorf(errJobNotFoundMessage, jobName, t.namespace)
+	}
+	return err
+}
+
+// Resume resumes the suspended training job
+func (t *TrainingJobClient) Resume(jobName string, jobType types.TrainingJobType) error {
+	err := training.SuspendTrainingJob(jobName, t.namespace, jobType, false)
+	if err == types.ErrTrainingJobNotFound {
+		return fmt.Errorf(errJobNotFoundMessage, jobName, t.namespace)
+	}
+	return err
+}
+
+// RunRetryController resubmits the failed training jobs by their retry policies,it never returns
+func (t *TrainingJobClient) RunRetryController(args types.RetryControllerArgs) error {
+	args.Namespace = t.namespace
+	return training.RunRetryController(args)
+}
+
 // List returns all training jobs
 func (t *TrainingJobClient) List(allNamespaces bool, trainingType types.TrainingJobType, showPrometheusMetric bool) ([]*types.TrainingJobInfo, error) {
 	jobs, err := training.ListTrainingJobs(t.namespace, allNamespaces, trainingType)
@@ -16,6 +16,9 @@ type CustomServingJobBuilder struct {
 
 func NewCustomServingJobBuilder() *CustomServingJobBuilder {
 	args := &types.CustomServingArgs{
+		AutoscalingArgs: types.AutoscalingArgs{
+			MinReplicas: 1,
+		},
 		CommonServingArgs: types.CommonServingArgs{
 			ImagePullPolicy: "IfNotPresent",
 			Replicas:        1,
@@ -281,6 +284,40 @@ func (b *CustomServingJobBuilder) MetricsPort(port int) *CustomServingJobBuilder
 	return b
 }
 
+// MinReplicas is used to set the minimum replicas of the autoscaler,match option --min-replicas
+func (b *CustomServingJobBuilder) MinReplicas(minReplicas int) *CustomServingJobBuilder {
+	if minReplicas > 0 {
+		b.args.MinReplicas = minReplicas
+	}
+	return b
+}
+
+// MaxReplicas is used to set the maximum replicas of the autoscaler,match option --max-replicas,
+// the horizontal pod autoscaler is created if it is greater than 0
+func (b *CustomServingJobBuilder) MaxReplicas(maxReplicas int) *CustomServingJobBuilder {
+	if maxReplicas > 0 {
+		b.args.MaxReplicas = maxReplicas
+	}
+	return b
+}
+
+// ScaleMetric is used to set the metric watched by the autoscaler,possible values are cpu, memory or a custom pod metric,
+// match option --scale-metric
+func (b *CustomServingJobBuilder) ScaleMetric(scaleMetric string) *CustomServingJobBuilder {
+	if scaleMetric != "" {
+		b.args.ScaleMetric = scaleMetric
+	}
+	return b
+}
+
+// ScaleTarget is used to set the target value of the scaling metric,match option --scale-target
+func (b *CustomServingJobBuilder) ScaleTarget(scaleTarget int) *CustomServingJobBuilder {
+	if scaleTarget > 0 {
+		b.args.ScaleTarget = scaleTarget
+	}
+	return b
+}
+
 // Build is used to build the job
 func (b *CustomServingJobBuilder) Build() (*Job, error) {
 	for key, value := range b.argValues {
@@ -18,6 +18,9 @@ func NewTFServingJobBuilder() *TFServingJobBuilder {
 	args := &types.TensorFlowServingArgs{
 		Port:        8500,
 		RestfulPort: 8501,
+		AutoscalingArgs: types.AutoscalingArgs{
+			MinReplicas: 1,
+		},
 		CommonServingArgs: types.CommonServingArgs{
 			Image:           argsbuilder.DefaultTfServingImage,
 			ImagePullPolicy: "IfNotPresent",
@@ -331,6 +334,40 @@ func (b *TFServingJobBuilder) ConfigFiles(files map[string]string) *TFServingJob
 	return b
 }
 
+// MinReplicas is used to set the minimum replicas of the autoscaler,match option --min-replicas
+func (b *TFServingJobBuilder) MinReplicas(minReplicas int) *TFServingJobBuilder {
+	if minReplicas > 0 {
+		b.args.MinReplicas = minReplicas
+	}
+	return b
+}
+
+// MaxReplicas is used to set the maximum replicas of the autoscaler,match option --max-replicas,
+// the horizontal pod autoscaler is created if it is greater than 0
+func (b *TFServingJobBuilder) MaxReplicas(maxReplicas int) *TFServingJobBuilder {
+	if maxReplicas > 0 {
+		b.args.MaxReplicas = maxReplicas
+	}
+	return b
+}
+
+// ScaleMetric is used to set the metric watched by the autoscaler,possible values are cpu, memory or a custom pod metric,
+// match option --scale-metric
+func (b *TFServingJobBuilder) ScaleMetric(scaleMetric string) *TFServingJobBuilder {
+	if scaleMetric != "" {
+		b.args.ScaleMetric = scaleMetric
+	}
+	return b
+}
+
+// ScaleTarget is used to set the target value of the scaling metric,match option --scale-target
+func (b *TFServingJobBuilder) ScaleTarget(scaleTarget int) *TFServingJobBuilder {
+	if scaleTarget > 0 {
+		b.args.ScaleTarget = scaleTarget
+	}
+	return b
+}
+
 // Build is used to build the job
 func (b *TFServingJobBuilder) Build() (*Job, error) {
 	for key, value := range b.argValues {
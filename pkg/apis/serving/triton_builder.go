@@ -19,6 +19,9 @@ func NewTritonServingJobBuilder() *TritonServingJobBuilder {
 		HttpPort:    8000,
 		GrpcPort:    8001,
 		MetricsPort: 8002,
+		AutoscalingArgs: types.AutoscalingArgs{
+			MinReplicas: 1,
+		},
 		CommonServingArgs: types.CommonServingArgs{
 			ImagePullPolicy: "IfNotPresent",
 			Replicas:        1,
@@ -313,6 +316,40 @@ func (b *TritonServingJobBuilder) ConfigFiles(files map[string]string) *TritonSe
 	return b
 }
 
+// MinReplicas is used to set the minimum replicas of the autoscaler,match option --min-replicas
+func (b *TritonServingJobBuilder) MinReplicas(minReplicas int) *TritonServingJobBuilder {
+	if minReplicas > 0 {
+		b.args.MinReplicas = minReplicas
+	}
+	return b
+}
+
+// MaxReplicas is used to set the maximum replicas of the autoscaler,match option --max-replicas,
+// the horizontal pod autoscaler is created if it is greater than 0
+func (b *TritonServingJobBuilder) MaxReplicas(maxReplicas int) *TritonServingJobBuilder {
+	if maxReplicas > 0 {
+		b.args.MaxReplicas = maxReplicas
+	}
+	return b
+}
+
+// ScaleMetric is used to set the metric watched by the autoscaler,possible values are cpu, memory or a custom pod metric,
+// match option --scale-metric
+func (b *TritonServingJobBuilder) ScaleMetric(scaleMetric string) *TritonServingJobBuilder {
+	if scaleMetric != "" {
+		b.args.ScaleMetric = scaleMetric
+	}
+	return b
+}
+
+// ScaleTarget is used to set the target value of the scaling metric,match option --scale-target
+func (b *TritonServingJobBuilder) ScaleTarget(scaleTarget int) *TritonServingJobBuilder {
+	if scaleTarget > 0 {
+		b.args.ScaleTarget = scaleTarget
+	}
+	return b
+}
+
 // Build is used to build the job
 func (b *TritonServingJobBuilder) Build() (*Job, error) {
 	for key, value := range b.argValues {
@@ -129,6 +129,40 @@ func (b *UpdateCustomServingJobBuilder) Replicas(count int) *UpdateCustomServing
 	return b
 }
 
+// MinReplicas is used to set the minimum replicas of the autoscaler,match option --min-replicas
+func (b *UpdateCustomServingJobBuilder) MinReplicas(minReplicas int) *UpdateCustomServingJobBuilder {
+	if minReplicas > 0 {
+		b.args.MinReplicas = minReplicas
+	}
+	return b
+}
+
+// MaxReplicas is used to set the maximum replicas of the autoscaler,match option --max-replicas,
+// it must be set if the serving job has no autoscaler
+func (b *UpdateCustomServingJobBuilder) MaxReplicas(maxReplicas int) *UpdateCustomServingJobBuilder {
+	if maxReplicas > 0 {
+		b.args.MaxReplicas = maxReplicas
+	}
+	return b
+}
+
+// ScaleMetric is used to set the metric watched by the autoscaler,possible values are cpu, memory or a custom pod metric,
+// match option --scale-metric
+func (b *UpdateCustomServingJobBuilder) ScaleMetric(scaleMetric string) *UpdateCustomServingJobBuilder {
+	if scaleMetric != "" {
+		b.args.ScaleMetric = scaleMetric
+	}
+	return b
+}
+
+// ScaleTarget is used to set the target value of the scaling metric,match option --scale-target
+func (b *UpdateCustomServingJobBuilder) ScaleTarget(scaleTarget int) *UpdateCustomServingJobBuilder {
+	if scaleTarget > 0 {
+		b.args.ScaleTarget = scaleTarget
+	}
+	return b
+}
+
 // Build is used to build the job
 func (b *UpdateCustomServingJobBuilder) Build() (*Job, error) {
 	for key, value := range b.argValues {
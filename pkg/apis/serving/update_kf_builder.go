@@ -0,0 +1,204 @@
+package serving
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/argsbuilder"
+)
+
+type UpdateKFServingJobBuilder struct {
+	args      *types.UpdateKFServingArgs
+	argValues map[string]interface{}
+	argsbuilder.ArgsBuilder
+}
+
+func NewUpdateKFServingJobBuilder() *UpdateKFServingJobBuilder {
+	args := &types.UpdateKFServingArgs{}
+	return &UpdateKFServingJobBuilder{
+		args:        args,
+		argValues:   map[string]interface{}{},
+		ArgsBuilder: argsbuilder.NewUpdateKFServingArgsBuilder(args),
+	}
+}
+
+// Name is used to set job name,match option --name
+func (b *UpdateKFServingJobBuilder) Name(name string) *UpdateKFServingJobBuilder {
+	if name != "" {
+		b.args.Name = name
+	}
+	return b
+}
+
+// Namespace is used to set job namespace,match option --namespace
+func (b *UpdateKFServingJobBuilder) Namespace(namespace string) *UpdateKFServingJobBuilder {
+	if namespace != "" {
+		b.args.Namespace = namespace
+	}
+	return b
+}
+
+// Version is used to set serving job version, match the option --version
+func (b *UpdateKFServingJobBuilder) Version(version string) *UpdateKFServingJobBuilder {
+	if version != "" {
+		b.args.Version = version
+	}
+	return b
+}
+
+// Command is used to set job command
+func (b *UpdateKFServingJobBuilder) Command(args []string) *UpdateKFServingJobBuilder {
+	if b.args.Command == "" {
+		b.args.Command = strings.Join(args, " ")
+	}
+	return b
+}
+
+// Image is used to set job image,match the option --image
+func (b *UpdateKFServingJobBuilder) Image(image string) *UpdateKFServingJobBuilder {
+	if image != "" {
+		b.args.Image = image
+	}
+	return b
+}
+
+// Envs is used to set env of job containers,match option --env
+func (b *UpdateKFServingJobBuilder) Envs(envs map[string]string) *UpdateKFServingJobBuilder {
+	if len(envs) != 0 {
+		envSlice := []string{}
+		for key, value := range envs {
+			envSlice = append(envSlice, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["env"] = &envSlice
+	}
+	return b
+}
+
+// Tolerations are used to set tolerations for tolerate nodes, match option --toleration
+func (b *UpdateKFServingJobBuilder) Tolerations(tolerations []string) *UpdateKFServingJobBuilder {
+	b.argValues["toleration"] = &tolerations
+	return b
+}
+
+// NodeSelectors is used to set node selectors for scheduling job, match option --selector
+func (b *UpdateKFServingJobBuilder) NodeSelectors(selectors map[string]string) *UpdateKFServingJobBuilder {
+	if len(selectors) != 0 {
+		selectorsSlice := []string{}
+		for key, value := range selectors {
+			selectorsSlice = append(selectorsSlice, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["selector"] = &selectorsSlice
+	}
+	return b
+}
+
+// Annotations is used to add annotations for job pods,match option --annotation
+func (b *UpdateKFServingJobBuilder) Annotations(annotations map[string]string) *UpdateKFServingJobBuilder {
+	if len(annotations) != 0 {
+		s := []string{}
+		for key, value := range annotations {
+			s = append(s, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["annotation"] = &s
+	}
+	return b
+}
+
+// Labels is used to add labels for job
+func (b *UpdateKFServingJobBuilder) Labels(labels map[string]string) *UpdateKFServingJobBuilder {
+	if len(labels) != 0 {
+		s := []string{}
+		for key, value := range labels {
+			s = append(s, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["label"] = &s
+	}
+	return b
+}
+
+// Replicas is used to set serving job replicas,match the option --replicas
+func (b *UpdateKFServingJobBuilder) Replicas(count int) *UpdateKFServingJobBuilder {
+	if count > 0 {
+		b.args.Replicas = count
+	}
+	return b
+}
+
+// GPUCount is used to set gpu count for the job,match the option --gpus
+func (b *UpdateKFServingJobBuilder) GPUCount(count int) *UpdateKFServingJobBuilder {
+	if count > 0 {
+		b.args.GPUCount = count
+	}
+	return b
+}
+
+// GPUMemory is used to set gpu memory for the job,match the option --gpumemory
+func (b *UpdateKFServingJobBuilder) GPUMemory(memory int) *UpdateKFServingJobBuilder {
+	if memory > 0 {
+		b.args.GPUMemory = memory
+	}
+	return b
+}
+
+// GPUCore is used to set gpu core for the job, match the option --gpucore
+func (b *UpdateKFServingJobBuilder) GPUCore(core int) *UpdateKFServingJobBuilder {
+	if core > 0 {
+		b.args.GPUCore = core
+	}
+	return b
+}
+
+// CPU assign cpu limits,match the option --cpu
+func (b *UpdateKFServingJobBuilder) CPU(cpu string) *UpdateKFServingJobBuilder {
+	if cpu != "" {
+		b.args.Cpu = cpu
+	}
+	return b
+}
+
+// Memory assign memory limits,match option --memory
+func (b *UpdateKFServingJobBuilder) Memory(memory string) *UpdateKFServingJobBuilder {
+	if memory != "" {
+		b.args.Memory = memory
+	}
+	return b
+}
+
+// StorageUri is used to set the uri of the model file,match the option --storage-uri
+func (b *UpdateKFServingJobBuilder) StorageUri(uri string) *UpdateKFServingJobBuilder {
+	if uri != "" {
+		b.args.StorageUri = uri
+	}
+	return b
+}
+
+// ModelVersion is used to serve the version of the registered model,match the option --model-version
+func (b *UpdateKFServingJobBuilder) ModelVersion(version string) *UpdateKFServingJobBuilder {
+	if version != "" {
+		b.args.ModelVersion = version
+	}
+	return b
+}
+
+// ModelAlias is used to serve the registered model version with the alias,match the option --model-alias
+func (b *UpdateKFServingJobBuilder) ModelAlias(alias string) *UpdateKFServingJobBuilder {
+	if alias != "" {
+		b.args.ModelAlias = alias
+	}
+	return b
+}
+
+// Build is used to build the job
+func (b *UpdateKFServingJobBuilder) Build() (*Job, error) {
+	for key, value := range b.argValues {
+		b.AddArgValue(key, value)
+	}
+	if err := b.PreBuild(); err != nil {
+		return nil, err
+	}
+	if err := b.ArgsBuilder.Build(); err != nil {
+		return nil, err
+	}
+	return NewJob(b.args.Name, types.KFServingJob, b.args), nil
+}
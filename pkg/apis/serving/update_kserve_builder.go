@@ -215,6 +215,22 @@ func (b *UpdateKServeJobBuilder) Port(port int) *UpdateKServeJobBuilder {
 	return b
 }
 
+// ModelVersion is used to serve the version of the registered model,match the option --model-version
+func (b *UpdateKServeJobBuilder) ModelVersion(version string) *UpdateKServeJobBuilder {
+	if version != "" {
+		b.args.ModelVersion = version
+	}
+	return b
+}
+
+// ModelAlias is used to serve the registered model version with the alias,match the option --model-alias
+func (b *UpdateKServeJobBuilder) ModelAlias(alias string) *UpdateKServeJobBuilder {
+	if alias != "" {
+		b.args.ModelAlias = alias
+	}
+	return b
+}
+
 // Build is used to build the job
 func (b *UpdateKServeJobBuilder) Build() (*Job, error) {
 	for key, value := range b.argValues {
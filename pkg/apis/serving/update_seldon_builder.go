@@ -0,0 +1,212 @@
+package serving
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/argsbuilder"
+)
+
+type UpdateSeldonServingJobBuilder struct {
+	args      *types.UpdateSeldonServingArgs
+	argValues map[string]interface{}
+	argsbuilder.ArgsBuilder
+}
+
+func NewUpdateSeldonServingJobBuilder() *UpdateSeldonServingJobBuilder {
+	args := &types.UpdateSeldonServingArgs{}
+	return &UpdateSeldonServingJobBuilder{
+		args:        args,
+		argValues:   map[string]interface{}{},
+		ArgsBuilder: argsbuilder.NewUpdateSeldonServingArgsBuilder(args),
+	}
+}
+
+// Name is used to set job name,match option --name
+func (b *UpdateSeldonServingJobBuilder) Name(name string) *UpdateSeldonServingJobBuilder {
+	if name != "" {
+		b.args.Name = name
+	}
+	return b
+}
+
+// Namespace is used to set job namespace,match option --namespace
+func (b *UpdateSeldonServingJobBuilder) Namespace(namespace string) *UpdateSeldonServingJobBuilder {
+	if namespace != "" {
+		b.args.Namespace = namespace
+	}
+	return b
+}
+
+// Version is used to set serving job version, match the option --version
+func (b *UpdateSeldonServingJobBuilder) Version(version string) *UpdateSeldonServingJobBuilder {
+	if version != "" {
+		b.args.Version = version
+	}
+	return b
+}
+
+// Command is used to set job command
+func (b *UpdateSeldonServingJobBuilder) Command(args []string) *UpdateSeldonServingJobBuilder {
+	if b.args.Command == "" {
+		b.args.Command = strings.Join(args, " ")
+	}
+	return b
+}
+
+// Image is used to set job image,match the option --image
+func (b *UpdateSeldonServingJobBuilder) Image(image string) *UpdateSeldonServingJobBuilder {
+	if image != "" {
+		b.args.Image = image
+	}
+	return b
+}
+
+// Envs is used to set env of job containers,match option --env
+func (b *UpdateSeldonServingJobBuilder) Envs(envs map[string]string) *UpdateSeldonServingJobBuilder {
+	if len(envs) != 0 {
+		envSlice := []string{}
+		for key, value := range envs {
+			envSlice = append(envSlice, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["env"] = &envSlice
+	}
+	return b
+}
+
+// Tolerations are used to set tolerations for tolerate nodes, match option --toleration
+func (b *UpdateSeldonServingJobBuilder) Tolerations(tolerations []string) *UpdateSeldonServingJobBuilder {
+	b.argValues["toleration"] = &tolerations
+	return b
+}
+
+// NodeSelectors is used to set node selectors for scheduling job, match option --selector
+func (b *UpdateSeldonServingJobBuilder) NodeSelectors(selectors map[string]string) *UpdateSeldonServingJobBuilder {
+	if len(selectors) != 0 {
+		selectorsSlice := []string{}
+		for key, value := range selectors {
+			selectorsSlice = append(selectorsSlice, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["selector"] = &selectorsSlice
+	}
+	return b
+}
+
+// Annotations is used to add annotations for job pods,match option --annotation
+func (b *UpdateSeldonServingJobBuilder) Annotations(annotations map[string]string) *UpdateSeldonServingJobBuilder {
+	if len(annotations) != 0 {
+		s := []string{}
+		for key, value := range annotations {
+			s = append(s, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["annotation"] = &s
+	}
+	return b
+}
+
+// Labels is used to add labels for job
+func (b *UpdateSeldonServingJobBuilder) Labels(labels map[string]string) *UpdateSeldonServingJobBuilder {
+	if len(labels) != 0 {
+		s := []string{}
+		for key, value := range labels {
+			s = append(s, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["label"] = &s
+	}
+	return b
+}
+
+// Replicas is used to set serving job replicas,match the option --replicas
+func (b *UpdateSeldonServingJobBuilder) Replicas(count int) *UpdateSeldonServingJobBuilder {
+	if count > 0 {
+		b.args.Replicas = count
+	}
+	return b
+}
+
+// GPUCount is used to set gpu count for the job,match the option --gpus
+func (b *UpdateSeldonServingJobBuilder) GPUCount(count int) *UpdateSeldonServingJobBuilder {
+	if count > 0 {
+		b.args.GPUCount = count
+	}
+	return b
+}
+
+// GPUMemory is used to set gpu memory for the job,match the option --gpumemory
+func (b *UpdateSeldonServingJobBuilder) GPUMemory(memory int) *UpdateSeldonServingJobBuilder {
+	if memory > 0 {
+		b.args.GPUMemory = memory
+	}
+	return b
+}
+
+// GPUCore is used to set gpu core for the job, match the option --gpucore
+func (b *UpdateSeldonServingJobBuilder) GPUCore(core int) *UpdateSeldonServingJobBuilder {
+	if core > 0 {
+		b.args.GPUCore = core
+	}
+	return b
+}
+
+// CPU assign cpu limits,match the option --cpu
+func (b *UpdateSeldonServingJobBuilder) CPU(cpu string) *UpdateSeldonServingJobBuilder {
+	if cpu != "" {
+		b.args.Cpu = cpu
+	}
+	return b
+}
+
+// Memory assign memory limits,match option --memory
+func (b *UpdateSeldonServingJobBuilder) Memory(memory string) *UpdateSeldonServingJobBuilder {
+	if memory != "" {
+		b.args.Memory = memory
+	}
+	return b
+}
+
+// ModelUri is used to set the uri of the model file,match the option --model-uri
+func (b *UpdateSeldonServingJobBuilder) ModelUri(uri string) *UpdateSeldonServingJobBuilder {
+	if uri != "" {
+		b.args.ModelUri = uri
+	}
+	return b
+}
+
+// Implementation is used to set the type of serving implementation,match the option --implementation
+func (b *UpdateSeldonServingJobBuilder) Implementation(implementation string) *UpdateSeldonServingJobBuilder {
+	if implementation != "" {
+		b.args.Implementation = implementation
+	}
+	return b
+}
+
+// ModelVersion is used to serve the version of the registered model,match the option --model-version
+func (b *UpdateSeldonServingJobBuilder) ModelVersion(version string) *UpdateSeldonServingJobBuilder {
+	if version != "" {
+		b.args.ModelVersion = version
+	}
+	return b
+}
+
+// ModelAlias is used to serve the registered model version with the alias,match the option --model-alias
+func (b *UpdateSeldonServingJobBuilder) ModelAlias(alias string) *UpdateSeldonServingJobBuilder {
+	if alias != "" {
+		b.args.ModelAlias = alias
+	}
+	return b
+}
+
+// Build is used to build the job
+func (b *UpdateSeldonServingJobBuilder) Build() (*Job, error) {
+	for key, value := range b.argValues {
+		b.AddArgValue(key, value)
+	}
+	if err := b.PreBuild(); err != nil {
+		return nil, err
+	}
+	if err := b.ArgsBuilder.Build(); err != nil {
+		return nil, err
+	}
+	return NewJob(b.args.Name, types.SeldonServingJob, b.args), nil
+}
@@ -153,6 +153,56 @@ func (b *UpdateTFServingJobBuilder) ModelPath(path string) *UpdateTFServingJobBu
 	return b
 }
 
+// MinReplicas is used to set the minimum replicas of the autoscaler,match option --min-replicas
+func (b *UpdateTFServingJobBuilder) MinReplicas(minReplicas int) *UpdateTFServingJobBuilder {
+	if minReplicas > 0 {
+		b.args.MinReplicas = minReplicas
+	}
+	return b
+}
+
+// MaxReplicas is used to set the maximum replicas of the autoscaler,match option --max-replicas,
+// it must be set if the serving job has no autoscaler
+func (b *UpdateTFServingJobBuilder) MaxReplicas(maxReplicas int) *UpdateTFServingJobBuilder {
+	if maxReplicas > 0 {
+		b.args.MaxReplicas = maxReplicas
+	}
+	return b
+}
+
+// ScaleMetric is used to set the metric watched by the autoscaler,possible values are cpu, memory or a custom pod metric,
+// match option --scale-metric
+func (b *UpdateTFServingJobBuilder) ScaleMetric(scaleMetric string) *UpdateTFServingJobBuilder {
+	if scaleMetric != "" {
+		b.args.ScaleMetric = scaleMetric
+	}
+	return b
+}
+
+// ScaleTarget is used to set the target value of the scaling metric,match option --scale-target
+func (b *UpdateTFServingJobBuilder) ScaleTarget(scaleTarget int) *UpdateTFServingJobBuilder {
+	if scaleTarget > 0 {
+		b.args.ScaleTarget = scaleTarget
+	}
+	return b
+}
+
+// ModelVersion is used to serve the version of the registered model,match the option --model-version
+func (b *UpdateTFServingJobBuilder) ModelVersion(version string) *UpdateTFServingJobBuilder {
+	if version != "" {
+		b.args.ModelVersion = version
+	}
+	return b
+}
+
+// ModelAlias is used to serve the registered model version with the alias,match the option --model-alias
+func (b *UpdateTFServingJobBuilder) ModelAlias(alias string) *UpdateTFServingJobBuilder {
+	if alias != "" {
+		b.args.ModelAlias = alias
+	}
+	return b
+}
+
 // Build is used to build the job
 func (b *UpdateTFServingJobBuilder) Build() (*Job, error) {
 	for key, value := range b.argValues {
@@ -0,0 +1,204 @@
+package serving
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/argsbuilder"
+)
+
+type UpdateTensorRTServingJobBuilder struct {
+	args      *types.UpdateTensorRTServingArgs
+	argValues map[string]interface{}
+	argsbuilder.ArgsBuilder
+}
+
+func NewUpdateTensorRTServingJobBuilder() *UpdateTensorRTServingJobBuilder {
+	args := &types.UpdateTensorRTServingArgs{}
+	return &UpdateTensorRTServingJobBuilder{
+		args:        args,
+		argValues:   map[string]interface{}{},
+		ArgsBuilder: argsbuilder.NewUpdateTensorRTServingArgsBuilder(args),
+	}
+}
+
+// Name is used to set job name,match option --name
+func (b *UpdateTensorRTServingJobBuilder) Name(name string) *UpdateTensorRTServingJobBuilder {
+	if name != "" {
+		b.args.Name = name
+	}
+	return b
+}
+
+// Namespace is used to set job namespace,match option --namespace
+func (b *UpdateTensorRTServingJobBuilder) Namespace(namespace string) *UpdateTensorRTServingJobBuilder {
+	if namespace != "" {
+		b.args.Namespace = namespace
+	}
+	return b
+}
+
+// Version is used to set serving job version, match the option --version
+func (b *UpdateTensorRTServingJobBuilder) Version(version string) *UpdateTensorRTServingJobBuilder {
+	if version != "" {
+		b.args.Version = version
+	}
+	return b
+}
+
+// Command is used to set job command
+func (b *UpdateTensorRTServingJobBuilder) Command(args []string) *UpdateTensorRTServingJobBuilder {
+	if b.args.Command == "" {
+		b.args.Command = strings.Join(args, " ")
+	}
+	return b
+}
+
+// Image is used to set job image,match the option --image
+func (b *UpdateTensorRTServingJobBuilder) Image(image string) *UpdateTensorRTServingJobBuilder {
+	if image != "" {
+		b.args.Image = image
+	}
+	return b
+}
+
+// Envs is used to set env of job containers,match option --env
+func (b *UpdateTensorRTServingJobBuilder) Envs(envs map[string]string) *UpdateTensorRTServingJobBuilder {
+	if len(envs) != 0 {
+		envSlice := []string{}
+		for key, value := range envs {
+			envSlice = append(envSlice, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["env"] = &envSlice
+	}
+	return b
+}
+
+// Tolerations are used to set tolerations for tolerate nodes, match option --toleration
+func (b *UpdateTensorRTServingJobBuilder) Tolerations(tolerations []string) *UpdateTensorRTServingJobBuilder {
+	b.argValues["toleration"] = &tolerations
+	return b
+}
+
+// NodeSelectors is used to set node selectors for scheduling job, match option --selector
+func (b *UpdateTensorRTServingJobBuilder) NodeSelectors(selectors map[string]string) *UpdateTensorRTServingJobBuilder {
+	if len(selectors) != 0 {
+		selectorsSlice := []string{}
+		for key, value := range selectors {
+			selectorsSlice = append(selectorsSlice, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["selector"] = &selectorsSlice
+	}
+	return b
+}
+
+// Annotations is used to add annotations for job pods,match option --annotation
+func (b *UpdateTensorRTServingJobBuilder) Annotations(annotations map[string]string) *UpdateTensorRTServingJobBuilder {
+	if len(annotations) != 0 {
+		s := []string{}
+		for key, value := range annotations {
+			s = append(s, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["annotation"] = &s
+	}
+	return b
+}
+
+// Labels is used to add labels for job
+func (b *UpdateTensorRTServingJobBuilder) Labels(labels map[string]string) *UpdateTensorRTServingJobBuilder {
+	if len(labels) != 0 {
+		s := []string{}
+		for key, value := range labels {
+			s = append(s, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["label"] = &s
+	}
+	return b
+}
+
+// Replicas is used to set serving job replicas,match the option --replicas
+func (b *UpdateTensorRTServingJobBuilder) Replicas(count int) *UpdateTensorRTServingJobBuilder {
+	if count > 0 {
+		b.args.Replicas = count
+	}
+	return b
+}
+
+// GPUCount is used to set gpu count for the job,match the option --gpus
+func (b *UpdateTensorRTServingJobBuilder) GPUCount(count int) *UpdateTensorRTServingJobBuilder {
+	if count > 0 {
+		b.args.GPUCount = count
+	}
+	return b
+}
+
+// GPUMemory is used to set gpu memory for the job,match the option --gpumemory
+func (b *UpdateTensorRTServingJobBuilder) GPUMemory(memory int) *UpdateTensorRTServingJobBuilder {
+	if memory > 0 {
+		b.args.GPUMemory = memory
+	}
+	return b
+}
+
+// GPUCore is used to set gpu core for the job, match the option --gpucore
+func (b *UpdateTensorRTServingJobBuilder) GPUCore(core int) *UpdateTensorRTServingJobBuilder {
+	if core > 0 {
+		b.args.GPUCore = core
+	}
+	return b
+}
+
+// CPU assign cpu limits,match the option --cpu
+func (b *UpdateTensorRTServingJobBuilder) CPU(cpu string) *UpdateTensorRTServingJobBuilder {
+	if cpu != "" {
+		b.args.Cpu = cpu
+	}
+	return b
+}
+
+// Memory assign memory limits,match option --memory
+func (b *UpdateTensorRTServingJobBuilder) Memory(memory string) *UpdateTensorRTServingJobBuilder {
+	if memory != "" {
+		b.args.Memory = memory
+	}
+	return b
+}
+
+// ModelStore is used to set model store,match the option --model-store
+func (b *UpdateTensorRTServingJobBuilder) ModelStore(modelStore string) *UpdateTensorRTServingJobBuilder {
+	if modelStore != "" {
+		b.args.ModelStore = modelStore
+	}
+	return b
+}
+
+// ModelVersion is used to serve the version of the registered model,match the option --model-version
+func (b *UpdateTensorRTServingJobBuilder) ModelVersion(version string) *UpdateTensorRTServingJobBuilder {
+	if version != "" {
+		b.args.ModelVersion = version
+	}
+	return b
+}
+
+// ModelAlias is used to serve the registered model version with the alias,match the option --model-alias
+func (b *UpdateTensorRTServingJobBuilder) ModelAlias(alias string) *UpdateTensorRTServingJobBuilder {
+	if alias != "" {
+		b.args.ModelAlias = alias
+	}
+	return b
+}
+
+// Build is used to build the job
+func (b *UpdateTensorRTServingJobBuilder) Build() (*Job, error) {
+	for key, value := range b.argValues {
+		b.AddArgValue(key, value)
+	}
+	if err := b.PreBuild(); err != nil {
+		return nil, err
+	}
+	if err := b.ArgsBuilder.Build(); err != nil {
+		return nil, err
+	}
+	return NewJob(b.args.Name, types.TRTServingJob, b.args), nil
+}
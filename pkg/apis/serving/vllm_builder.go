@@ -0,0 +1,352 @@
+package serving
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/argsbuilder"
+)
+
+type VLLMServingJobBuilder struct {
+	args      *types.VLLMServingArgs
+	argValues map[string]interface{}
+	argsbuilder.ArgsBuilder
+}
+
+func NewVLLMServingJobBuilder() *VLLMServingJobBuilder {
+	args := &types.VLLMServingArgs{
+		Port: 8000,
+		CommonServingArgs: types.CommonServingArgs{
+			ImagePullPolicy: "IfNotPresent",
+			Replicas:        1,
+			Namespace:       "default",
+			Shell:           "sh",
+		},
+	}
+	return &VLLMServingJobBuilder{
+		args:        args,
+		argValues:   map[string]interface{}{},
+		ArgsBuilder: argsbuilder.NewVLLMServingArgsBuilder(args),
+	}
+}
+
+// Name is used to set job name,match option --name
+func (b *VLLMServingJobBuilder) Name(name string) *VLLMServingJobBuilder {
+	if name != "" {
+		b.args.Name = name
+	}
+	return b
+}
+
+// Namespace is used to set job namespace,match option --namespace
+func (b *VLLMServingJobBuilder) Namespace(namespace string) *VLLMServingJobBuilder {
+	if namespace != "" {
+		b.args.Namespace = namespace
+	}
+	return b
+}
+
+// Shell is used to set bash or sh
+func (b *VLLMServingJobBuilder) Shell(shell string) *VLLMServingJobBuilder {
+	if shell != "" {
+		b.args.Shell = shell
+	}
+	return b
+}
+
+// Command is used to set job command
+func (b *VLLMServingJobBuilder) Command(args []string) *VLLMServingJobBuilder {
+	if b.args.Command == "" {
+		b.args.Command = strings.Join(args, " ")
+	}
+	return b
+}
+
+// GPUCount is used to set count of gpu for the job,match the option --gpus
+func (b *VLLMServingJobBuilder) GPUCount(count int) *VLLMServingJobBuilder {
+	if count > 0 {
+		b.args.GPUCount = count
+	}
+	return b
+}
+
+// GPUMemory is used to set gpu memory for the job,match the option --gpumemory
+func (b *VLLMServingJobBuilder) GPUMemory(memory int) *VLLMServingJobBuilder {
+	if memory > 0 {
+		b.args.GPUMemory = memory
+	}
+	return b
+}
+
+// GPUCore is used to set gpu core for the job,match the option --gpucore
+func (b *VLLMServingJobBuilder) GPUCore(core int) *VLLMServingJobBuilder {
+	if core > 0 {
+		b.args.GPUCore = core
+	}
+	return b
+}
+
+// Image is used to set job image,match the option --image
+func (b *VLLMServingJobBuilder) Image(image string) *VLLMServingJobBuilder {
+	if image != "" {
+		b.args.Image = image
+	}
+	return b
+}
+
+// ImagePullPolicy is used to set image pull policy,match the option --image-pull-policy
+func (b *VLLMServingJobBuilder) ImagePullPolicy(policy string) *VLLMServingJobBuilder {
+	if policy != "" {
+		b.args.ImagePullPolicy = policy
+	}
+	return b
+}
+
+// CPU assign cpu limits,match the option --cpu
+func (b *VLLMServingJobBuilder) CPU(cpu string) *VLLMServingJobBuilder {
+	if cpu != "" {
+		b.args.Cpu = cpu
+	}
+	return b
+}
+
+// Memory assign memory limits,match option --memory
+func (b *VLLMServingJobBuilder) Memory(memory string) *VLLMServingJobBuilder {
+	if memory != "" {
+		b.args.Memory = memory
+	}
+	return b
+}
+
+// Envs is used to set env of job containers,match option --env
+func (b *VLLMServingJobBuilder) Envs(envs map[string]string) *VLLMServingJobBuilder {
+	if len(envs) != 0 {
+		envSlice := []string{}
+		for key, value := range envs {
+			envSlice = append(envSlice, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["env"] = &envSlice
+	}
+	return b
+}
+
+// Replicas is used to set serving job replicas,match the option --replicas
+func (b *VLLMServingJobBuilder) Replicas(count int) *VLLMServingJobBuilder {
+	if count > 0 {
+		b.args.Replicas = count
+	}
+	return b
+}
+
+// EnableIstio is used to enable istio,match the option --enable-istio
+func (b *VLLMServingJobBuilder) EnableIstio() *VLLMServingJobBuilder {
+	b.args.EnableIstio = true
+	return b
+}
+
+// ExposeService is used to expose service,match the option --expose-service
+func (b *VLLMServingJobBuilder) ExposeService() *VLLMServingJobBuilder {
+	b.args.ExposeService = true
+	return b
+}
+
+// Version is used to set serving job version,match the option --version
+func (b *VLLMServingJobBuilder) Version(version string) *VLLMServingJobBuilder {
+	if version != "" {
+		b.args.Version = version
+	}
+	return b
+}
+
+// Tolerations is used to set tolerations for tolerate nodes,match option --toleration
+func (b *VLLMServingJobBuilder) Tolerations(tolerations []string) *VLLMServingJobBuilder {
+	b.argValues["toleration"] = &tolerations
+	return b
+}
+
+// NodeSelectors is used to set node selectors for scheduling job,match option --selector
+func (b *VLLMServingJobBuilder) NodeSelectors(selectors map[string]string) *VLLMServingJobBuilder {
+	if len(selectors) != 0 {
+		selectorsSlice := []string{}
+		for key, value := range selectors {
+			selectorsSlice = append(selectorsSlice, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["selector"] = &selectorsSlice
+	}
+	return b
+}
+
+// Annotations is used to add annotations for job pods,match option --annotation
+func (b *VLLMServingJobBuilder) Annotations(annotations map[string]string) *VLLMServingJobBuilder {
+	if len(annotations) != 0 {
+		s := []string{}
+		for key, value := range annotations {
+			s = append(s, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["annotation"] = &s
+	}
+	return b
+}
+
+// Labels is used to add labels for job
+func (b *VLLMServingJobBuilder) Labels(labels map[string]string) *VLLMServingJobBuilder {
+	if len(labels) != 0 {
+		s := []string{}
+		for key, value := range labels {
+			s = append(s, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["label"] = &s
+	}
+	return b
+}
+
+// Datas is used to mount k8s pvc to job pods,match option --data
+func (b *VLLMServingJobBuilder) Datas(volumes map[string]string) *VLLMServingJobBuilder {
+	if len(volumes) != 0 {
+		s := []string{}
+		for key, value := range volumes {
+			s = append(s, fmt.Sprintf("%v:%v", key, value))
+		}
+		b.argValues["data"] = &s
+	}
+	return b
+}
+
+// DataSubPathExprs is used to mount k8s pvc subpath to job pods,match option data-subpath-expr
+func (b *VLLMServingJobBuilder) DataSubPathExprs(exprs map[string]string) *VLLMServingJobBuilder {
+	if len(exprs) != 0 {
+		s := []string{}
+		for key, value := range exprs {
+			s = append(s, fmt.Sprintf("%v:%v", key, value))
+		}
+		b.argValues["data-subpath-expr"] = &s
+	}
+	return b
+}
+
+// TempDirs specify the deployment empty dir
+func (b *VLLMServingJobBuilder) TempDirs(volumes map[string]string) *VLLMServingJobBuilder {
+	if len(volumes) != 0 {
+		s := []string{}
+		for key, value := range volumes {
+			s = append(s, fmt.Sprintf("%v:%v", key, value))
+		}
+		b.argValues["temp-dir"] = &s
+	}
+	return b
+}
+
+// EmptyDirSubPathExprs specify the datasource subpath to mount to the pod by expression
+func (b *VLLMServingJobBuilder) EmptyDirSubPathExprs(exprs map[string]string) *VLLMServingJobBuilder {
+	if len(exprs) != 0 {
+		s := []string{}
+		for key, value := range exprs {
+			s = append(s, fmt.Sprintf("%v:%v", key, value))
+		}
+		b.argValues["temp-dir-subpath-expr"] = &s
+	}
+	return b
+}
+
+// DataDirs is used to mount host files to job containers,match option --data-dir
+func (b *VLLMServingJobBuilder) DataDirs(volumes map[string]string) *VLLMServingJobBuilder {
+	if len(volumes) != 0 {
+		s := []string{}
+		for key, value := range volumes {
+			s = append(s, fmt.Sprintf("%v:%v", key, value))
+		}
+		b.argValues["data-dir"] = &s
+	}
+	return b
+}
+
+// ModelPath is used to set the path of the model in the container,match the option --model-path
+func (b *VLLMServingJobBuilder) ModelPath(path string) *VLLMServingJobBuilder {
+	if path != "" {
+		b.args.ModelPath = path
+	}
+	return b
+}
+
+// ModelID is used to set the huggingface model id,match the option --model-id
+func (b *VLLMServingJobBuilder) ModelID(id string) *VLLMServingJobBuilder {
+	if id != "" {
+		b.args.ModelID = id
+	}
+	return b
+}
+
+// ServedModelName is used to set the model name in the api requests,match the option --served-model-name
+func (b *VLLMServingJobBuilder) ServedModelName(name string) *VLLMServingJobBuilder {
+	if name != "" {
+		b.args.ServedModelName = name
+	}
+	return b
+}
+
+// Port is used to set the port of the OpenAI compatible api server,match the option --port
+func (b *VLLMServingJobBuilder) Port(port int) *VLLMServingJobBuilder {
+	if port > 0 {
+		b.args.Port = port
+	}
+	return b
+}
+
+// TensorParallelSize is used to set the number of GPUs for tensor parallelism,match the option --tensor-parallel-size
+func (b *VLLMServingJobBuilder) TensorParallelSize(size int) *VLLMServingJobBuilder {
+	if size > 0 {
+		b.args.TensorParallelSize = size
+	}
+	return b
+}
+
+// MaxModelLen is used to set the max context length of the model,match the option --max-model-len
+func (b *VLLMServingJobBuilder) MaxModelLen(length int) *VLLMServingJobBuilder {
+	if length > 0 {
+		b.args.MaxModelLen = length
+	}
+	return b
+}
+
+// Quantization is used to set the quantization method of the weights,match the option --quantization
+func (b *VLLMServingJobBuilder) Quantization(quantization string) *VLLMServingJobBuilder {
+	if quantization != "" {
+		b.args.Quantization = quantization
+	}
+	return b
+}
+
+// ExtendCommand is used to append the options to the server command,match the option --extend-command
+func (b *VLLMServingJobBuilder) ExtendCommand(command string) *VLLMServingJobBuilder {
+	if command != "" {
+		b.args.ExtendCommand = command
+	}
+	return b
+}
+
+// ConfigFiles is used to mapping config files form local to job containers,match option --config-file
+func (b *VLLMServingJobBuilder) ConfigFiles(files map[string]string) *VLLMServingJobBuilder {
+	if len(files) != 0 {
+		filesSlice := []string{}
+		for localPath, containerPath := range files {
+			filesSlice = append(filesSlice, fmt.Sprintf("%v:%v", localPath, containerPath))
+		}
+		b.argValues["config-file"] = &filesSlice
+	}
+	return b
+}
+
+// Build is used to build the job
+func (b *VLLMServingJobBuilder) Build() (*Job, error) {
+	for key, value := range b.argValues {
+		b.AddArgValue(key, value)
+	}
+	if err := b.PreBuild(); err != nil {
+		return nil, err
+	}
+	if err := b.ArgsBuilder.Build(); err != nil {
+		return nil, err
+	}
+	return NewJob(b.args.Name, types.VLLMServingJob, b.args), nil
+}
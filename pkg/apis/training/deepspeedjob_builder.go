@@ -40,6 +40,11 @@ func NewDeepSpeedJobBuilder() *DeepSpeedJobBuilder {
 	}
 }
 
+// GetArgs returns the submit args of the builder,they can be filled by a job spec file
+func (b *DeepSpeedJobBuilder) GetArgs() *types.SubmitDeepSpeedJobArgs {
+	return b.args
+}
+
 // Name is used to set job name,match option --name
 func (b *DeepSpeedJobBuilder) Name(name string) *DeepSpeedJobBuilder {
 	if name != "" {
@@ -28,6 +28,11 @@ func NewETJobBuilder() *ETJobBuilder {
 	}
 }
 
+// GetArgs returns the submit args of the builder,they can be filled by a job spec file
+func (b *ETJobBuilder) GetArgs() *types.SubmitETJobArgs {
+	return b.args
+}
+
 // Name is used to set job name,match option --name
 func (b *ETJobBuilder) Name(name string) *ETJobBuilder {
 	if name != "" {
@@ -26,6 +26,11 @@ func NewHorovodJobBuilder() *HorovodJobBuilder {
 	}
 }
 
+// GetArgs returns the submit args of the builder,they can be filled by a job spec file
+func (b *HorovodJobBuilder) GetArgs() *types.SubmitHorovodJobArgs {
+	return b.args
+}
+
 // Name is used to set job name,match option --name
 func (b *HorovodJobBuilder) Name(name string) *HorovodJobBuilder {
 	if name != "" {
@@ -0,0 +1,251 @@
+package training
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/argsbuilder"
+)
+
+type JAXJobBuilder struct {
+	args      *types.SubmitJAXJobArgs
+	argValues map[string]interface{}
+	argsbuilder.ArgsBuilder
+}
+
+func NewJAXJobBuilder() *JAXJobBuilder {
+	args := &types.SubmitJAXJobArgs{
+		SubmitTrainingOperatorArgs: types.SubmitTrainingOperatorArgs{
+			CleanPodPolicy: "Running",
+		},
+		CommonSubmitArgs: DefaultCommonSubmitArgs,
+	}
+	return &JAXJobBuilder{
+		args:        args,
+		argValues:   map[string]interface{}{},
+		ArgsBuilder: argsbuilder.NewSubmitJAXJobArgsBuilder(args),
+	}
+}
+
+// GetArgs returns the submit args of the builder,they can be filled by a job spec file
+func (b *JAXJobBuilder) GetArgs() *types.SubmitJAXJobArgs {
+	return b.args
+}
+
+// Name is used to set job name,match option --name
+func (b *JAXJobBuilder) Name(name string) *JAXJobBuilder {
+	if name != "" {
+		b.args.Name = name
+	}
+	return b
+}
+
+// Shell is used to set bash or sh
+func (b *JAXJobBuilder) Shell(shell string) *JAXJobBuilder {
+	if shell != "" {
+		b.args.Shell = shell
+	}
+	return b
+}
+
+// Command is used to set job command
+func (b *JAXJobBuilder) Command(args []string) *JAXJobBuilder {
+	if b.args.Command == "" {
+		b.args.Command = strings.Join(args, " ")
+	}
+	return b
+}
+
+// WorkingDir is used to set working directory of job containers,default is '/root'
+// match option --working-dir
+func (b *JAXJobBuilder) WorkingDir(dir string) *JAXJobBuilder {
+	if dir != "" {
+		b.args.WorkingDir = dir
+	}
+	return b
+}
+
+// Envs is used to set env of job containers,match option --env
+func (b *JAXJobBuilder) Envs(envs map[string]string) *JAXJobBuilder {
+	if len(envs) != 0 {
+		envSlice := []string{}
+		for key, value := range envs {
+			envSlice = append(envSlice, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["env"] = &envSlice
+	}
+	return b
+}
+
+// GPUCount is used to set count of gpu for every replica of the job,match the option --gpus
+func (b *JAXJobBuilder) GPUCount(count int) *JAXJobBuilder {
+	if count > 0 {
+		b.args.GPUCount = count
+	}
+	return b
+}
+
+// Image is used to set job image,match the option --image
+func (b *JAXJobBuilder) Image(image string) *JAXJobBuilder {
+	if image != "" {
+		b.args.Image = image
+	}
+	return b
+}
+
+// Tolerations is used to set tolerations for tolerate nodes,match option --toleration
+func (b *JAXJobBuilder) Tolerations(tolerations []string) *JAXJobBuilder {
+	b.argValues["toleration"] = &tolerations
+	return b
+}
+
+// ConfigFiles is used to mapping config files form local to job containers,match option --config-file
+func (b *JAXJobBuilder) ConfigFiles(files map[string]string) *JAXJobBuilder {
+	if len(files) != 0 {
+		filesSlice := []string{}
+		for localPath, containerPath := range files {
+			filesSlice = append(filesSlice, fmt.Sprintf("%v:%v", localPath, containerPath))
+		}
+		b.argValues["config-file"] = &filesSlice
+	}
+	return b
+}
+
+// NodeSelectors is used to set node selectors for scheduling job,match option --selector
+func (b *JAXJobBuilder) NodeSelectors(selectors map[string]string) *JAXJobBuilder {
+	if len(selectors) != 0 {
+		selectorsSlice := []string{}
+		for key, value := range selectors {
+			selectorsSlice = append(selectorsSlice, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["selector"] = &selectorsSlice
+	}
+	return b
+}
+
+// Annotations is used to add annotations for job pods,match option --annotation
+func (b *JAXJobBuilder) Annotations(annotations map[string]string) *JAXJobBuilder {
+	if len(annotations) != 0 {
+		s := []string{}
+		for key, value := range annotations {
+			s = append(s, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["annotation"] = &s
+	}
+	return b
+}
+
+// Labels is used to add labels for job
+func (b *JAXJobBuilder) Labels(labels map[string]string) *JAXJobBuilder {
+	if len(labels) != 0 {
+		s := []string{}
+		for key, value := range labels {
+			s = append(s, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["label"] = &s
+	}
+	return b
+}
+
+// Datas is used to mount k8s pvc to job pods,match option --data
+func (b *JAXJobBuilder) Datas(volumes map[string]string) *JAXJobBuilder {
+	if len(volumes) != 0 {
+		s := []string{}
+		for key, value := range volumes {
+			s = append(s, fmt.Sprintf("%v:%v", key, value))
+		}
+		b.argValues["data"] = &s
+	}
+	return b
+}
+
+// DataDirs is used to mount host files to job containers,match option --data-dir
+func (b *JAXJobBuilder) DataDirs(volumes map[string]string) *JAXJobBuilder {
+	if len(volumes) != 0 {
+		s := []string{}
+		for key, value := range volumes {
+			s = append(s, fmt.Sprintf("%v:%v", key, value))
+		}
+		b.argValues["data-dir"] = &s
+	}
+	return b
+}
+
+// Priority sets the priority
+func (b *JAXJobBuilder) Priority(priority string) *JAXJobBuilder {
+	if priority != "" {
+		b.args.PriorityClassName = priority
+	}
+	return b
+}
+
+// ImagePullSecrets is used to set image pull secrests,match option --image-pull-secret
+func (b *JAXJobBuilder) ImagePullSecrets(secrets []string) *JAXJobBuilder {
+	if secrets != nil {
+		b.argValues["image-pull-secret"] = &secrets
+	}
+	return b
+}
+
+// CleanPodPolicy is used to set cleaning pod policy,match option --clean-task-policy
+func (b *JAXJobBuilder) CleanPodPolicy(policy string) *JAXJobBuilder {
+	if policy != "" {
+		b.args.CleanPodPolicy = policy
+	}
+	return b
+}
+
+// WorkerCount is used to set count of worker,match option --workers
+func (b *JAXJobBuilder) WorkerCount(count int) *JAXJobBuilder {
+	if count > 0 {
+		b.args.WorkerCount = count
+	}
+	return b
+}
+
+// CPU assign cpu limits,match option --cpu
+func (b *JAXJobBuilder) CPU(cpu string) *JAXJobBuilder {
+	if cpu != "" {
+		b.args.Cpu = cpu
+	}
+	return b
+}
+
+// Memory assign memory limits,match option --memory
+func (b *JAXJobBuilder) Memory(memory string) *JAXJobBuilder {
+	if memory != "" {
+		b.args.Memory = memory
+	}
+	return b
+}
+
+// ActiveDeadlineSeconds match option --running-timeout
+func (b *JAXJobBuilder) ActiveDeadlineSeconds(act int64) *JAXJobBuilder {
+	if act > 0 {
+		b.args.ActiveDeadlineSeconds = act
+	}
+	return b
+}
+
+// TTLSecondsAfterFinished match option --ttl-after-finished
+func (b *JAXJobBuilder) TTLSecondsAfterFinished(ttl int32) *JAXJobBuilder {
+	if ttl > 0 {
+		b.args.TTLSecondsAfterFinished = ttl
+	}
+	return b
+}
+
+// Build is used to build the job
+func (b *JAXJobBuilder) Build() (*Job, error) {
+	for key, value := range b.argValues {
+		b.AddArgValue(key, value)
+	}
+	if err := b.PreBuild(); err != nil {
+		return nil, err
+	}
+	if err := b.ArgsBuilder.Build(); err != nil {
+		return nil, err
+	}
+	return NewJob(b.args.Name, types.JAXTrainingJob, b.args), nil
+}
@@ -0,0 +1,47 @@
+package training
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+)
+
+// LoadJobSpecFile reads a training job spec from a yaml or json file
+func LoadJobSpecFile(file string) (*types.TrainingJobSpec, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read job spec file %v: %v", file, err)
+	}
+	spec := &types.TrainingJobSpec{}
+	// json is a subset of yaml,so both formats can be parsed by yaml
+	if err := yaml.Unmarshal(content, spec); err != nil {
+		return nil, fmt.Errorf("failed to parse job spec file %v: %v", file, err)
+	}
+	if spec.Kind == "" {
+		return nil, fmt.Errorf("the kind of job spec file %v must be set", file)
+	}
+	jobType := utils.TransferTrainingJobType(string(spec.Kind))
+	if jobType == types.AllTrainingJob || jobType == types.UnknownTrainingJob {
+		return nil, fmt.Errorf("unknown kind %v in job spec file %v,arena only supports: [%v]", spec.Kind, file, utils.GetSupportTrainingJobTypesInfo())
+	}
+	spec.Kind = jobType
+	return spec, nil
+}
+
+// DecodeJobSpec decodes the spec into the submit args,args must be a pointer
+// to the submit args type matching the kind of spec,like *types.SubmitTFJobArgs.
+// The fields which are not given by the spec keep their values.
+func DecodeJobSpec(spec *types.TrainingJobSpec, args interface{}) error {
+	if len(spec.Spec) == 0 {
+		return nil
+	}
+	content, err := yaml.Marshal(spec.Spec)
+	if err != nil {
+		return err
+	}
+	return yaml.Unmarshal(content, args)
+}
@@ -27,6 +27,11 @@ func NewMPIJobBuilder() *MPIJobBuilder {
 	}
 }
 
+// GetArgs returns the submit args of the builder,they can be filled by a job spec file
+func (b *MPIJobBuilder) GetArgs() *types.SubmitMPIJobArgs {
+	return b.args
+}
+
 // Name is used to set job name,match option --name
 func (b *MPIJobBuilder) Name(name string) *MPIJobBuilder {
 	if name != "" {
@@ -0,0 +1,251 @@
+package training
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/argsbuilder"
+)
+
+type PaddleJobBuilder struct {
+	args      *types.SubmitPaddleJobArgs
+	argValues map[string]interface{}
+	argsbuilder.ArgsBuilder
+}
+
+func NewPaddleJobBuilder() *PaddleJobBuilder {
+	args := &types.SubmitPaddleJobArgs{
+		SubmitTrainingOperatorArgs: types.SubmitTrainingOperatorArgs{
+			CleanPodPolicy: "Running",
+		},
+		CommonSubmitArgs: DefaultCommonSubmitArgs,
+	}
+	return &PaddleJobBuilder{
+		args:        args,
+		argValues:   map[string]interface{}{},
+		ArgsBuilder: argsbuilder.NewSubmitPaddleJobArgsBuilder(args),
+	}
+}
+
+// GetArgs returns the submit args of the builder,they can be filled by a job spec file
+func (b *PaddleJobBuilder) GetArgs() *types.SubmitPaddleJobArgs {
+	return b.args
+}
+
+// Name is used to set job name,match option --name
+func (b *PaddleJobBuilder) Name(name string) *PaddleJobBuilder {
+	if name != "" {
+		b.args.Name = name
+	}
+	return b
+}
+
+// Shell is used to set bash or sh
+func (b *PaddleJobBuilder) Shell(shell string) *PaddleJobBuilder {
+	if shell != "" {
+		b.args.Shell = shell
+	}
+	return b
+}
+
+// Command is used to set job command
+func (b *PaddleJobBuilder) Command(args []string) *PaddleJobBuilder {
+	if b.args.Command == "" {
+		b.args.Command = strings.Join(args, " ")
+	}
+	return b
+}
+
+// WorkingDir is used to set working directory of job containers,default is '/root'
+// match option --working-dir
+func (b *PaddleJobBuilder) WorkingDir(dir string) *PaddleJobBuilder {
+	if dir != "" {
+		b.args.WorkingDir = dir
+	}
+	return b
+}
+
+// Envs is used to set env of job containers,match option --env
+func (b *PaddleJobBuilder) Envs(envs map[string]string) *PaddleJobBuilder {
+	if len(envs) != 0 {
+		envSlice := []string{}
+		for key, value := range envs {
+			envSlice = append(envSlice, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["env"] = &envSlice
+	}
+	return b
+}
+
+// GPUCount is used to set count of gpu for every replica of the job,match the option --gpus
+func (b *PaddleJobBuilder) GPUCount(count int) *PaddleJobBuilder {
+	if count > 0 {
+		b.args.GPUCount = count
+	}
+	return b
+}
+
+// Image is used to set job image,match the option --image
+func (b *PaddleJobBuilder) Image(image string) *PaddleJobBuilder {
+	if image != "" {
+		b.args.Image = image
+	}
+	return b
+}
+
+// Tolerations is used to set tolerations for tolerate nodes,match option --toleration
+func (b *PaddleJobBuilder) Tolerations(tolerations []string) *PaddleJobBuilder {
+	b.argValues["toleration"] = &tolerations
+	return b
+}
+
+// ConfigFiles is used to mapping config files form local to job containers,match option --config-file
+func (b *PaddleJobBuilder) ConfigFiles(files map[string]string) *PaddleJobBuilder {
+	if len(files) != 0 {
+		filesSlice := []string{}
+		for localPath, containerPath := range files {
+			filesSlice = append(filesSlice, fmt.Sprintf("%v:%v", localPath, containerPath))
+		}
+		b.argValues["config-file"] = &filesSlice
+	}
+	return b
+}
+
+// NodeSelectors is used to set node selectors for scheduling job,match option --selector
+func (b *PaddleJobBuilder) NodeSelectors(selectors map[string]string) *PaddleJobBuilder {
+	if len(selectors) != 0 {
+		selectorsSlice := []string{}
+		for key, value := range selectors {
+			selectorsSlice = append(selectorsSlice, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["selector"] = &selectorsSlice
+	}
+	return b
+}
+
+// Annotations is used to add annotations for job pods,match option --annotation
+func (b *PaddleJobBuilder) Annotations(annotations map[string]string) *PaddleJobBuilder {
+	if len(annotations) != 0 {
+		s := []string{}
+		for key, value := range annotations {
+			s = append(s, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["annotation"] = &s
+	}
+	return b
+}
+
+// Labels is used to add labels for job
+func (b *PaddleJobBuilder) Labels(labels map[string]string) *PaddleJobBuilder {
+	if len(labels) != 0 {
+		s := []string{}
+		for key, value := range labels {
+			s = append(s, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["label"] = &s
+	}
+	return b
+}
+
+// Datas is used to mount k8s pvc to job pods,match option --data
+func (b *PaddleJobBuilder) Datas(volumes map[string]string) *PaddleJobBuilder {
+	if len(volumes) != 0 {
+		s := []string{}
+		for key, value := range volumes {
+			s = append(s, fmt.Sprintf("%v:%v", key, value))
+		}
+		b.argValues["data"] = &s
+	}
+	return b
+}
+
+// DataDirs is used to mount host files to job containers,match option --data-dir
+func (b *PaddleJobBuilder) DataDirs(volumes map[string]string) *PaddleJobBuilder {
+	if len(volumes) != 0 {
+		s := []string{}
+		for key, value := range volumes {
+			s = append(s, fmt.Sprintf("%v:%v", key, value))
+		}
+		b.argValues["data-dir"] = &s
+	}
+	return b
+}
+
+// Priority sets the priority
+func (b *PaddleJobBuilder) Priority(priority string) *PaddleJobBuilder {
+	if priority != "" {
+		b.args.PriorityClassName = priority
+	}
+	return b
+}
+
+// ImagePullSecrets is used to set image pull secrests,match option --image-pull-secret
+func (b *PaddleJobBuilder) ImagePullSecrets(secrets []string) *PaddleJobBuilder {
+	if secrets != nil {
+		b.argValues["image-pull-secret"] = &secrets
+	}
+	return b
+}
+
+// CleanPodPolicy is used to set cleaning pod policy,match option --clean-task-policy
+func (b *PaddleJobBuilder) CleanPodPolicy(policy string) *PaddleJobBuilder {
+	if policy != "" {
+		b.args.CleanPodPolicy = policy
+	}
+	return b
+}
+
+// WorkerCount is used to set count of worker,match option --workers
+func (b *PaddleJobBuilder) WorkerCount(count int) *PaddleJobBuilder {
+	if count > 0 {
+		b.args.WorkerCount = count
+	}
+	return b
+}
+
+// CPU assign cpu limits,match option --cpu
+func (b *PaddleJobBuilder) CPU(cpu string) *PaddleJobBuilder {
+	if cpu != "" {
+		b.args.Cpu = cpu
+	}
+	return b
+}
+
+// Memory assign memory limits,match option --memory
+func (b *PaddleJobBuilder) Memory(memory string) *PaddleJobBuilder {
+	if memory != "" {
+		b.args.Memory = memory
+	}
+	return b
+}
+
+// ActiveDeadlineSeconds match option --running-timeout
+func (b *PaddleJobBuilder) ActiveDeadlineSeconds(act int64) *PaddleJobBuilder {
+	if act > 0 {
+		b.args.ActiveDeadlineSeconds = act
+	}
+	return b
+}
+
+// TTLSecondsAfterFinished match option --ttl-after-finished
+func (b *PaddleJobBuilder) TTLSecondsAfterFinished(ttl int32) *PaddleJobBuilder {
+	if ttl > 0 {
+		b.args.TTLSecondsAfterFinished = ttl
+	}
+	return b
+}
+
+// Build is used to build the job
+func (b *PaddleJobBuilder) Build() (*Job, error) {
+	for key, value := range b.argValues {
+		b.AddArgValue(key, value)
+	}
+	if err := b.PreBuild(); err != nil {
+		return nil, err
+	}
+	if err := b.ArgsBuilder.Build(); err != nil {
+		return nil, err
+	}
+	return NewJob(b.args.Name, types.PaddleTrainingJob, b.args), nil
+}
@@ -27,6 +27,11 @@ func NewPytorchJobBuilder() *PytorchJobBuilder {
 	}
 }
 
+// GetArgs returns the submit args of the builder,they can be filled by a job spec file
+func (b *PytorchJobBuilder) GetArgs() *types.SubmitPyTorchJobArgs {
+	return b.args
+}
+
 // Name is used to set job name,match option --name
 func (b *PytorchJobBuilder) Name(name string) *PytorchJobBuilder {
 	if name != "" {
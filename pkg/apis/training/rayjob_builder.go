@@ -0,0 +1,281 @@
+package training
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/argsbuilder"
+)
+
+type RayJobBuilder struct {
+	args      *types.SubmitRayJobArgs
+	argValues map[string]interface{}
+	argsbuilder.ArgsBuilder
+}
+
+func NewRayJobBuilder() *RayJobBuilder {
+	args := &types.SubmitRayJobArgs{
+		ShutdownAfterJobFinishes: true,
+		CommonSubmitArgs:         DefaultCommonSubmitArgs,
+	}
+	return &RayJobBuilder{
+		args:        args,
+		argValues:   map[string]interface{}{},
+		ArgsBuilder: argsbuilder.NewSubmitRayJobArgsBuilder(args),
+	}
+}
+
+// GetArgs returns the submit args of the builder,they can be filled by a job spec file
+func (b *RayJobBuilder) GetArgs() *types.SubmitRayJobArgs {
+	return b.args
+}
+
+// Name is used to set job name,match option --name
+func (b *RayJobBuilder) Name(name string) *RayJobBuilder {
+	if name != "" {
+		b.args.Name = name
+	}
+	return b
+}
+
+// Command is used to set the entrypoint of the ray job
+func (b *RayJobBuilder) Command(args []string) *RayJobBuilder {
+	if b.args.Command == "" {
+		b.args.Command = strings.Join(args, " ")
+	}
+	return b
+}
+
+// WorkingDir is used to set working directory of job containers,default is '/root'
+// match option --working-dir
+func (b *RayJobBuilder) WorkingDir(dir string) *RayJobBuilder {
+	if dir != "" {
+		b.args.WorkingDir = dir
+	}
+	return b
+}
+
+// Envs is used to set env of job containers,match option --env
+func (b *RayJobBuilder) Envs(envs map[string]string) *RayJobBuilder {
+	if len(envs) != 0 {
+		envSlice := []string{}
+		for key, value := range envs {
+			envSlice = append(envSlice, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["env"] = &envSlice
+	}
+	return b
+}
+
+// GPUCount is used to set count of gpu for every ray worker,match the option --gpus
+func (b *RayJobBuilder) GPUCount(count int) *RayJobBuilder {
+	if count > 0 {
+		b.args.GPUCount = count
+	}
+	return b
+}
+
+// Image is used to set job image,match the option --image
+func (b *RayJobBuilder) Image(image string) *RayJobBuilder {
+	if image != "" {
+		b.args.Image = image
+	}
+	return b
+}
+
+// Tolerations is used to set tolerations for tolerate nodes,match option --toleration
+func (b *RayJobBuilder) Tolerations(tolerations []string) *RayJobBuilder {
+	b.argValues["toleration"] = &tolerations
+	return b
+}
+
+// ConfigFiles is used to mapping config files form local to job containers,match option --config-file
+func (b *RayJobBuilder) ConfigFiles(files map[string]string) *RayJobBuilder {
+	if len(files) != 0 {
+		filesSlice := []string{}
+		for localPath, containerPath := range files {
+			filesSlice = append(filesSlice, fmt.Sprintf("%v:%v", localPath, containerPath))
+		}
+		b.argValues["config-file"] = &filesSlice
+	}
+	return b
+}
+
+// NodeSelectors is used to set node selectors for scheduling job,match option --selector
+func (b *RayJobBuilder) NodeSelectors(selectors map[string]string) *RayJobBuilder {
+	if len(selectors) != 0 {
+		selectorsSlice := []string{}
+		for key, value := range selectors {
+			selectorsSlice = append(selectorsSlice, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["selector"] = &selectorsSlice
+	}
+	return b
+}
+
+// Annotations is used to add annotations for job pods,match option --annotation
+func (b *RayJobBuilder) Annotations(annotations map[string]string) *RayJobBuilder {
+	if len(annotations) != 0 {
+		s := []string{}
+		for key, value := range annotations {
+			s = append(s, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["annotation"] = &s
+	}
+	return b
+}
+
+// Labels is used to add labels for job
+func (b *RayJobBuilder) Labels(labels map[string]string) *RayJobBuilder {
+	if len(labels) != 0 {
+		s := []string{}
+		for key, value := range labels {
+			s = append(s, fmt.Sprintf("%v=%v", key, value))
+		}
+		b.argValues["label"] = &s
+	}
+	return b
+}
+
+// Datas is used to mount k8s pvc to job pods,match option --data
+func (b *RayJobBuilder) Datas(volumes map[string]string) *RayJobBuilder {
+	if len(volumes) != 0 {
+		s := []string{}
+		for key, value := range volumes {
+			s = append(s, fmt.Sprintf("%v:%v", key, value))
+		}
+		b.argValues["data"] = &s
+	}
+	return b
+}
+
+// DataDirs is used to mount host files to job containers,match option --data-dir
+func (b *RayJobBuilder) DataDirs(volumes map[string]string) *RayJobBuilder {
+	if len(volumes) != 0 {
+		s := []string{}
+		for key, value := range volumes {
+			s = append(s, fmt.Sprintf("%v:%v", key, value))
+		}
+		b.argValues["data-dir"] = &s
+	}
+	return b
+}
+
+// Priority sets the priority
+func (b *RayJobBuilder) Priority(priority string) *RayJobBuilder {
+	if priority != "" {
+		b.args.PriorityClassName = priority
+	}
+	return b
+}
+
+// ImagePullSecrets is used to set image pull secrests,match option --image-pull-secret
+func (b *RayJobBuilder) ImagePullSecrets(secrets []string) *RayJobBuilder {
+	if secrets != nil {
+		b.argValues["image-pull-secret"] = &secrets
+	}
+	return b
+}
+
+// WorkerCount is used to set count of ray worker,match option --workers
+func (b *RayJobBuilder) WorkerCount(count int) *RayJobBuilder {
+	if count >= 0 {
+		b.args.WorkerCount = count
+	}
+	return b
+}
+
+// HeadCPU assigns cpu limits of the ray head,match option --head-cpu
+func (b *RayJobBuilder) HeadCPU(cpu string) *RayJobBuilder {
+	if cpu != "" {
+		b.args.HeadCPU = cpu
+	}
+	return b
+}
+
+// HeadMemory assigns memory limits of the ray head,match option --head-memory
+func (b *RayJobBuilder) HeadMemory(memory string) *RayJobBuilder {
+	if memory != "" {
+		b.args.HeadMemory = memory
+	}
+	return b
+}
+
+// HeadGPUCount assigns gpu count of the ray head,match option --head-gpus
+func (b *RayJobBuilder) HeadGPUCount(count int) *RayJobBuilder {
+	if count > 0 {
+		b.args.HeadGPUCount = count
+	}
+	return b
+}
+
+// WorkerCPU assigns cpu limits of every ray worker,match option --worker-cpu
+func (b *RayJobBuilder) WorkerCPU(cpu string) *RayJobBuilder {
+	if cpu != "" {
+		b.args.WorkerCPU = cpu
+	}
+	return b
+}
+
+// WorkerMemory assigns memory limits of every ray worker,match option --worker-memory
+func (b *RayJobBuilder) WorkerMemory(memory string) *RayJobBuilder {
+	if memory != "" {
+		b.args.WorkerMemory = memory
+	}
+	return b
+}
+
+// RayVersion is used to set the version of ray,match option --ray-version
+func (b *RayJobBuilder) RayVersion(version string) *RayJobBuilder {
+	if version != "" {
+		b.args.RayVersion = version
+	}
+	return b
+}
+
+// RuntimeEnv is used to set the runtime environment of ray job,it is a yaml string,
+// match the content of the file given by option --runtime-env
+func (b *RayJobBuilder) RuntimeEnv(runtimeEnvYAML string) *RayJobBuilder {
+	if runtimeEnvYAML != "" {
+		b.args.RuntimeEnvYAML = runtimeEnvYAML
+	}
+	return b
+}
+
+// ShutdownAfterJobFinishes is used to delete the ray cluster or not after the job finishes,
+// match option --shutdown-after-finished
+func (b *RayJobBuilder) ShutdownAfterJobFinishes(shutdown bool) *RayJobBuilder {
+	b.args.ShutdownAfterJobFinishes = shutdown
+	return b
+}
+
+// ActiveDeadlineSeconds match option --running-timeout
+func (b *RayJobBuilder) ActiveDeadlineSeconds(act int64) *RayJobBuilder {
+	if act > 0 {
+		b.args.ActiveDeadlineSeconds = act
+	}
+	return b
+}
+
+// TTLSecondsAfterFinished match option --ttl-after-finished
+func (b *RayJobBuilder) TTLSecondsAfterFinished(ttl int32) *RayJobBuilder {
+	if ttl > 0 {
+		b.args.TTLSecondsAfterFinished = ttl
+	}
+	return b
+}
+
+// Build is used to build the job
+func (b *RayJobBuilder) Build() (*Job, error) {
+	for key, value := range b.argValues {
+		b.AddArgValue(key, value)
+	}
+	if err := b.PreBuild(); err != nil {
+		return nil, err
+	}
+	if err := b.ArgsBuilder.Build(); err != nil {
+		return nil, err
+	}
+	return NewJob(b.args.Name, types.RayTrainingJob, b.args), nil
+}
@@ -35,6 +35,11 @@ func NewSparkJobBuilder() *SparkJobBuilder {
 	}
 }
 
+// GetArgs returns the submit args of the builder,they can be filled by a job spec file
+func (b *SparkJobBuilder) GetArgs() *types.SubmitSparkJobArgs {
+	return b.args
+}
+
 // Name is used to set job name,match option --name
 func (b *SparkJobBuilder) Name(name string) *SparkJobBuilder {
 	if name != "" {
@@ -30,6 +30,11 @@ func NewTFJobBuilder(args *types.SubmitTFJobArgs) *TFJobBuilder {
 	}
 }
 
+// GetArgs returns the submit args of the builder,they can be filled by a job spec file
+func (b *TFJobBuilder) GetArgs() *types.SubmitTFJobArgs {
+	return b.args
+}
+
 func (b *TFJobBuilder) GetArgValues() map[string]interface{} {
 	return b.argValues
 }
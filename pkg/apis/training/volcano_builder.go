@@ -33,6 +33,11 @@ func NewVolcanoJobBuilder() *VolcanoJobBuilder {
 	}
 }
 
+// GetArgs returns the submit args of the builder,they can be filled by a job spec file
+func (b *VolcanoJobBuilder) GetArgs() *types.SubmitVolcanoJobArgs {
+	return b.args
+}
+
 // Name is used to set job name,match option --name
 func (b *VolcanoJobBuilder) Name(name string) *VolcanoJobBuilder {
 	if name != "" {
@@ -0,0 +1,67 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// DiagnosisReason is the kind of problem which is found in the pods of a training job
+type DiagnosisReason string
+
+const (
+	DiagnosisOOMKilled             DiagnosisReason = "OOMKilled"
+	DiagnosisImagePullFailed       DiagnosisReason = "ImagePullFailed"
+	DiagnosisCreateContainerFailed DiagnosisReason = "CreateContainerFailed"
+	DiagnosisInitCodeSyncFailed    DiagnosisReason = "InitCodeSyncFailed"
+	DiagnosisVolumeMountFailed     DiagnosisReason = "VolumeMountFailed"
+	DiagnosisUnschedulable         DiagnosisReason = "Unschedulable"
+	DiagnosisEvicted               DiagnosisReason = "Evicted"
+	DiagnosisPreempted             DiagnosisReason = "Preempted"
+	DiagnosisNodeLost              DiagnosisReason = "NodeLost"
+	DiagnosisCrashLoopBackOff      DiagnosisReason = "CrashLoopBackOff"
+	DiagnosisNonZeroExitCode       DiagnosisReason = "NonZeroExitCode"
+	DiagnosisUnknownFailure        DiagnosisReason = "UnknownFailure"
+)
+
+// TrainingJobDiagnosis is the failure diagnosis report of a training job
+type TrainingJobDiagnosis struct {
+	// Name is the name of the job
+	Name string `json:"name" yaml:"name"`
+	// Namespace is the namespace of the job
+	Namespace string `json:"namespace" yaml:"namespace"`
+	// Trainer is the type of the job
+	Trainer TrainingJobType `json:"trainer" yaml:"trainer"`
+	// Status is the status of the job
+	Status TrainingJobStatus `json:"status" yaml:"status"`
+	// Findings are the problems found in the pods of the job,the most likely root cause is the first one
+	Findings []DiagnosisFinding `json:"findings" yaml:"findings"`
+}
+
+// DiagnosisFinding is a problem found in a pod of the training job
+type DiagnosisFinding struct {
+	// Reason is the kind of the problem
+	Reason DiagnosisReason `json:"reason" yaml:"reason"`
+	// Score ranks the findings,the finding with higher score is more likely the root cause
+	Score int `json:"score" yaml:"score"`
+	// Instance is the pod which has the problem
+	Instance string `json:"instance" yaml:"instance"`
+	// Container is the container which has the problem,it is empty if the problem is about the pod
+	Container string `json:"container,omitempty" yaml:"container,omitempty"`
+	// ExitCode is the exit code of the container
+	ExitCode int32 `json:"exitCode,omitempty" yaml:"exitCode,omitempty"`
+	// Message is the message of the problem given by kubernetes
+	Message string `json:"message" yaml:"message"`
+	// Hint is the suggestion to fix the problem
+	Hint string `json:"hint" yaml:"hint"`
+	// Events are the warning events of the pod
+	Events []string `json:"events,omitempty" yaml:"events,omitempty"`
+}
@@ -0,0 +1,29 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License
+
+package types
+
+// TrainingJobSpec is the declarative form of a training job,
+// it is read by 'arena submit -f' and written by 'arena get --export'
+type TrainingJobSpec struct {
+	// Kind stores the training job type,like tfjob,pytorchjob
+	Kind TrainingJobType `yaml:"kind"`
+	// Name stores the job name,it can be overridden by option --name
+	Name string `yaml:"name"`
+	// Namespace stores the job namespace,it can be overridden by option --namespace
+	Namespace string `yaml:"namespace,omitempty"`
+	// Spec stores the submit args of the job,the keys are the yaml tags
+	// of the submit args type which matches the Kind,like SubmitTFJobArgs
+	Spec map[string]interface{} `yaml:"spec"`
+}
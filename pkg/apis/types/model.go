@@ -144,6 +144,7 @@ type CommonModelArgs struct {
 	Namespace       string `yaml:"namespace"`       // --namespace
 	ModelConfigFile string `yaml:"modelConfigFile"` // --model-config-file
 	ModelName       string `yaml:"modelName"`       // --model-name
+	ModelVersion    string `yaml:"modelVersion"`    // --model-version
 	ModelPath       string `yaml:"modelPath"`       // --model-path
 	Inputs          string `yaml:"inputs"`          // --inputs
 	Outputs         string `yaml:"outputs"`         // --outputs
@@ -212,6 +213,103 @@ type ModelEvaluateArgs struct {
 }
 
 // Model Management
+
+// ModelRegistryType defines the backend of the model registry
+type ModelRegistryType string
+
+const (
+	// MlflowModelRegistry stores the models in the mlflow tracking server
+	MlflowModelRegistry ModelRegistryType = "mlflow"
+	// OCIModelRegistry stores the models as artifacts in an oci registry
+	OCIModelRegistry ModelRegistryType = "oci"
+)
+
+// ModelVersionRegistration is the model version to register when a training job
+// submitted with --register-model-on-success is succeeded
+type ModelVersionRegistration struct {
+	JobName      string
+	JobNamespace string
+	JobType      TrainingJobType
+	ModelName    string
+	Source       string
+	// RunLink links the model version back to the training job,like arena://trainingjobs/default/tfjob/mnist
+	RunLink     string
+	Description string
+	Tags        []*ModelVersionTag
+}
+
+// ModelPromoteArgs defines the args of promoting a model version by moving the alias to it
+type ModelPromoteArgs struct {
+	Name    string
+	Version string
+	Alias   string
+	// EvaluateJob is the completed evaluate job whose metrics gate the promotion,match option --evaluate-job
+	EvaluateJob string
+	// Thresholds are the metric thresholds like accuracy>=0.9,match option --threshold
+	Thresholds []string
+}
+
+// ModelMetricThreshold is the threshold of a metric of the evaluate job,like accuracy>=0.9
+type ModelMetricThreshold struct {
+	Metric   string
+	Operator string
+	Value    float64
+}
+
+func (t ModelMetricThreshold) String() string {
+	return fmt.Sprintf("%s%s%v", t.Metric, t.Operator, t.Value)
+}
+
+// ModelVersionDiff is a difference between two model versions
+type ModelVersionDiff struct {
+	// Field is description,source or tag:KEY
+	Field string `json:"field" yaml:"field"`
+	From  string `json:"from" yaml:"from"`
+	To    string `json:"to" yaml:"to"`
+}
+
+// ModelLineageRelation is how a job is related to a model version
+type ModelLineageRelation string
+
+const (
+	// ModelLineageProduced means the training job produced the model version
+	ModelLineageProduced ModelLineageRelation = "produced"
+	// ModelLineageEvaluated means the evaluate job evaluated the model version
+	ModelLineageEvaluated ModelLineageRelation = "evaluated"
+	// ModelLineageProfiled means the model analyze job profiled the model version
+	ModelLineageProfiled ModelLineageRelation = "profiled"
+	// ModelLineageOptimized means the model analyze job optimized the model version
+	ModelLineageOptimized ModelLineageRelation = "optimized"
+	// ModelLineageBenchmarked means the model analyze job benchmarked the model version
+	ModelLineageBenchmarked ModelLineageRelation = "benchmarked"
+	// ModelLineageServed means the serving job served the model version
+	ModelLineageServed ModelLineageRelation = "served"
+)
+
+// ModelLineageJob is a job related to a model version
+type ModelLineageJob struct {
+	Name      string `json:"name" yaml:"name"`
+	Namespace string `json:"namespace" yaml:"namespace"`
+	// Type is the type of the job,like tfjob,evaluate,profile and tf-serving
+	Type     string               `json:"type" yaml:"type"`
+	Status   string               `json:"status" yaml:"status"`
+	Relation ModelLineageRelation `json:"relation" yaml:"relation"`
+}
+
+// ModelVersionLineage is the jobs related to a model version,the version is empty for the jobs
+// which are labeled with the model name only,like the training job waiting for registering its model version
+type ModelVersionLineage struct {
+	Version string             `json:"version" yaml:"version"`
+	Source  string             `json:"source,omitempty" yaml:"source,omitempty"`
+	Jobs    []*ModelLineageJob `json:"jobs" yaml:"jobs"`
+}
+
+// ModelLineage is the lineage of a registered model across training,evaluation,analyze and serving
+type ModelLineage struct {
+	Name     string                 `json:"name" yaml:"name"`
+	Versions []*ModelVersionLineage `json:"versions" yaml:"versions"`
+}
+
 type RegisteredModel struct {
 	Name                 string                  `json:"name"`
 	CreationTimestamp    int64                   `json:"creation_timestamp,omitempty"`
@@ -0,0 +1,72 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// RetryCondition is a failure of training job which is retried by the retry controller,
+// it is given by option --retry-on
+type RetryCondition string
+
+const (
+	// RetryOnOOM retries the job when a container of it is killed for out of memory
+	RetryOnOOM RetryCondition = "oom"
+	// RetryOnNodeLost retries the job when a pod of it is lost with its node
+	RetryOnNodeLost RetryCondition = "node-lost"
+	// RetryOnExitCodePrefix retries the job when a container of it exits with the code,like "exitcode:137"
+	RetryOnExitCodePrefix = "exitcode:"
+)
+
+// the annotations of the training jobs which are handled by the retry controller
+const (
+	// RetryAttemptAnnotation is the attempt number of the job,the first submission is attempt 1
+	RetryAttemptAnnotation = "arena.kubeflow.org/retry-attempt"
+	// RetryOriginAnnotation is the name of the job which is submitted as attempt 1
+	RetryOriginAnnotation = "arena.kubeflow.org/retry-origin"
+	// RetryPreviousAnnotation is the name of the failed attempt which is retried by the job
+	RetryPreviousAnnotation = "arena.kubeflow.org/retry-previous"
+	// RetryNextAnnotation is the name of the attempt which retries the failed job
+	RetryNextAnnotation = "arena.kubeflow.org/retry-next"
+	// RetryStatusAnnotation records how the failed job is handled by the retry controller
+	RetryStatusAnnotation = "arena.kubeflow.org/retry-status"
+	// RetryFailureAnnotation records the failure of the job which is classified by the retry controller
+	RetryFailureAnnotation = "arena.kubeflow.org/retry-failure"
+)
+
+// RetryStatus is the result of handling a failed job by the retry controller
+type RetryStatus string
+
+const (
+	// RetryResubmitted means the next attempt of the job has been submitted
+	RetryResubmitted RetryStatus = "Resubmitted"
+	// RetryExhausted means the job has used up its attempts
+	RetryExhausted RetryStatus = "Exhausted"
+	// RetryNotRetryable means the failure of the job is not matched by its --retry-on
+	RetryNotRetryable RetryStatus = "NotRetryable"
+)
+
+// RetryControllerArgs defines the args of the retry controller
+type RetryControllerArgs struct {
+	// Namespace is the namespace of the watched jobs
+	Namespace string
+	// AllNamespaces watches the jobs in all namespaces
+	AllNamespaces bool
+	// ResyncPeriod is the interval to check the failed jobs
+	ResyncPeriod time.Duration
+	// Backoff is the delay before the second attempt,it is doubled by each attempt
+	Backoff time.Duration
+	// MaxBackoff is the max delay before an attempt
+	MaxBackoff time.Duration
+}
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // ServingJobType defines the serving job type
 // name must like shorthand + "-serving"
 type ServingJobType string
@@ -19,6 +21,8 @@ const (
 	TritonServingJob ServingJobType = "triton-serving"
 	// CustomServingJob defines the custom serving job
 	CustomServingJob ServingJobType = "custom-serving"
+	// VLLMServingJob defines the vllm serving job which exposes the OpenAI compatible api
+	VLLMServingJob ServingJobType = "vllm-serving"
 	// AllServingJob represents all serving job type
 	AllServingJob ServingJobType = ""
 	// UnknownServingJob defines the unknown serving job
@@ -68,6 +72,11 @@ var ServingTypeMap = map[ServingJobType]ServingTypeInfo{
 		Alias:     "Seldon",
 		Shorthand: "seldon",
 	},
+	VLLMServingJob: {
+		Name:      VLLMServingJob,
+		Alias:     "VLLM",
+		Shorthand: "vllm",
+	},
 }
 
 // ServingJobInfo display serving job information
@@ -104,6 +113,25 @@ type ServingJobInfo struct {
 	RequestGPUCore int `json:"requestGPUCore" yaml:"requestGPUCore"`
 	// CreationTimestamp stores the creation timestamp of job
 	CreationTimestamp int64 `json:"creationTimestamp" yaml:"creationTimestamp"`
+	// Autoscaling gives the horizontal pod autoscaler of the serving job
+	Autoscaling *ServingAutoscaling `json:"autoscaling,omitempty" yaml:"autoscaling,omitempty"`
+}
+
+type ServingAutoscaling struct {
+	// MinReplicas specifies the lower limit of the replicas
+	MinReplicas int32 `json:"minReplicas" yaml:"minReplicas"`
+	// MaxReplicas specifies the upper limit of the replicas
+	MaxReplicas int32 `json:"maxReplicas" yaml:"maxReplicas"`
+	// ScaleMetric specifies the metric watched by the autoscaler
+	ScaleMetric string `json:"scaleMetric" yaml:"scaleMetric"`
+	// ScaleTarget specifies the target value of the metric
+	ScaleTarget string `json:"scaleTarget" yaml:"scaleTarget"`
+	// CurrentValue specifies the current value of the metric
+	CurrentValue string `json:"currentValue" yaml:"currentValue"`
+	// CurrentReplicas specifies the current replicas
+	CurrentReplicas int32 `json:"currentReplicas" yaml:"currentReplicas"`
+	// DesiredReplicas specifies the replicas desired by the autoscaler
+	DesiredReplicas int32 `json:"desiredReplicas" yaml:"desiredReplicas"`
 }
 
 type Endpoint struct {
@@ -184,6 +212,42 @@ type CommonServingArgs struct {
 
 	ModelName    string `yaml:"modelName"`    // --model-name
 	ModelVersion string `yaml:"modelVersion"` // --model-version
+
+	DryRun DryRunStrategy `yaml:"-"` // --dry-run
+}
+
+// AutoscalingArgs creates the horizontal pod autoscaler for the serving jobs which are deployments
+type AutoscalingArgs struct {
+	MinReplicas int    `yaml:"minReplicas"` // --min-replicas
+	MaxReplicas int    `yaml:"maxReplicas"` // --max-replicas
+	ScaleMetric string `yaml:"scaleMetric"` // --scale-metric
+	ScaleTarget int    `yaml:"scaleTarget"` // --scale-target
+}
+
+const (
+	// cpu and memory are scaled by the average utilization of the pods,
+	// other metrics are the custom pod metrics served by the prometheus adapter
+	DefaultAutoscalingMetric = "cpu"
+	DefaultAutoscalingTarget = 80
+)
+
+// IsResourceScaleMetric returns true if the metric is scaled by the resource utilization
+func IsResourceScaleMetric(metric string) bool {
+	return metric == "cpu" || metric == "memory"
+}
+
+// ValidateAutoscalingArgs checks the args of the horizontal pod autoscaler
+func ValidateAutoscalingArgs(args *AutoscalingArgs) error {
+	if args.MinReplicas < 1 {
+		return fmt.Errorf("--min-replicas must be greater than 0")
+	}
+	if args.MaxReplicas < args.MinReplicas {
+		return fmt.Errorf("--max-replicas %v must be not less than --min-replicas %v", args.MaxReplicas, args.MinReplicas)
+	}
+	if args.ScaleTarget <= 0 {
+		return fmt.Errorf("--scale-target must be greater than 0 for the metric %v", args.ScaleMetric)
+	}
+	return nil
 }
 
 type CustomServingArgs struct {
@@ -201,6 +265,7 @@ type CustomServingArgs struct {
 	StartupProbeAction         string   `yaml:"startupProbeAction"`         // --startup-probe-action
 	StartupProbeActionOption   []string `yaml:"startupProbeActionOption"`   // --startup-probe-action-option
 	StartupProbeOption         []string `yaml:"startupProbeOption"`         // --startup-probe-option
+	AutoscalingArgs            `yaml:",inline"`
 	CommonServingArgs          `yaml:",inline"`
 }
 
@@ -211,6 +276,7 @@ type TensorFlowServingArgs struct {
 	ModelPath            string `yaml:"modelPath"`            // --model-path
 	Port                 int    `yaml:"port"`                 // --port
 	RestfulPort          int    `yaml:"restApiPort"`          // --restful-port
+	AutoscalingArgs      `yaml:",inline"`
 	CommonServingArgs    `yaml:",inline"`
 }
 
@@ -265,9 +331,22 @@ type TritonServingArgs struct {
 	AllowMetrics      bool     `yaml:"allowMetrics"`    // --allow-metrics
 	LoadModels        []string `yaml:"loadModels"`      // --load-model
 	ExtendCommand     string   `yaml:"extendCommand"`   // --extend-command
+	AutoscalingArgs   `yaml:",inline"`
 	CommonServingArgs `yaml:",inline"`
 }
 
+type VLLMServingArgs struct {
+	ModelPath          string `yaml:"modelPath"`          // --model-path
+	ModelID            string `yaml:"modelId"`            // --model-id
+	ServedModelName    string `yaml:"servedModelName"`    // --served-model-name
+	Port               int    `yaml:"port"`               // --port
+	TensorParallelSize int    `yaml:"tensorParallelSize"` // --tensor-parallel-size
+	MaxModelLen        int    `yaml:"maxModelLen"`        // --max-model-len
+	Quantization       string `yaml:"quantization"`       // --quantization
+	ExtendCommand      string `yaml:"extendCommand"`      // --extend-command
+	CommonServingArgs  `yaml:",inline"`
+}
+
 type ModelFormat struct {
 	// Name of the model format.
 	// +required
@@ -0,0 +1,38 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// ServingRevision is a revision of a serving job,it is the replicaset of the deployment
+// or the knative revision of the kserve inference service
+type ServingRevision struct {
+	// Revision specifies the revision number
+	Revision int64 `json:"revision" yaml:"revision"`
+	// Name specifies the name of the replicaset or the knative revision
+	Name string `json:"name" yaml:"name"`
+	// Age specifies the age of the revision
+	Age string `json:"age" yaml:"age"`
+	// Image specifies the image of the serving container
+	Image string `json:"image,omitempty" yaml:"image,omitempty"`
+	// ModelPath specifies the model path given in the serving command
+	ModelPath string `json:"modelPath,omitempty" yaml:"modelPath,omitempty"`
+	// Current is true if the revision is the current one
+	Current bool `json:"current" yaml:"current"`
+	// TrafficPercent specifies the traffic routed to the revision,only for kserve
+	TrafficPercent *int64 `json:"trafficPercent,omitempty" yaml:"trafficPercent,omitempty"`
+	// Status specifies the status of the knative revision,only for kserve
+	Status string `json:"status,omitempty" yaml:"status,omitempty"`
+	// Changes gives the changed fields from the previous revision
+	Changes []string `json:"changes" yaml:"changes"`
+}
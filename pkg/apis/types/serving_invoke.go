@@ -0,0 +1,67 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// InvokeProtocol is the inference protocol used to send requests to a serving job
+type InvokeProtocol string
+
+const (
+	// TFServingInvokeProtocol is the restful api of tensorflow serving
+	TFServingInvokeProtocol InvokeProtocol = "tfserving"
+	// V1InvokeProtocol is the kserve v1 protocol
+	V1InvokeProtocol InvokeProtocol = "v1"
+	// V2InvokeProtocol is the open inference protocol,which is supported by triton and kserve
+	V2InvokeProtocol InvokeProtocol = "v2"
+	// OpenAIInvokeProtocol is the OpenAI chat completions api
+	OpenAIInvokeProtocol InvokeProtocol = "openai"
+	// RawInvokeProtocol sends the request to the given path as it is
+	RawInvokeProtocol InvokeProtocol = "raw"
+)
+
+// ServingInvokeArgs is the request which is sent to a serving job
+type ServingInvokeArgs struct {
+	// Data is the request body
+	Data []byte
+	// Protocol is the inference protocol,it is detected by the serving type if empty
+	Protocol InvokeProtocol
+	// Model is the model name used in the request path or the request body
+	Model string
+	// Path overrides the request path given by the protocol
+	Path string
+	// Method is the http method,default to POST if data is given,otherwise GET
+	Method string
+	// Timeout is the timeout of the request
+	Timeout time.Duration
+}
+
+// ServingInvokeResult is the response of a serving job
+type ServingInvokeResult struct {
+	// Protocol is the inference protocol used by the request
+	Protocol InvokeProtocol `json:"protocol" yaml:"protocol"`
+	// Method is the http method of the request
+	Method string `json:"method" yaml:"method"`
+	// URL is the url of the request,which is proxied by the api server
+	URL string `json:"url" yaml:"url"`
+	// StatusCode is the http status code of the response
+	StatusCode int `json:"statusCode" yaml:"statusCode"`
+	// Status is the http status of the response
+	Status string `json:"status" yaml:"status"`
+	// Latency is the time cost of the request
+	Latency time.Duration `json:"latency" yaml:"latency"`
+	// Body is the response body
+	Body []byte `json:"-" yaml:"-"`
+}
@@ -0,0 +1,71 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import "time"
+
+// ServingRolloutPhase is the phase of a canary rollout
+type ServingRolloutPhase string
+
+const (
+	RolloutProgressing ServingRolloutPhase = "Progressing"
+	RolloutSucceeded   ServingRolloutPhase = "Succeeded"
+	RolloutRolledBack  ServingRolloutPhase = "RolledBack"
+)
+
+const (
+	// SERVING_REQUEST_RATE_QUERY_TMP is the requests per second of a serving deployment reported by the istio sidecar
+	SERVING_REQUEST_RATE_QUERY_TMP = `sum(rate(istio_requests_total{reporter="destination",destination_workload_namespace="%[1]s",destination_workload="%[2]s"}[%[3]s]))`
+	// SERVING_ERROR_RATE_QUERY_TMP is the 5xx error rate of a serving deployment reported by the istio sidecar,
+	// the 5xx series does not exist before the first error,so it is 0 by default
+	SERVING_ERROR_RATE_QUERY_TMP = `(sum(rate(istio_requests_total{reporter="destination",destination_workload_namespace="%[1]s",destination_workload="%[2]s",response_code=~"5.."}[%[3]s])) or vector(0)) / sum(rate(istio_requests_total{reporter="destination",destination_workload_namespace="%[1]s",destination_workload="%[2]s"}[%[3]s]))`
+	// SERVING_LATENCY_QUERY_TMP is the p99 latency(milliseconds) of a serving deployment reported by the istio sidecar
+	SERVING_LATENCY_QUERY_TMP = `histogram_quantile(0.99, sum(rate(istio_request_duration_milliseconds_bucket{reporter="destination",destination_workload_namespace="%[1]s",destination_workload="%[2]s"}[%[3]s])) by (le))`
+)
+
+// ServingRolloutArgs is the canary rollout from a serving version to another
+type ServingRolloutArgs struct {
+	ServingName string `json:"servingName"`
+	Namespace   string `json:"namespace"`
+	// FromVersion is the stable version which serves all traffic before the rollout
+	FromVersion string `json:"fromVersion"`
+	// ToVersion is the canary version which serves all traffic after the rollout
+	ToVersion string `json:"toVersion"`
+	// Steps are the traffic weights of the canary version,the last one must be 100
+	Steps []int `json:"steps"`
+	// Interval is the time to wait and analyze the metrics before the next step
+	Interval time.Duration `json:"interval"`
+	// MaxErrorRate is the max 5xx error rate of the canary version,eg: 0.01
+	MaxErrorRate float64 `json:"maxErrorRate"`
+	// MaxLatency is the max p99 latency of the canary version,no check if it is 0
+	MaxLatency time.Duration `json:"maxLatency"`
+	// SkipAnalysis shifts the traffic step by step without analyzing the metrics of the canary version
+	SkipAnalysis bool `json:"skipAnalysis"`
+	// Resume continues the rollout from the persisted status
+	Resume bool `json:"-"`
+}
+
+// ServingRolloutStatus is the status of a canary rollout,it is persisted so the rollout can be resumed
+type ServingRolloutStatus struct {
+	ServingRolloutArgs `json:",inline"`
+	Phase              ServingRolloutPhase `json:"phase"`
+	// Step is the index of the step whose weight is applied
+	Step int `json:"step"`
+	// Weight is the current traffic weight of the canary version
+	Weight int `json:"weight"`
+	// Message is the result of the last analysis
+	Message        string    `json:"message"`
+	LastUpdateTime time.Time `json:"lastUpdateTime"`
+}
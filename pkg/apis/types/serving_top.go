@@ -0,0 +1,95 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// ServingJobMetrics is the live metrics of a serving job shown by 'arena top serving',
+// a metric is nil if it is not found in prometheus
+type ServingJobMetrics struct {
+	// Name specifies the serving job name
+	Name string `json:"name" yaml:"name"`
+	// Namespace specifies the serving job namespace
+	Namespace string `json:"namespace" yaml:"namespace"`
+	// Type specifies the serving job type
+	Type string `json:"type" yaml:"type"`
+	// Version specifies the serving job version
+	Version string `json:"version" yaml:"version"`
+	// Desired specifies the desired instances
+	Desired int `json:"desiredInstances" yaml:"desiredInstances"`
+	// Available specifies the available instances
+	Available int `json:"availableInstances" yaml:"availableInstances"`
+	// QPS specifies the requests per second
+	QPS *float64 `json:"qps,omitempty" yaml:"qps,omitempty"`
+	// ErrorRate specifies the ratio of the failed requests
+	ErrorRate *float64 `json:"errorRate,omitempty" yaml:"errorRate,omitempty"`
+	// P50Latency specifies the p50 latency in milliseconds
+	P50Latency *float64 `json:"p50LatencyMs,omitempty" yaml:"p50LatencyMs,omitempty"`
+	// P99Latency specifies the p99 latency in milliseconds
+	P99Latency *float64 `json:"p99LatencyMs,omitempty" yaml:"p99LatencyMs,omitempty"`
+	// GPUUtilization specifies the average gpu duty cycle of the instances in percent
+	GPUUtilization *float64 `json:"gpuUtilization,omitempty" yaml:"gpuUtilization,omitempty"`
+}
+
+// ServingMetricQueries are the promql templates of the serving metrics,
+// the templates are formatted with the label matchers of the serving job and the rate window,
+// the failed requests are 0 by default because their series do not exist before the first failure
+type ServingMetricQueries struct {
+	QPS        string
+	ErrorRate  string
+	P50Latency string
+	P99Latency string
+}
+
+var (
+	// TFServingMetricQueries are the metrics exported by tensorflow serving with --monitoring-config-file,
+	// the latency is reported in microseconds
+	TFServingMetricQueries = ServingMetricQueries{
+		QPS:        `sum(rate(:tensorflow:serving:request_count{%[1]s}[%[2]s]))`,
+		ErrorRate:  `(sum(rate(:tensorflow:serving:request_count{%[1]s,status!="OK"}[%[2]s])) or vector(0)) / sum(rate(:tensorflow:serving:request_count{%[1]s}[%[2]s]))`,
+		P50Latency: `histogram_quantile(0.5, sum(rate(:tensorflow:serving:request_latency_bucket{%[1]s}[%[2]s])) by (le)) / 1000`,
+		P99Latency: `histogram_quantile(0.99, sum(rate(:tensorflow:serving:request_latency_bucket{%[1]s}[%[2]s])) by (le)) / 1000`,
+	}
+	// TritonServingMetricQueries are the metrics exported by triton inference server,
+	// the latency quantiles are reported by the summary metrics in microseconds
+	TritonServingMetricQueries = ServingMetricQueries{
+		QPS:        `sum(rate({__name__=~"nv_inference_request_success|nv_inference_request_failure",%[1]s}[%[2]s]))`,
+		ErrorRate:  `sum(rate(nv_inference_request_failure{%[1]s}[%[2]s])) / sum(rate({__name__=~"nv_inference_request_success|nv_inference_request_failure",%[1]s}[%[2]s]))`,
+		P50Latency: `avg(nv_inference_request_summary_us{%[1]s,quantile="0.5"}) / 1000`,
+		P99Latency: `avg(nv_inference_request_summary_us{%[1]s,quantile="0.99"}) / 1000`,
+	}
+	// KServeMetricQueries are the metrics of the predictor revisions reported by the knative queue proxy
+	KServeMetricQueries = ServingMetricQueries{
+		QPS:        `sum(rate(revision_app_request_count{%[1]s}[%[2]s]))`,
+		ErrorRate:  `(sum(rate(revision_app_request_count{%[1]s,response_code_class="5xx"}[%[2]s])) or vector(0)) / sum(rate(revision_app_request_count{%[1]s}[%[2]s]))`,
+		P50Latency: `histogram_quantile(0.5, sum(rate(revision_app_request_latencies_bucket{%[1]s}[%[2]s])) by (le))`,
+		P99Latency: `histogram_quantile(0.99, sum(rate(revision_app_request_latencies_bucket{%[1]s}[%[2]s])) by (le))`,
+	}
+	// IstioServingMetricQueries are the metrics reported by the istio sidecar,
+	// they are used by the serving types without their own metrics
+	IstioServingMetricQueries = ServingMetricQueries{
+		QPS:        `sum(rate(istio_requests_total{reporter="destination",%[1]s}[%[2]s]))`,
+		ErrorRate:  `(sum(rate(istio_requests_total{reporter="destination",%[1]s,response_code=~"5.."}[%[2]s])) or vector(0)) / sum(rate(istio_requests_total{reporter="destination",%[1]s}[%[2]s]))`,
+		P50Latency: `histogram_quantile(0.5, sum(rate(istio_request_duration_milliseconds_bucket{reporter="destination",%[1]s}[%[2]s])) by (le))`,
+		P99Latency: `histogram_quantile(0.99, sum(rate(istio_request_duration_milliseconds_bucket{reporter="destination",%[1]s}[%[2]s])) by (le))`,
+	}
+)
+
+// SERVING_GPU_UTILIZATION_QUERY_TMPS are the promql templates of the average gpu utilization of the serving job pods,
+// they are formatted with the namespace and the regex of the pod names,the first one found in prometheus is used
+var SERVING_GPU_UTILIZATION_QUERY_TMPS = []string{
+	// the gpu duty cycle reported by the gpu exporter of arena
+	`avg(nvidia_gpu_duty_cycle{pod_name=~"%[2]s"})`,
+	// the gpu utilization reported by the nvidia dcgm exporter
+	`avg(DCGM_FI_DEV_GPU_UTIL{namespace="%[1]s",pod=~"%[2]s"})`,
+}
@@ -0,0 +1,29 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// TritonModel is a model in the model repository of the triton inference server
+type TritonModel struct {
+	// Name specifies the name of the model
+	Name string `json:"name" yaml:"name"`
+	// Version specifies the version of the model,it is empty if the model is not loaded
+	Version string `json:"version,omitempty" yaml:"version,omitempty"`
+	// State specifies the state of the model,like READY or UNAVAILABLE
+	State string `json:"state,omitempty" yaml:"state,omitempty"`
+	// Replicas specifies the number of the ready pods reporting the state and the number of all the ready pods,like 2/3
+	Replicas string `json:"replicas,omitempty" yaml:"replicas,omitempty"`
+	// Reason specifies the reason if the model is not ready
+	Reason string `json:"reason,omitempty" yaml:"reason,omitempty"`
+}
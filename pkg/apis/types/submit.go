@@ -66,6 +66,13 @@ type CommonSubmitArgs struct {
 	// Retry defines the retry times
 	Retry int `yaml:"retry"`
 
+	// MaxAttempts defines the max attempts of the job which are submitted by the retry controller
+	// when the job is failed,match option --max-attempts
+	MaxAttempts int `yaml:"maxAttempts"`
+
+	// RetryOn defines the failures which are retried by the retry controller,match option --retry-on
+	RetryOn []string `yaml:"retryOn"`
+
 	// DataSet stores the kubernetes pvc names
 	DataSet map[string]string `yaml:"dataset"`
 
@@ -134,6 +141,12 @@ type CommonSubmitArgs struct {
 
 	// ModelSource defines the model source
 	ModelSource string `yaml:"modelSource"`
+
+	// RegisterModelOnSuccess defines registering the model version when the job is succeeded,match option --register-model-on-success
+	RegisterModelOnSuccess bool `yaml:"registerModelOnSuccess"`
+
+	// DryRun defines the dry run strategy,match option --dry-run
+	DryRun DryRunStrategy `yaml:"-"`
 }
 
 // DataDirVolume defines the volume of kubernetes
@@ -160,6 +173,9 @@ type ConfigFileInfo struct {
 	HostFile          string `yaml:"hostFile"`
 	Key               string `yaml:"key"`
 	ContainerFilePath string `yaml:"containerFilePath"`
+	// Content stores the content of the file which is restored by 'arena get --export',
+	// the content of the file given by --config-file is passed by helm option --set-file
+	Content string `yaml:"content,omitempty"`
 }
 
 type SubmitSyncCodeArgs struct {
@@ -19,4 +19,7 @@ type SubmitMPIJobArgs struct {
 
 	// clean-task-policy
 	CleanPodPolicy string `yaml:"cleanPodPolicy"`
+
+	// TrainingOperatorCRD compatible with training-operator crd.
+	TrainingOperatorCRD bool `yaml:"trainingOperatorCRD,omitempty"`
 }
@@ -0,0 +1,51 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// SubmitRayJobArgs defines the args of submitting a RayJob of KubeRay
+type SubmitRayJobArgs struct {
+	// HeadCPU is the cpu of the ray head,match option --head-cpu
+	HeadCPU string `yaml:"headCPU"`
+	// HeadMemory is the memory of the ray head,match option --head-memory
+	HeadMemory string `yaml:"headMemory"`
+	// HeadGPUCount is the gpu count of the ray head,match option --head-gpus
+	HeadGPUCount int `yaml:"headGPUCount"`
+
+	// WorkerCPU is the cpu of every ray worker,match option --worker-cpu
+	WorkerCPU string `yaml:"workerCPU"`
+	// WorkerMemory is the memory of every ray worker,match option --worker-memory
+	WorkerMemory string `yaml:"workerMemory"`
+
+	// RayVersion is the version of ray used in the image,match option --ray-version
+	RayVersion string `yaml:"rayVersion,omitempty"`
+
+	// RuntimeEnvYAML is the runtime environment of the ray job,it is read
+	// from the file given by option --runtime-env
+	RuntimeEnvYAML string `yaml:"runtimeEnvYAML,omitempty"`
+
+	// ShutdownAfterJobFinishes defines the ray cluster is deleted or not after the job finishes,
+	// match option --shutdown-after-finished
+	ShutdownAfterJobFinishes bool `yaml:"shutdownAfterJobFinishes"`
+
+	// ActiveDeadlineSeconds Specifies the duration (in seconds) since startTime during which the job can remain active
+	// before it is terminated
+	ActiveDeadlineSeconds int64 `yaml:"activeDeadlineSeconds,omitempty"`
+
+	// TTLSecondsAfterFinished defines the TTL for cleaning up the ray cluster after the job finishes
+	TTLSecondsAfterFinished int32 `yaml:"ttlSecondsAfterFinished,omitempty"`
+
+	// for common args
+	CommonSubmitArgs `yaml:",inline"`
+}
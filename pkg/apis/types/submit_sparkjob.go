@@ -4,6 +4,7 @@ type SubmitSparkJobArgs struct {
 	Name         string          `yaml:"-"`
 	Namespace    string          `yaml:"-"`
 	TrainingType TrainingJobType `yaml:"-"`
+	DryRun       DryRunStrategy  `yaml:"-"`
 	Image        string          `yaml:"Image"`
 	MainClass    string          `yaml:"MainClass"`
 	Jar          string          `yaml:"Jar"`
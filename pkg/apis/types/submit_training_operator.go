@@ -0,0 +1,55 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// SubmitTrainingOperatorArgs defines the common args of the kubeflow.org/v1 jobs of training-operator
+type SubmitTrainingOperatorArgs struct {
+	Cpu    string `yaml:"cpu"`    // --cpu
+	Memory string `yaml:"memory"` // --memory
+
+	// clean-task-policy
+	CleanPodPolicy string `yaml:"cleanPodPolicy"`
+
+	// ActiveDeadlineSeconds Specifies the duration (in seconds) since startTime during which the job can remain active
+	// before it is terminated
+	ActiveDeadlineSeconds int64 `yaml:"activeDeadlineSeconds,omitempty"`
+
+	// Defines the TTL for cleaning up finished jobs. Defaults to infinite.
+	TTLSecondsAfterFinished int32 `yaml:"ttlSecondsAfterFinished,omitempty"`
+}
+
+// SubmitXGBoostJobArgs defines the args of submitting a XGBoostJob of training-operator
+type SubmitXGBoostJobArgs struct {
+	SubmitTrainingOperatorArgs `yaml:",inline"`
+
+	// for common args
+	CommonSubmitArgs `yaml:",inline"`
+}
+
+// SubmitPaddleJobArgs defines the args of submitting a PaddleJob of training-operator
+type SubmitPaddleJobArgs struct {
+	SubmitTrainingOperatorArgs `yaml:",inline"`
+
+	// for common args
+	CommonSubmitArgs `yaml:",inline"`
+}
+
+// SubmitJAXJobArgs defines the args of submitting a JAXJob of training-operator
+type SubmitJAXJobArgs struct {
+	SubmitTrainingOperatorArgs `yaml:",inline"`
+
+	// for common args
+	CommonSubmitArgs `yaml:",inline"`
+}
@@ -7,6 +7,8 @@ type SubmitVolcanoJobArgs struct {
 	Namespace string
 	// TrainingType is used to accept job type
 	TrainingType TrainingJobType
+	// DryRun specifies how to dry run the job,match option --dry-run
+	DryRun DryRunStrategy `yaml:"-"`
 	// Command defines the job command
 	Command string
 	// The MinAvailable available pods to run for this Job
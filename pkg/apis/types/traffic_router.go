@@ -5,6 +5,16 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// TrafficRouterType is the backend which splits the traffic of the serving versions
+type TrafficRouterType string
+
+const (
+	// IstioTrafficRouter splits the traffic by the istio virtual service and destination rule
+	IstioTrafficRouter TrafficRouterType = "istio"
+	// GatewayAPITrafficRouter splits the traffic by the gateway api HTTPRoute
+	GatewayAPITrafficRouter TrafficRouterType = "gateway-api"
+)
+
 type TrafficRouterSplitArgs struct {
 	ServingName    string `yaml:"servingName,omitempty"` //--name
 	Namespace      string `yaml:"namespace,omitempty"`   //--namespace
@@ -44,6 +54,13 @@ type DestinationRuleCRD struct {
 	Spec              istiov1alpha3.DestinationRule `json:"spec,omitempty" yaml:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
 }
 
+type GatewayCRD struct {
+	Kind              string `json:"kind,omitempty" protobuf:"bytes,1,opt,name=kind"`
+	APIVersion        string `json:"apiVersion,omitempty" protobuf:"bytes,2,opt,name=apiVersion"`
+	metav1.ObjectMeta `json:"metadata,omitempty" yaml:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Spec              istiov1alpha3.Gateway `json:"spec,omitempty" yaml:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+}
+
 type VirtualServiceCRD struct {
 	// Kind is a string value representing the REST resource this object represents.
 	// Servers may infer this from the endpoint the client submits requests to.
@@ -97,3 +114,41 @@ type PortSelector struct {
 	*istiov1alpha3.PortSelector
 	Number uint32 `protobuf:"varint,1,opt,name=number,proto3,oneof" json:"number,omitempty"`
 }
+
+// HTTPRouteCRD is the HTTPRoute of gateway api,only the fields used by arena are defined
+type HTTPRouteCRD struct {
+	Kind              string `json:"kind,omitempty"`
+	APIVersion        string `json:"apiVersion,omitempty"`
+	metav1.ObjectMeta `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+	Spec              HTTPRouteSpec `json:"spec,omitempty" yaml:"spec,omitempty"`
+}
+
+type HTTPRouteSpec struct {
+	ParentRefs []HTTPRouteParentRef `json:"parentRefs,omitempty"`
+	Rules      []HTTPRouteRule      `json:"rules,omitempty"`
+}
+
+type HTTPRouteParentRef struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+type HTTPRouteRule struct {
+	Matches     []HTTPRouteMatch      `json:"matches,omitempty"`
+	BackendRefs []HTTPRouteBackendRef `json:"backendRefs,omitempty"`
+}
+
+type HTTPRouteMatch struct {
+	Path *HTTPRoutePathMatch `json:"path,omitempty"`
+}
+
+type HTTPRoutePathMatch struct {
+	Type  string `json:"type,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+type HTTPRouteBackendRef struct {
+	Name   string `json:"name"`
+	Port   int32  `json:"port,omitempty"`
+	Weight int32  `json:"weight"`
+}
@@ -36,6 +36,14 @@ const (
 	SparkTrainingJob TrainingJobType = "sparkjob"
 	// DeepSpeedTrainingJob defines the deepspeed job
 	DeepSpeedTrainingJob TrainingJobType = "deepspeedjob"
+	// RayTrainingJob defines the ray job
+	RayTrainingJob TrainingJobType = "rayjob"
+	// XGBoostTrainingJob defines the xgboost job of training-operator
+	XGBoostTrainingJob TrainingJobType = "xgboostjob"
+	// PaddleTrainingJob defines the paddle job of training-operator
+	PaddleTrainingJob TrainingJobType = "paddlejob"
+	// JAXTrainingJob defines the jax job of training-operator
+	JAXTrainingJob TrainingJobType = "jaxjob"
 	// AllTrainingJob represents all job types
 	AllTrainingJob TrainingJobType = ""
 	// UnknownTrainingJob defines the unknown training
@@ -49,8 +57,9 @@ type TrainingJobTypeInfo struct {
 }
 
 var (
-	ErrTrainingJobNotFound      = errors.New("training job not found,please use 'arena list' to make sure job is existed.")
-	ErrNoPrivilegesToOperateJob = errors.New("you have no privileges to operate the job,because the owner of job is not you")
+	ErrTrainingJobNotFound          = errors.New("training job not found,please use 'arena list' to make sure job is existed.")
+	ErrNoPrivilegesToOperateJob     = errors.New("you have no privileges to operate the job,because the owner of job is not you")
+	ErrTrainingJobStatusUnreachable = errors.New("the training job has finished,it can not reach the expected status")
 )
 
 // ServingTypeMap collects serving job type and their alias
@@ -95,6 +104,26 @@ var TrainingTypeMap = map[TrainingJobType]TrainingJobTypeInfo{
 		Alias:     "DeepSpeed",
 		Shorthand: "dp",
 	},
+	RayTrainingJob: {
+		Name:      RayTrainingJob,
+		Alias:     "Ray",
+		Shorthand: "ray",
+	},
+	XGBoostTrainingJob: {
+		Name:      XGBoostTrainingJob,
+		Alias:     "XGBoost",
+		Shorthand: "xgb",
+	},
+	PaddleTrainingJob: {
+		Name:      PaddleTrainingJob,
+		Alias:     "Paddle",
+		Shorthand: "paddle",
+	},
+	JAXTrainingJob: {
+		Name:      JAXTrainingJob,
+		Alias:     "JAX",
+		Shorthand: "jax",
+	},
 }
 
 // TrainingJobInfo stores training job information
@@ -114,6 +143,8 @@ type TrainingJobInfo struct {
 	Trainer TrainingJobType `json:"trainer" yaml:"trainer"`
 	// The tensorboard of the training job
 	Tensorboard string `json:"tensorboard" yaml:"tensorboard"`
+	// The dashboard of the training job,like the ray dashboard
+	Dashboard string `json:"dashboard,omitempty" yaml:"dashboard,omitempty"`
 
 	// The name of the chief Instance
 	ChiefName string `json:"chiefName" yaml:"chiefName"`
@@ -153,6 +184,8 @@ const (
 	TrainingJobSucceeded TrainingJobStatus = "SUCCEEDED"
 	// TrainingJobFailed means the job is failed
 	TrainingJobFailed TrainingJobStatus = "FAILED"
+	// TrainingJobSuspended means the job is suspended,the pods of job are deleted
+	TrainingJobSuspended TrainingJobStatus = "SUSPENDED"
 )
 
 // TrainingJobInstance defines the instance of training job
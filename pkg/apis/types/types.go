@@ -19,6 +19,19 @@ const (
 	UnknownFormat FormatStyle = "unknown"
 )
 
+// DryRunStrategy defines how to dry run the submission of job
+type DryRunStrategy string
+
+const (
+	// DryRunNone submits the job to the cluster
+	DryRunNone DryRunStrategy = ""
+	// DryRunClient only renders the manifests of job and prints them,nothing is sent to the cluster
+	DryRunClient DryRunStrategy = "client"
+	// DryRunServer sends the manifests of job to the api server with server side dry run,
+	// so that they are validated by admission webhooks and quotas without being persisted
+	DryRunServer DryRunStrategy = "server"
+)
+
 type ArenaClientArgs struct {
 	Kubeconfig     string
 	Namespace      string
@@ -20,6 +20,8 @@ type CommonUpdateServingArgs struct {
 	Shell         string            `yaml:"shell"`         // --shell
 	Command       string            `yaml:"command"`       // --command
 	ModelDirs     map[string]string `yaml:"modelDirs"`     // --data
+	ModelVersion  string            `yaml:"modelVersion"`  // --model-version
+	ModelAlias    string            `yaml:"modelAlias"`    // --model-alias
 }
 
 type UpdateTensorFlowServingArgs struct {
@@ -27,16 +29,19 @@ type UpdateTensorFlowServingArgs struct {
 	MonitoringConfigFile    string `yaml:"monitoringConfigFile"` // --monitoring-config-file
 	ModelName               string `yaml:"modelName"`            // --model-name
 	ModelPath               string `yaml:"modelPath"`            // --model-path
+	AutoscalingArgs         `yaml:",inline"`
 	CommonUpdateServingArgs `yaml:",inline"`
 }
 
 type UpdateTritonServingArgs struct {
 	ModelRepository         string `yaml:"modelRepository"` // --model-repository
 	AllowMetrics            bool   `yaml:"allowMetrics"`    // --allow-metrics
+	AutoscalingArgs         `yaml:",inline"`
 	CommonUpdateServingArgs `yaml:",inline"`
 }
 
 type UpdateCustomServingArgs struct {
+	AutoscalingArgs         `yaml:",inline"`
 	CommonUpdateServingArgs `yaml:",inline"`
 }
 
@@ -56,3 +61,19 @@ type UpdateKServeArgs struct {
 	Port                    int          `yaml:"port"`                           // --port
 	CommonUpdateServingArgs `yaml:",inline"`
 }
+
+type UpdateSeldonServingArgs struct {
+	Implementation          string `yaml:"implementation"` // --implementation
+	ModelUri                string `yaml:"modelUri"`       // --model-uri
+	CommonUpdateServingArgs `yaml:",inline"`
+}
+
+type UpdateTensorRTServingArgs struct {
+	ModelStore              string `yaml:"modelStore"` // --model-store
+	CommonUpdateServingArgs `yaml:",inline"`
+}
+
+type UpdateKFServingArgs struct {
+	StorageUri              string `yaml:"storageUri"` // --storage-uri
+	CommonUpdateServingArgs `yaml:",inline"`
+}
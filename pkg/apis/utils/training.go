@@ -69,14 +69,43 @@ func IsMPIPod(name, ns string, pod *v1.Pod) bool {
 	if pod.Labels["app"] != string(types.MPITrainingJob) {
 		return false
 	}
+	if pod.Namespace != ns {
+		return false
+	}
+	// check the group name of mpi-operator or the operator name of training-operator
+	switch {
+	case pod.Labels[labelGroupNameV1alpha2] == "kubeflow.org":
+		return true
+	case pod.Labels[OperatorNameLabel] == "mpijob-controller":
+		return true
+	}
+	return false
+}
+
+func IsXGBoostPod(name, ns string, pod *v1.Pod) bool {
+	return isTrainingOperatorPod(name, ns, pod, types.XGBoostTrainingJob, "xgboostjob-controller")
+}
+
+func IsPaddlePod(name, ns string, pod *v1.Pod) bool {
+	return isTrainingOperatorPod(name, ns, pod, types.PaddleTrainingJob, "paddlejob-controller")
+}
+
+func IsJAXPod(name, ns string, pod *v1.Pod) bool {
+	return isTrainingOperatorPod(name, ns, pod, types.JAXTrainingJob, "jaxjob-controller")
+}
 
-	if pod.Labels["group_name"] != "kubeflow.org" {
+// isTrainingOperatorPod checks the pod is created by training-operator for the job submitted by arena
+func isTrainingOperatorPod(name, ns string, pod *v1.Pod, jobType types.TrainingJobType, operatorName string) bool {
+	if pod.Labels["release"] != name {
+		return false
+	}
+	if pod.Labels["app"] != string(jobType) {
 		return false
 	}
 	if pod.Namespace != ns {
 		return false
 	}
-	return true
+	return pod.Labels[OperatorNameLabel] == operatorName
 }
 
 func IsHorovodPod(name, ns string, pod *v1.Pod) bool {
@@ -149,3 +178,16 @@ func IsDeepSpeedPod(name, ns string, pod *v1.Pod) bool {
 	}
 	return true
 }
+
+func IsRayPod(name, ns string, pod *v1.Pod) bool {
+	if pod.Labels["release"] != name {
+		return false
+	}
+	if pod.Labels["app"] != string(types.RayTrainingJob) {
+		return false
+	}
+	if pod.Namespace != ns {
+		return false
+	}
+	return true
+}
@@ -68,6 +68,7 @@ func (m *ModelArgsBuilder) AddCommandFlags(command *cobra.Command) {
 
 	command.Flags().StringVar(&m.args.ModelConfigFile, "model-config-file", "", "model config file")
 	command.Flags().StringVar(&m.args.ModelName, "model-name", "", "model name")
+	command.Flags().StringVar(&m.args.ModelVersion, "model-version", "", "the version of the registered model,it is recorded for the model lineage")
 	command.Flags().StringVar(&m.args.ModelPath, "model-path", "", "model path")
 	command.Flags().StringVar(&m.args.Inputs, "inputs", "", "model inputs")
 	command.Flags().StringVar(&m.args.Outputs, "outputs", "", "model outputs")
@@ -135,6 +136,9 @@ func (m *ModelArgsBuilder) Build() error {
 	if err := m.setLabels(); err != nil {
 		return err
 	}
+	if err := m.setModelLabels(); err != nil {
+		return err
+	}
 	// set environment
 	if err := m.setEnvs(); err != nil {
 		return err
@@ -232,6 +236,20 @@ func (m *ModelArgsBuilder) setLabels() error {
 	return nil
 }
 
+// setModelLabels adds the labels modelName and modelVersion,so the job is found by 'arena model lineage'
+func (m *ModelArgsBuilder) setModelLabels() error {
+	if m.args.ModelName != "" {
+		m.args.Labels["modelName"] = m.args.ModelName
+	}
+	if m.args.ModelVersion != "" {
+		if m.args.ModelName == "" {
+			return fmt.Errorf("--model-name must be specified when --model-version is set")
+		}
+		m.args.Labels["modelVersion"] = m.args.ModelVersion
+	}
+	return nil
+}
+
 // setNodeSelectors is used to handle option --selector
 func (m *ModelArgsBuilder) setNodeSelectors() error {
 	m.args.NodeSelectors = map[string]string{}
@@ -116,7 +116,7 @@ func (s *ServingArgsBuilder) AddCommandFlags(command *cobra.Command) {
 
 	command.Flags().BoolVar(&s.args.ExposeService, "exposeService", false, "expose service using Istio gateway for external access or not (not expose by default)")
 	_ = command.Flags().MarkDeprecated("exposeService", "please use --expose-service instead")
-	command.Flags().BoolVar(&s.args.ExposeService, "expose-service", false, "expose service using Istio gateway for external access or not (not expose by default)")
+	command.Flags().BoolVar(&s.args.ExposeService, "expose-service", false, "expose service using the Istio gateway or the Gateway API HTTPRoute for external access or not (not expose by default)")
 
 	command.Flags().StringVar(&s.args.Name, "servingName", "", "the serving name")
 	_ = command.Flags().MarkDeprecated("servingName", "please use --name instead")
@@ -151,6 +151,8 @@ func (s *ServingArgsBuilder) AddCommandFlags(command *cobra.Command) {
 	command.Flags().StringVar(&s.args.ModelName, "model-name", "", "model name")
 	// add option --model-version
 	command.Flags().StringVar(&s.args.ModelVersion, "model-version", "", "model version")
+	// add option --dry-run
+	addDryRunFlag(command, &s.args.DryRun)
 
 	s.AddArgValue("annotation", &annotations).
 		AddArgValue("toleration", &tolerations).
@@ -175,6 +177,9 @@ func (s *ServingArgsBuilder) PreBuild() error {
 	if err := s.checkNamespace(); err != nil {
 		return err
 	}
+	if err := checkDryRunStrategy(&s.args.DryRun); err != nil {
+		return err
+	}
 	if err := s.validateIstioEnablement(); err != nil {
 		return err
 	}
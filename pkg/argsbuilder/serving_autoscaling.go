@@ -0,0 +1,96 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License
+package argsbuilder
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+type AutoscalingArgsBuilder struct {
+	args        *types.AutoscalingArgs
+	argValues   map[string]interface{}
+	subBuilders map[string]ArgsBuilder
+}
+
+func NewAutoscalingArgsBuilder(args *types.AutoscalingArgs) ArgsBuilder {
+	s := &AutoscalingArgsBuilder{
+		args:        args,
+		argValues:   map[string]interface{}{},
+		subBuilders: map[string]ArgsBuilder{},
+	}
+	return s
+}
+
+func (s *AutoscalingArgsBuilder) GetName() string {
+	items := strings.Split(fmt.Sprintf("%v", reflect.TypeOf(*s)), ".")
+	return items[len(items)-1]
+}
+
+func (s *AutoscalingArgsBuilder) AddSubBuilder(builders ...ArgsBuilder) ArgsBuilder {
+	for _, b := range builders {
+		s.subBuilders[b.GetName()] = b
+	}
+	return s
+}
+
+func (s *AutoscalingArgsBuilder) AddArgValue(key string, value interface{}) ArgsBuilder {
+	for name := range s.subBuilders {
+		s.subBuilders[name].AddArgValue(key, value)
+	}
+	s.argValues[key] = value
+	return s
+}
+
+func (s *AutoscalingArgsBuilder) AddCommandFlags(command *cobra.Command) {
+	for name := range s.subBuilders {
+		s.subBuilders[name].AddCommandFlags(command)
+	}
+	command.Flags().IntVar(&s.args.MinReplicas, "min-replicas", 1, "minimum number of replicas for autoscaling")
+	command.Flags().IntVar(&s.args.MaxReplicas, "max-replicas", 0, "maximum number of replicas for autoscaling,the horizontal pod autoscaler is created if it is greater than 0")
+	command.Flags().StringVar(&s.args.ScaleMetric, "scale-metric", types.DefaultAutoscalingMetric, "the scaling metric watched by the autoscaler. possible values are cpu, memory or the name of a custom pod metric,eg: nv_inference_queue_duration_us")
+	command.Flags().IntVar(&s.args.ScaleTarget, "scale-target", 0, "the target value of the scaling metric,it is the average utilization percentage for cpu and memory (default 80),otherwise the average value per pod")
+}
+
+func (s *AutoscalingArgsBuilder) PreBuild() error {
+	for name := range s.subBuilders {
+		if err := s.subBuilders[name].PreBuild(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *AutoscalingArgsBuilder) Build() error {
+	for name := range s.subBuilders {
+		if err := s.subBuilders[name].Build(); err != nil {
+			return err
+		}
+	}
+	if s.args.MaxReplicas == 0 {
+		return nil
+	}
+	if s.args.ScaleMetric == "" {
+		s.args.ScaleMetric = types.DefaultAutoscalingMetric
+	}
+	if s.args.ScaleTarget == 0 && types.IsResourceScaleMetric(s.args.ScaleMetric) {
+		s.args.ScaleTarget = types.DefaultAutoscalingTarget
+	}
+	return types.ValidateAutoscalingArgs(s.args)
+}
@@ -44,6 +44,7 @@ func NewCustomServingArgsBuilder(args *types.CustomServingArgs) ArgsBuilder {
 	}
 	s.AddSubBuilder(
 		NewServingArgsBuilder(&s.args.CommonServingArgs),
+		NewAutoscalingArgsBuilder(&s.args.AutoscalingArgs),
 	)
 	return s
 }
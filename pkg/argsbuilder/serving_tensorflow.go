@@ -45,6 +45,7 @@ func NewTensorflowServingArgsBuilder(args *types.TensorFlowServingArgs) ArgsBuil
 	}
 	s.AddSubBuilder(
 		NewServingArgsBuilder(&s.args.CommonServingArgs),
+		NewAutoscalingArgsBuilder(&s.args.AutoscalingArgs),
 	)
 	s.AddArgValue("default-image", DefaultTfServingImage)
 	return s
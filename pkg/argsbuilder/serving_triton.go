@@ -38,6 +38,7 @@ func NewTritonServingArgsBuilder(args *types.TritonServingArgs) ArgsBuilder {
 	}
 	s.AddSubBuilder(
 		NewServingArgsBuilder(&s.args.CommonServingArgs),
+		NewAutoscalingArgsBuilder(&s.args.AutoscalingArgs),
 	)
 	return s
 }
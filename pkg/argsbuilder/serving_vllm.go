@@ -0,0 +1,141 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License
+package argsbuilder
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+const (
+	DefaultVLLMServingImage = "vllm/vllm-openai:v0.6.3"
+)
+
+type VLLMServingArgsBuilder struct {
+	args        *types.VLLMServingArgs
+	argValues   map[string]interface{}
+	subBuilders map[string]ArgsBuilder
+}
+
+func NewVLLMServingArgsBuilder(args *types.VLLMServingArgs) ArgsBuilder {
+	args.Type = types.VLLMServingJob
+	s := &VLLMServingArgsBuilder{
+		args:        args,
+		argValues:   map[string]interface{}{},
+		subBuilders: map[string]ArgsBuilder{},
+	}
+	s.AddSubBuilder(
+		NewServingArgsBuilder(&s.args.CommonServingArgs),
+	)
+	s.AddArgValue("default-image", DefaultVLLMServingImage)
+	return s
+}
+
+func (s *VLLMServingArgsBuilder) GetName() string {
+	items := strings.Split(fmt.Sprintf("%v", reflect.TypeOf(*s)), ".")
+	return items[len(items)-1]
+}
+
+func (s *VLLMServingArgsBuilder) AddSubBuilder(builders ...ArgsBuilder) ArgsBuilder {
+	for _, b := range builders {
+		s.subBuilders[b.GetName()] = b
+	}
+	return s
+}
+
+func (s *VLLMServingArgsBuilder) AddArgValue(key string, value interface{}) ArgsBuilder {
+	for name := range s.subBuilders {
+		s.subBuilders[name].AddArgValue(key, value)
+	}
+	s.argValues[key] = value
+	return s
+}
+
+func (s *VLLMServingArgsBuilder) AddCommandFlags(command *cobra.Command) {
+	for name := range s.subBuilders {
+		s.subBuilders[name].AddCommandFlags(command)
+	}
+	command.Flags().StringVar(&s.args.ModelPath, "model-path", "", "the path of the model in the container,it is usually mounted by --data")
+	command.Flags().StringVar(&s.args.ModelID, "model-id", "", "the id of the model on huggingface hub,it is downloaded when the server starts,eg: Qwen/Qwen2.5-7B-Instruct")
+	command.Flags().StringVar(&s.args.ServedModelName, "served-model-name", "", "the model name used in the OpenAI api requests,default to the serving name")
+	command.Flags().IntVar(&s.args.Port, "port", 8000, "the port of the OpenAI compatible api server")
+	command.Flags().IntVar(&s.args.TensorParallelSize, "tensor-parallel-size", 0, "the number of GPUs used for tensor parallelism,default to the count of --gpus")
+	command.Flags().IntVar(&s.args.MaxModelLen, "max-model-len", 0, "the max context length of the model,default to the value derived from the model config")
+	command.Flags().StringVar(&s.args.Quantization, "quantization", "", "the method used to quantize the weights,eg: awq,gptq,fp8")
+	command.Flags().StringVar(&s.args.ExtendCommand, "extend-command", "", "the command will attach to server's command.")
+}
+
+func (s *VLLMServingArgsBuilder) PreBuild() error {
+	for name := range s.subBuilders {
+		if err := s.subBuilders[name].PreBuild(); err != nil {
+			return err
+		}
+	}
+	if err := s.setDefaultValues(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *VLLMServingArgsBuilder) Build() error {
+	for name := range s.subBuilders {
+		if err := s.subBuilders[name].Build(); err != nil {
+			return err
+		}
+	}
+	if err := s.validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *VLLMServingArgsBuilder) setDefaultValues() error {
+	if s.args.ServedModelName == "" {
+		s.args.ServedModelName = s.args.Name
+	}
+	if s.args.TensorParallelSize == 0 {
+		s.args.TensorParallelSize = 1
+		if s.args.GPUCount > 1 {
+			s.args.TensorParallelSize = s.args.GPUCount
+		}
+	}
+	return nil
+}
+
+func (s *VLLMServingArgsBuilder) validate() error {
+	// the user given command replaces the api server command,so the model is not required
+	if s.args.Command == "" {
+		if s.args.ModelPath == "" && s.args.ModelID == "" {
+			return fmt.Errorf("--model-path or --model-id must be specified")
+		}
+		if s.args.ModelPath != "" && s.args.ModelID != "" {
+			return fmt.Errorf("--model-path and --model-id can not be specified at the same time")
+		}
+	}
+	if s.args.Port <= 0 {
+		return fmt.Errorf("--port must be greater than 0")
+	}
+	if s.args.TensorParallelSize < 0 || s.args.MaxModelLen < 0 {
+		return fmt.Errorf("--tensor-parallel-size and --max-model-len must not be less than 0")
+	}
+	if s.args.GPUCount > 0 && s.args.TensorParallelSize > s.args.GPUCount {
+		return fmt.Errorf("--tensor-parallel-size %v is greater than --gpus %v", s.args.TensorParallelSize, s.args.GPUCount)
+	}
+	return nil
+}
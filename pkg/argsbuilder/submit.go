@@ -96,6 +96,12 @@ func (s *SubmitArgsBuilder) AddCommandFlags(command *cobra.Command) {
 	// add option --retry
 	command.Flags().IntVar(&s.args.Retry, "retry", 0,
 		"retry times.")
+	// add option --max-attempts
+	command.Flags().IntVar(&s.args.MaxAttempts, "max-attempts", 0,
+		"the max attempts of the job,the failed job is submitted again by the retry controller until the attempts are used up.")
+	// add option --retry-on
+	command.Flags().StringSliceVar(&s.args.RetryOn, "retry-on", []string{},
+		`the failures which are retried,any failure is retried if not set, usage: "--retry-on=exitcode:137,oom,node-lost"`)
 	// command.MarkFlagRequired("syncSource")
 	// add option --working-dir
 	command.Flags().StringVar(&s.args.WorkingDir, "workingDir", "/root", "working directory to extract the code. If using syncMode, the $workingDir/code contains the code")
@@ -147,6 +153,10 @@ func (s *SubmitArgsBuilder) AddCommandFlags(command *cobra.Command) {
 	command.Flags().StringVar(&s.args.ModelName, "model-name", "", "model name")
 	// add option --model-source
 	command.Flags().StringVar(&s.args.ModelSource, "model-source", "", "model source is a URI indicating the location of the model e.g. s3://my-bucket/path/to/model, pvc://namespace/pvc-name/path/to/model")
+	// add option --register-model-on-success
+	command.Flags().BoolVar(&s.args.RegisterModelOnSuccess, "register-model-on-success", false, "register the model version when the job is succeeded instead of submitted, it is observed by 'arena wait'")
+	// add option --dry-run
+	addDryRunFlag(command, &s.args.DryRun)
 
 	s.AddArgValue("image-pull-secret", &imagePullSecrets).
 		AddArgValue("config-file", &configFiles).
@@ -169,6 +179,9 @@ func (s *SubmitArgsBuilder) PreBuild() error {
 	if err := s.checkNameAndPriorityClassName(); err != nil {
 		return err
 	}
+	if err := checkDryRunStrategy(&s.args.DryRun); err != nil {
+		return err
+	}
 	// set data set
 	if err := s.setDataSet(); err != nil {
 		return err
@@ -177,6 +190,9 @@ func (s *SubmitArgsBuilder) PreBuild() error {
 	if err := s.checkModelNameAndSource(); err != nil {
 		return err
 	}
+	if err := s.checkRetryPolicy(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -272,7 +288,10 @@ func (s *SubmitArgsBuilder) checkNameAndPriorityClassName() error {
 
 // setDataDirs is used to handle option --data-dir
 func (s *SubmitArgsBuilder) setDataDirs() error {
-	s.args.DataDirs = []types.DataDirVolume{}
+	// the data dirs may be given by the job spec file
+	if s.args.DataDirs == nil {
+		s.args.DataDirs = []types.DataDirVolume{}
+	}
 	argKey := "data-dir"
 	var dataDirs *[]string
 	value, ok := s.argValues[argKey]
@@ -281,13 +300,14 @@ func (s *SubmitArgsBuilder) setDataDirs() error {
 	}
 	dataDirs = value.(*[]string)
 	log.Debugf("dataDir: %v", *dataDirs)
+	offset := len(s.args.DataDirs)
 	for i, dataDir := range *dataDirs {
 		hostPath, containerPath, err := util.ParseDataDirRaw(dataDir)
 		if err != nil {
 			return err
 		}
 		s.args.DataDirs = append(s.args.DataDirs, types.DataDirVolume{
-			Name:          fmt.Sprintf("training-data-%d", i),
+			Name:          fmt.Sprintf("training-data-%d", offset+i),
 			HostPath:      hostPath,
 			ContainerPath: containerPath,
 		})
@@ -297,7 +317,9 @@ func (s *SubmitArgsBuilder) setDataDirs() error {
 
 // setDataSets is used to handle option --data
 func (s *SubmitArgsBuilder) setDataSet() error {
-	s.args.DataSet = map[string]string{}
+	if s.args.DataSet == nil {
+		s.args.DataSet = map[string]string{}
+	}
 	argKey := "data"
 	var dataSet *[]string
 	value, ok := s.argValues[argKey]
@@ -313,7 +335,9 @@ func (s *SubmitArgsBuilder) setDataSet() error {
 	if err != nil {
 		return err
 	}
-	s.args.DataSet = transformSliceToMap(*dataSet, ":")
+	for key, val := range transformSliceToMap(*dataSet, ":") {
+		s.args.DataSet[key] = val
+	}
 	return nil
 }
 
@@ -436,13 +460,30 @@ func (s *SubmitArgsBuilder) setNodeSelectors() error {
 	}
 	nodeSelectors = value.(*[]string)
 	log.Debugf("node selectors: %v", *nodeSelectors)
-	s.args.NodeSelectors = transformSliceToMap(*nodeSelectors, "=")
+	for key, val := range transformSliceToMap(*nodeSelectors, "=") {
+		s.args.NodeSelectors[key] = val
+	}
 	return nil
 }
 
 // setConfigFiles is used to handle option --config-file
 func (s *SubmitArgsBuilder) setConfigFiles() error {
-	s.args.ConfigFiles = map[string]map[string]types.ConfigFileInfo{}
+	// the files of the job spec file carry their contents,keep them
+	restored := 0
+	configFileInfos := map[string]map[string]types.ConfigFileInfo{}
+	for containerPathKey, val := range s.args.ConfigFiles {
+		for configFileKey, info := range val {
+			if info.Content == "" {
+				continue
+			}
+			if _, ok := configFileInfos[containerPathKey]; !ok {
+				configFileInfos[containerPathKey] = map[string]types.ConfigFileInfo{}
+			}
+			configFileInfos[containerPathKey][configFileKey] = info
+			restored++
+		}
+	}
+	s.args.ConfigFiles = configFileInfos
 	if s.args.HelmOptions == nil {
 		s.args.HelmOptions = []string{}
 	}
@@ -460,7 +501,7 @@ func (s *SubmitArgsBuilder) setConfigFiles() error {
 			err           error
 		)
 		// use md5 rather than index,the reason is that if user gives a option twice,index can't filter it
-		configFileKey := fmt.Sprintf("config-%v", ind)
+		configFileKey := fmt.Sprintf("config-%v", ind+restored)
 		files := strings.Split(val, ":")
 		hostFile := files[0]
 		// change ~ to user home directory
@@ -509,6 +550,9 @@ func (s *SubmitArgsBuilder) setConfigFiles() error {
 	}
 	for containerPathkey, val := range s.args.ConfigFiles {
 		for configFileKey, info := range val {
+			if info.Content != "" {
+				continue
+			}
 			s.args.HelmOptions = append(s.args.HelmOptions,
 				fmt.Sprintf("--set-file configFiles.%v.%v.content=%v", containerPathkey, configFileKey, info.HostFile))
 		}
@@ -548,7 +592,9 @@ func (s *SubmitArgsBuilder) setTolerations() error {
 
 // setImagePullSecrets is used to set
 func (s *SubmitArgsBuilder) setImagePullSecrets() error {
-	s.args.ImagePullSecrets = []string{}
+	if s.args.ImagePullSecrets == nil {
+		s.args.ImagePullSecrets = []string{}
+	}
 	argKey := "image-pull-secret"
 	var imagePullSecrets *[]string
 	value, ok := s.argValues[argKey]
@@ -558,6 +604,10 @@ func (s *SubmitArgsBuilder) setImagePullSecrets() error {
 	imagePullSecrets = value.(*[]string)
 
 	if len(*imagePullSecrets) == 0 {
+		// the image pull secrets given by the job spec file have higher priority
+		if len(s.args.ImagePullSecrets) != 0 {
+			return nil
+		}
 		arenaConfig := config.GetArenaConfiger().GetConfigsFromConfigFile()
 		if temp, found := arenaConfig["imagePullSecrets"]; found {
 			log.Debugf("imagePullSecrets load from arenaConfigs: %v", temp)
@@ -623,6 +673,36 @@ func (s *SubmitArgsBuilder) checkModelNameAndSource() error {
 	if s.args.ModelName != "" && s.args.ModelSource == "" {
 		return errors.New("model version source must be specified when registering a model version")
 	}
+	if s.args.RegisterModelOnSuccess && s.args.ModelName == "" {
+		return errors.New("--model-name must be specified when --register-model-on-success is set")
+	}
+	// the horovod job is not a crd,so it can not be labeled after its model version is registered
+	if s.args.RegisterModelOnSuccess && s.args.TrainingType == types.HorovodTrainingJob {
+		return fmt.Errorf("--register-model-on-success is not supported by %v", types.HorovodTrainingJob)
+	}
+	return nil
+}
+
+// checkRetryPolicy is used to check the options --max-attempts and --retry-on
+func (s *SubmitArgsBuilder) checkRetryPolicy() error {
+	if s.args.MaxAttempts < 0 {
+		return fmt.Errorf("--max-attempts must not be negative")
+	}
+	if len(s.args.RetryOn) != 0 && s.args.MaxAttempts <= 1 {
+		return fmt.Errorf("--retry-on is set,but the job is not retried,please set --max-attempts greater than 1")
+	}
+	for _, condition := range s.args.RetryOn {
+		switch {
+		case condition == string(types.RetryOnOOM), condition == string(types.RetryOnNodeLost):
+		case strings.HasPrefix(condition, types.RetryOnExitCodePrefix):
+			code, err := strconv.Atoi(strings.TrimPrefix(condition, types.RetryOnExitCodePrefix))
+			if err != nil || code <= 0 || code > 255 {
+				return fmt.Errorf("invalid exit code in --retry-on %v,it should be in [1,255]", condition)
+			}
+		default:
+			return fmt.Errorf("invalid --retry-on %v,the possible option is [exitcode:<code>, %v, %v]", condition, types.RetryOnOOM, types.RetryOnNodeLost)
+		}
+	}
 	return nil
 }
 
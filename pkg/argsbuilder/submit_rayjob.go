@@ -0,0 +1,198 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License
+package argsbuilder
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+type SubmitRayJobArgsBuilder struct {
+	args        *types.SubmitRayJobArgs
+	argValues   map[string]interface{}
+	subBuilders map[string]ArgsBuilder
+}
+
+func NewSubmitRayJobArgsBuilder(args *types.SubmitRayJobArgs) ArgsBuilder {
+	args.TrainingType = types.RayTrainingJob
+	s := &SubmitRayJobArgsBuilder{
+		args:        args,
+		argValues:   map[string]interface{}{},
+		subBuilders: map[string]ArgsBuilder{},
+	}
+	s.AddSubBuilder(
+		NewSubmitArgsBuilder(&s.args.CommonSubmitArgs),
+	)
+	return s
+}
+
+func (s *SubmitRayJobArgsBuilder) GetName() string {
+	items := strings.Split(fmt.Sprintf("%v", reflect.TypeOf(*s)), ".")
+	return items[len(items)-1]
+}
+
+func (s *SubmitRayJobArgsBuilder) AddSubBuilder(builders ...ArgsBuilder) ArgsBuilder {
+	for _, b := range builders {
+		s.subBuilders[b.GetName()] = b
+	}
+	return s
+}
+
+func (s *SubmitRayJobArgsBuilder) AddArgValue(key string, value interface{}) ArgsBuilder {
+	for name := range s.subBuilders {
+		s.subBuilders[name].AddArgValue(key, value)
+	}
+	s.argValues[key] = value
+	return s
+}
+
+func (s *SubmitRayJobArgsBuilder) AddCommandFlags(command *cobra.Command) {
+	for name := range s.subBuilders {
+		s.subBuilders[name].AddCommandFlags(command)
+	}
+
+	var (
+		runtimeEnv       string
+		runningTimeout   time.Duration
+		ttlAfterFinished time.Duration
+	)
+
+	command.Flags().StringVar(&s.args.HeadCPU, "head-cpu", "", "the cpu resource to use for the ray head, like 1 for 1 core.")
+	command.Flags().StringVar(&s.args.HeadMemory, "head-memory", "", "the memory resource to use for the ray head, like 1Gi.")
+	command.Flags().IntVar(&s.args.HeadGPUCount, "head-gpus", 0, "the gpu count of the ray head, the option --gpus is used for every ray worker.")
+	command.Flags().StringVar(&s.args.WorkerCPU, "worker-cpu", "", "the cpu resource to use for every ray worker, like 1 for 1 core.")
+	command.Flags().StringVar(&s.args.WorkerMemory, "worker-memory", "", "the memory resource to use for every ray worker, like 1Gi.")
+	command.Flags().StringVar(&s.args.RayVersion, "ray-version", "", "the version of ray used in the image, like 2.9.0.")
+	command.Flags().StringVar(&runtimeEnv, "runtime-env", "", "the yaml file of the ray runtime environment, like pip packages and env vars.")
+	command.Flags().BoolVar(&s.args.ShutdownAfterJobFinishes, "shutdown-after-finished", true, "delete the ray cluster after the job finishes.")
+	command.Flags().DurationVar(&runningTimeout, "running-timeout", runningTimeout, "Specifies the duration since startTime during which the job can remain active before it is terminated(e.g. '5s', '1m', '2h22m').")
+	command.Flags().DurationVar(&ttlAfterFinished, "ttl-after-finished", ttlAfterFinished, "Defines the TTL for cleaning up the ray cluster after the job finishes(e.g. '5s', '1m', '2h22m'), only works with --shutdown-after-finished.")
+
+	s.AddArgValue("runtime-env", &runtimeEnv).
+		AddArgValue("running-timeout", &runningTimeout).
+		AddArgValue("ttl-after-finished", &ttlAfterFinished)
+}
+
+func (s *SubmitRayJobArgsBuilder) PreBuild() error {
+	for name := range s.subBuilders {
+		if err := s.subBuilders[name].PreBuild(); err != nil {
+			return err
+		}
+	}
+	s.AddArgValue(ShareDataPrefix+"dataset", s.args.DataSet)
+	return nil
+}
+
+func (s *SubmitRayJobArgsBuilder) Build() error {
+	for name := range s.subBuilders {
+		if err := s.subBuilders[name].Build(); err != nil {
+			return err
+		}
+	}
+	if err := s.setRunPolicy(); err != nil {
+		return err
+	}
+	if err := s.setRuntimeEnv(); err != nil {
+		return err
+	}
+	if err := s.check(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *SubmitRayJobArgsBuilder) setRunPolicy() error {
+	// Get active deadline
+	if rt, ok := s.argValues["running-timeout"]; ok {
+		runningTimeout := rt.(*time.Duration)
+		if *runningTimeout != 0 {
+			s.args.ActiveDeadlineSeconds = int64(runningTimeout.Seconds())
+		}
+	}
+
+	// Get ttlSecondsAfterFinished
+	if ft, ok := s.argValues["ttl-after-finished"]; ok {
+		ttlAfterFinished := ft.(*time.Duration)
+		if *ttlAfterFinished != 0 {
+			s.args.TTLSecondsAfterFinished = int32(ttlAfterFinished.Seconds())
+		}
+	}
+	return nil
+}
+
+// setRuntimeEnv reads the runtime environment from the file given by option --runtime-env
+func (s *SubmitRayJobArgsBuilder) setRuntimeEnv() error {
+	item, ok := s.argValues["runtime-env"]
+	if !ok {
+		return nil
+	}
+	file := *(item.(*string))
+	if file == "" {
+		return nil
+	}
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return fmt.Errorf("failed to read runtime env file %v: %v", file, err)
+	}
+	runtimeEnv := map[string]interface{}{}
+	if err := yaml.Unmarshal(content, &runtimeEnv); err != nil {
+		return fmt.Errorf("the runtime env file %v is not a valid yaml file: %v", file, err)
+	}
+	s.args.RuntimeEnvYAML = string(content)
+	return nil
+}
+
+func (s *SubmitRayJobArgsBuilder) check() error {
+	if s.args.Image == "" {
+		return fmt.Errorf("--image must be set ")
+	}
+	if s.args.WorkerCount < 0 {
+		return fmt.Errorf("--workers is invalid")
+	}
+	if s.args.GPUCount < 0 {
+		return fmt.Errorf("--gpus is invalid")
+	}
+	if s.args.HeadGPUCount < 0 {
+		return fmt.Errorf("--head-gpus is invalid")
+	}
+	for option, value := range map[string]string{
+		"--head-cpu":      s.args.HeadCPU,
+		"--head-memory":   s.args.HeadMemory,
+		"--worker-cpu":    s.args.WorkerCPU,
+		"--worker-memory": s.args.WorkerMemory,
+	} {
+		if value == "" {
+			continue
+		}
+		if _, err := resource.ParseQuantity(value); err != nil {
+			return fmt.Errorf("%v is invalid", option)
+		}
+	}
+	if s.args.ActiveDeadlineSeconds < 0 {
+		return fmt.Errorf("--running-timeout is invalid")
+	}
+	if s.args.TTLSecondsAfterFinished < 0 {
+		return fmt.Errorf("--ttl-after-finished is invalid")
+	}
+	return nil
+}
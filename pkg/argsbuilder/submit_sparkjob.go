@@ -70,6 +70,7 @@ func (s *SubmitSparkJobArgsBuilder) AddCommandFlags(command *cobra.Command) {
 	)
 	command.Flags().StringVar(&s.args.Name, "name", "", "override name")
 	_ = command.MarkFlagRequired("name")
+	addDryRunFlag(command, &s.args.DryRun)
 
 	command.Flags().StringVar(&s.args.Image, "image", "registry.aliyuncs.com/acs/spark:v2.4.0", "the docker image name of training job")
 	command.Flags().IntVar(&s.args.Executor.Replicas, "replicas", 1, "the executor's number to run the distributed training.")
@@ -92,6 +93,9 @@ func (s *SubmitSparkJobArgsBuilder) PreBuild() error {
 			return err
 		}
 	}
+	if err := checkDryRunStrategy(&s.args.DryRun); err != nil {
+		return err
+	}
 	return nil
 }
 
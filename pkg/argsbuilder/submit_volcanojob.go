@@ -69,6 +69,7 @@ func (s *SubmitVolcanoJobArgsBuilder) AddCommandFlags(command *cobra.Command) {
 	)
 	command.Flags().StringVar(&s.args.Name, "name", "", "assign the job name")
 	_ = command.MarkFlagRequired("name")
+	addDryRunFlag(command, &s.args.DryRun)
 	command.Flags().IntVar(&(s.args.MinAvailable), "minAvailable", 1, "The minimal available pods to run for this Job. default value is 1")
 	_ = command.Flags().MarkDeprecated("minAvailable", "please use --min-available instead")
 	command.Flags().IntVar(&(s.args.MinAvailable), "min-available", 1, "The minimal available pods to run for this Job. default value is 1")
@@ -107,6 +108,9 @@ func (s *SubmitVolcanoJobArgsBuilder) PreBuild() error {
 			return err
 		}
 	}
+	if err := checkDryRunStrategy(&s.args.DryRun); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -0,0 +1,105 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License
+package argsbuilder
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+type SubmitXGBoostJobArgsBuilder struct {
+	args        *types.SubmitXGBoostJobArgs
+	argValues   map[string]interface{}
+	subBuilders map[string]ArgsBuilder
+}
+
+func NewSubmitXGBoostJobArgsBuilder(args *types.SubmitXGBoostJobArgs) ArgsBuilder {
+	args.TrainingType = types.XGBoostTrainingJob
+	s := &SubmitXGBoostJobArgsBuilder{
+		args:        args,
+		argValues:   map[string]interface{}{},
+		subBuilders: map[string]ArgsBuilder{},
+	}
+	s.AddSubBuilder(
+		NewSubmitArgsBuilder(&s.args.CommonSubmitArgs),
+		NewSubmitTrainingOperatorArgsBuilder(&s.args.SubmitTrainingOperatorArgs),
+	)
+	return s
+}
+
+func (s *SubmitXGBoostJobArgsBuilder) GetName() string {
+	items := strings.Split(fmt.Sprintf("%v", reflect.TypeOf(*s)), ".")
+	return items[len(items)-1]
+}
+
+func (s *SubmitXGBoostJobArgsBuilder) AddSubBuilder(builders ...ArgsBuilder) ArgsBuilder {
+	for _, b := range builders {
+		s.subBuilders[b.GetName()] = b
+	}
+	return s
+}
+
+func (s *SubmitXGBoostJobArgsBuilder) AddArgValue(key string, value interface{}) ArgsBuilder {
+	for name := range s.subBuilders {
+		s.subBuilders[name].AddArgValue(key, value)
+	}
+	s.argValues[key] = value
+	return s
+}
+
+func (s *SubmitXGBoostJobArgsBuilder) AddCommandFlags(command *cobra.Command) {
+	for name := range s.subBuilders {
+		s.subBuilders[name].AddCommandFlags(command)
+	}
+}
+
+func (s *SubmitXGBoostJobArgsBuilder) PreBuild() error {
+	for name := range s.subBuilders {
+		if err := s.subBuilders[name].PreBuild(); err != nil {
+			return err
+		}
+	}
+	s.AddArgValue(ShareDataPrefix+"dataset", s.args.DataSet)
+	return nil
+}
+
+func (s *SubmitXGBoostJobArgsBuilder) Build() error {
+	for name := range s.subBuilders {
+		if err := s.subBuilders[name].Build(); err != nil {
+			return err
+		}
+	}
+	if err := s.check(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *SubmitXGBoostJobArgsBuilder) check() error {
+	if s.args.Image == "" {
+		return fmt.Errorf("--image must be set ")
+	}
+	if s.args.WorkerCount < 1 {
+		return fmt.Errorf("--workers must be greater than 0")
+	}
+	if s.args.GPUCount < 0 {
+		return fmt.Errorf("--gpus is invalid")
+	}
+	return nil
+}
@@ -88,6 +88,8 @@ func (s *UpdateServingArgsBuilder) AddCommandFlags(command *cobra.Command) {
 	command.Flags().StringArrayVarP(&selectors, "selector", "", []string{}, `assigning jobs to some k8s particular nodes, usage: "--selector=key=value" or "--selector key=value" `)
 	command.Flags().StringArrayVarP(&tolerations, "toleration", "", []string{}, `tolerate some k8s nodes with taints,usage: "--toleration key=value:effect,operator" or "--toleration all" `)
 	command.Flags().StringArrayVarP(&dataset, "data", "d", []string{}, "specify the trained models datasource to mount for serving, like <name_of_datasource>:<mount_point_on_job>")
+	command.Flags().StringVar(&s.args.ModelVersion, "model-version", "", "the version of the registered model to serve,the registered model is given by the label modelName of the serving job")
+	command.Flags().StringVar(&s.args.ModelAlias, "model-alias", "", "the alias of the registered model version to serve,like champion")
 
 	s.AddArgValue("env", &envs).
 		AddArgValue("annotation", &annotations).
@@ -157,6 +159,9 @@ func (s *UpdateServingArgsBuilder) Build() error {
 }
 
 func (s *UpdateServingArgsBuilder) check() error {
+	if s.args.ModelVersion != "" && s.args.ModelAlias != "" {
+		return fmt.Errorf("--model-version and --model-alias can not be set at the same time")
+	}
 	if s.args.GPUCount < 0 {
 		return fmt.Errorf("--gpus is invalid")
 	}
@@ -37,6 +37,7 @@ func NewUpdateCustomServingArgsBuilder(args *types.UpdateCustomServingArgs) Args
 	}
 	s.AddSubBuilder(
 		NewUpdateServingArgsBuilder(&s.args.CommonUpdateServingArgs),
+		NewUpdateAutoscalingArgsBuilder(&s.args.AutoscalingArgs),
 	)
 	s.AddArgValue("default-image", DefaultTfServingImage)
 	return s
@@ -40,6 +40,7 @@ func NewUpdateTensorflowServingArgsBuilder(args *types.UpdateTensorFlowServingAr
 	}
 	s.AddSubBuilder(
 		NewUpdateServingArgsBuilder(&s.args.CommonUpdateServingArgs),
+		NewUpdateAutoscalingArgsBuilder(&s.args.AutoscalingArgs),
 	)
 	s.AddArgValue("default-image", DefaultTfServingImage)
 	return s
@@ -37,6 +37,7 @@ func NewUpdateTritonServingArgsBuilder(args *types.UpdateTritonServingArgs) Args
 	}
 	s.AddSubBuilder(
 		NewUpdateServingArgsBuilder(&s.args.CommonUpdateServingArgs),
+		NewUpdateAutoscalingArgsBuilder(&s.args.AutoscalingArgs),
 	)
 	s.AddArgValue("default-image", DefaultTfServingImage)
 	return s
@@ -2,8 +2,12 @@ package argsbuilder
 
 import (
 	"errors"
-	"github.com/kubeflow/arena/pkg/apis/types"
+	"fmt"
 	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
 )
 
 func transformSliceToMap(sets []string, split string) (valuesMap map[string]string) {
@@ -38,3 +42,24 @@ func split(value, sep string) (string, string) {
 	index := strings.Index(value, sep)
 	return value[:index], value[index+1:]
 }
+
+// addDryRunFlag adds option --dry-run,"--dry-run" without value equals to "--dry-run=client"
+func addDryRunFlag(command *cobra.Command, dryRun *types.DryRunStrategy) {
+	command.Flags().StringVar((*string)(dryRun), "dry-run", "", `dry run the submission,"client" prints the rendered manifests without creating them,"server" sends them to the api server with server side dry run. One of: none|client|server`)
+	command.Flags().Lookup("dry-run").NoOptDefVal = string(types.DryRunClient)
+}
+
+// checkDryRunStrategy checks the option --dry-run and transfers "none" to types.DryRunNone
+func checkDryRunStrategy(dryRun *types.DryRunStrategy) error {
+	switch strings.ToLower(string(*dryRun)) {
+	case "", "none":
+		*dryRun = types.DryRunNone
+	case string(types.DryRunClient):
+		*dryRun = types.DryRunClient
+	case string(types.DryRunServer):
+		*dryRun = types.DryRunServer
+	default:
+		return fmt.Errorf("invalid --dry-run value %v,only support: [none,client,server]", *dryRun)
+	}
+	return nil
+}
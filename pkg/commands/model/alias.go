@@ -0,0 +1,78 @@
+package model
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func NewModelAliasCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "alias",
+		Short: "Manage the aliases of a registered model",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.HelpFunc()(cmd, args)
+		},
+	}
+	command.AddCommand(newModelAliasListCommand())
+	command.AddCommand(newModelAliasDeleteCommand())
+	return command
+}
+
+func newModelAliasListCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:     "list NAME",
+		Short:   "List the aliases of a registered model",
+		Aliases: []string{"ls"},
+		Args:    cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			_ = viper.BindPFlags(cmd.Flags())
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			modelClient, err := newModelClient()
+			if err != nil {
+				return err
+			}
+			aliases, err := modelClient.ListRegisteredModelAliases(args[0])
+			if err != nil {
+				return err
+			}
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "ALIAS\tVERSION")
+			for _, alias := range aliases {
+				fmt.Fprintf(w, "%s\t%s\n", alias.Alias, alias.Version)
+			}
+			return w.Flush()
+		},
+	}
+	return command
+}
+
+func newModelAliasDeleteCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "delete NAME ALIAS",
+		Short: "Delete an alias of a registered model",
+		Args:  cobra.ExactArgs(2),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			_ = viper.BindPFlags(cmd.Flags())
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			modelClient, err := newModelClient()
+			if err != nil {
+				return err
+			}
+			if err := modelClient.DeleteRegisteredModelAlias(args[0], args[1]); err != nil {
+				return err
+			}
+			log.Infof("alias \"%s\" of model \"%s\" deleted\n", args[1], args[0])
+			return nil
+		},
+	}
+	return command
+}
@@ -0,0 +1,69 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v2"
+)
+
+func NewModelDiffCommand() *cobra.Command {
+	var output string
+	var command = &cobra.Command{
+		Use:     "diff NAME VERSION1 VERSION2",
+		Short:   "Show the differences of the description,source and tags between two model versions",
+		Example: "  arena model diff my-model v3 v5",
+		Args:    cobra.ExactArgs(3),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			_ = viper.BindPFlags(cmd.Flags())
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			modelClient, err := newModelClient()
+			if err != nil {
+				return err
+			}
+			// the versions can be given as 3 or v3
+			from := strings.TrimPrefix(args[1], "v")
+			to := strings.TrimPrefix(args[2], "v")
+			diffs, err := modelClient.DiffModelVersions(args[0], from, to)
+			if err != nil {
+				return err
+			}
+			switch output {
+			case "json":
+				data, _ := json.MarshalIndent(diffs, "", "    ")
+				fmt.Println(string(data))
+			case "yaml":
+				data, _ := yaml.Marshal(diffs)
+				fmt.Print(string(data))
+			case "":
+				if len(diffs) == 0 {
+					fmt.Printf("no differences between version %s and %s\n", from, to)
+					return nil
+				}
+				w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+				fmt.Fprintf(w, "FIELD\tVERSION %s\tVERSION %s\n", from, to)
+				for _, diff := range diffs {
+					fmt.Fprintf(w, "%s\t%s\t%s\n", diff.Field, oneLine(diff.From), oneLine(diff.To))
+				}
+				return w.Flush()
+			default:
+				return fmt.Errorf("unknown output format %v,only support: [json,yaml]", output)
+			}
+			return nil
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json|yaml")
+	return command
+}
+
+// oneLine keeps the multiline description in a row of the table
+func oneLine(s string) string {
+	return strings.ReplaceAll(s, "\n", `\n`)
+}
@@ -0,0 +1,48 @@
+package model
+
+import (
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kubeflow/arena/pkg/model/lineage"
+)
+
+func NewModelLineageCommand() *cobra.Command {
+	var version, output string
+	var allNamespaces bool
+	var command = &cobra.Command{
+		Use:   "lineage NAME [--version VERSION]",
+		Short: "Show the jobs which produced,evaluated,analyzed and served the model versions",
+		Long: `Show the jobs which produced,evaluated,analyzed and served the model versions.
+
+The training jobs,evaluate jobs,model analyze jobs and serving jobs are found by the model name and
+version given when they were submitted (--model-name and --model-version),the graph can be printed
+as a tree,json or graphviz dot.`,
+		Example: `  arena model lineage my-model
+  arena model lineage my-model --version 3 -A
+  arena model lineage my-model -o dot | dot -Tpng -o my-model.png`,
+		Args: cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			_ = viper.BindPFlags(cmd.Flags())
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			modelClient, err := newModelClient()
+			if err != nil {
+				return err
+			}
+			modelLineage, err := modelClient.Lineage(args[0], strings.TrimPrefix(version, "v"), allNamespaces)
+			if err != nil {
+				return err
+			}
+			return lineage.PrintModelLineage(os.Stdout, modelLineage, output)
+		},
+	}
+	command.Flags().StringVar(&version, "version", "", "only show the model version")
+	command.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "show the jobs of all the namespaces")
+	command.Flags().StringVarP(&output, "output", "o", "tree", "Output format. One of: tree|json|dot")
+	return command
+}
@@ -1,8 +1,13 @@
 package model
 
 import (
+	"fmt"
+
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
+	"github.com/kubeflow/arena/pkg/apis/arenaclient"
+	"github.com/kubeflow/arena/pkg/apis/types"
 	"github.com/kubeflow/arena/pkg/commands/model/analyze"
 )
 
@@ -20,8 +25,32 @@ func NewModelCommand() *cobra.Command {
 	command.AddCommand(NewModelListCommand())
 	command.AddCommand(NewModelUpdateCommand())
 	command.AddCommand(NewModelDeleteCommand())
+	command.AddCommand(NewModelPromoteCommand())
+	command.AddCommand(NewModelAliasCommand())
+	command.AddCommand(NewModelDiffCommand())
+	command.AddCommand(NewModelPullCommand())
+	command.AddCommand(NewModelPushCommand())
+	command.AddCommand(NewModelLineageCommand())
 
 	command.AddCommand(analyze.NewAnalyzeCommand())
 
 	return command
 }
+
+func newModelClient() (*arenaclient.ModelClient, error) {
+	client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
+		Kubeconfig:     viper.GetString("config"),
+		LogLevel:       viper.GetString("loglevel"),
+		Namespace:      viper.GetString("namespace"),
+		ArenaNamespace: viper.GetString("arena-namespace"),
+		IsDaemonMode:   false,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create arena client: %v", err)
+	}
+	modelClient, err := client.Model()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create arena model client: %v", err)
+	}
+	return modelClient, nil
+}
@@ -0,0 +1,61 @@
+package model
+
+import (
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+var promoteExample = `
+  # promote the model version 5 to production
+  arena model promote my-model --version 5 --alias production
+
+  # promote the model version 5 to production only if the evaluate job meets the thresholds
+  arena model promote my-model --version 5 --alias production --evaluate-job eval-my-model-5 --threshold "accuracy>=0.9" --threshold "loss<0.1"
+`
+
+func NewModelPromoteCommand() *cobra.Command {
+	args := &types.ModelPromoteArgs{}
+	var command = &cobra.Command{
+		Use:   "promote NAME --version VERSION --alias ALIAS",
+		Short: "Promote a model version by moving the alias to it",
+		Long: `Promote a model version by moving the alias to it.
+
+The promotion can be gated on the metrics of a completed evaluate job,the evaluator writes them
+as a json object like {"accuracy": 0.93} to the --metrics-path of the evaluate job. If the path is
+a directory,the metrics are written to metrics.json in it. The evaluatejob chart copies the metrics
+to the termination message of the container after the evaluator succeeds,where they are read from.`,
+		Example: promoteExample,
+		Args:    cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, _ []string) error {
+			_ = viper.BindPFlags(cmd.Flags())
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, names []string) error {
+			modelClient, err := newModelClient()
+			if err != nil {
+				return err
+			}
+			args.Name = names[0]
+			previous, err := modelClient.Promote(args)
+			if err != nil {
+				return err
+			}
+			if previous != "" && previous != args.Version {
+				log.Infof("alias \"%s\" of model \"%s\" moved from version %s to %s\n", args.Alias, args.Name, previous, args.Version)
+			} else {
+				log.Infof("model version \"%s/%s\" promoted to \"%s\"\n", args.Name, args.Version, args.Alias)
+			}
+			return nil
+		},
+	}
+	command.Flags().StringVar(&args.Version, "version", "", "model version")
+	_ = command.MarkFlagRequired("version")
+	command.Flags().StringVar(&args.Alias, "alias", "", "the alias moved to the model version, like production")
+	_ = command.MarkFlagRequired("alias")
+	command.Flags().StringVar(&args.EvaluateJob, "evaluate-job", "", "the completed evaluate job whose metrics gate the promotion")
+	command.Flags().StringArrayVar(&args.Thresholds, "threshold", []string{}, `the metric threshold of the evaluate job, usage: "--threshold accuracy>=0.9"`)
+	return command
+}
@@ -0,0 +1,41 @@
+package model
+
+import (
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func NewModelPullCommand() *cobra.Command {
+	var version, dest string
+	var command = &cobra.Command{
+		Use:   "pull NAME --version VERSION --dest DIR",
+		Short: "Download the artifacts of a model version",
+		Long: `Download the artifacts of a model version.
+
+The artifacts are transferred through the mlflow artifacts proxy (mlflow-artifacts:/) or the s3 compatible
+endpoint configured in the arena configuration file (s3://),the checksum recorded by 'arena model push' is verified.`,
+		Example: "  arena model pull my-model --version 5 --dest ./my-model",
+		Args:    cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			_ = viper.BindPFlags(cmd.Flags())
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			modelClient, err := newModelClient()
+			if err != nil {
+				return err
+			}
+			checksum, err := modelClient.Pull(args[0], version, dest)
+			if err != nil {
+				return err
+			}
+			log.Infof("model version \"%s/%s\" pulled to %s, %d files, sha256: %s\n", args[0], version, dest, len(checksum.Files), checksum.Digest())
+			return nil
+		},
+	}
+	command.Flags().StringVar(&version, "version", "", "model version")
+	_ = command.MarkFlagRequired("version")
+	command.Flags().StringVar(&dest, "dest", ".", "the directory which the artifacts are downloaded to")
+	return command
+}
@@ -0,0 +1,43 @@
+package model
+
+import (
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func NewModelPushCommand() *cobra.Command {
+	var path, uri, versionDescription string
+	var command = &cobra.Command{
+		Use:   "push NAME --path PATH",
+		Short: "Upload a model file or directory and create a model version of it",
+		Long: `Upload a model file or directory and create a model version of it.
+
+The artifacts are uploaded to --uri,or under model_artifact_uri_prefix of the arena configuration file if not set.
+The checksum of the artifacts is recorded as the version tags artifact.sha256,artifact.files and artifact.size.`,
+		Example: `  arena model push my-model --path ./my-model
+  arena model push my-model --path ./my-model --uri s3://my-bucket/models/my-model/v6`,
+		Args: cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			_ = viper.BindPFlags(cmd.Flags())
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			modelClient, err := newModelClient()
+			if err != nil {
+				return err
+			}
+			modelVersion, err := modelClient.Push(args[0], path, uri, versionDescription)
+			if err != nil {
+				return err
+			}
+			log.Infof("model version \"%s/%s\" pushed to %s\n", modelVersion.Name, modelVersion.Version, modelVersion.Source)
+			return nil
+		},
+	}
+	command.Flags().StringVar(&path, "path", "", "the model file or directory to upload")
+	_ = command.MarkFlagRequired("path")
+	command.Flags().StringVar(&uri, "uri", "", "the uri which the artifacts are uploaded to, like s3://my-bucket/path/to/model or mlflow-artifacts:/path/to/model")
+	command.Flags().StringVar(&versionDescription, "version-description", "", "model version description")
+	return command
+}
@@ -46,6 +46,12 @@ func NewCommand() *cobra.Command {
 	command.AddCommand(training.NewLogViewerCommand())
 	command.AddCommand(training.NewLogsCommand())
 	command.AddCommand(training.NewDeleteCommand())
+	command.AddCommand(training.NewWaitCommand())
+	command.AddCommand(training.NewSuspendCommand())
+	command.AddCommand(training.NewResumeCommand())
+	command.AddCommand(training.NewRerunCommand())
+	command.AddCommand(training.NewRetryControllerCommand())
+	command.AddCommand(training.NewDiagnoseCommand())
 	command.AddCommand(topcommand.NewTopCommand())
 	command.AddCommand(NewVersionCmd(CLIName))
 	command.AddCommand(datacommand.NewDataCommand())
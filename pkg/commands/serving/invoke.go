@@ -0,0 +1,120 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kubeflow/arena/pkg/apis/arenaclient"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+)
+
+// NewInvokeCommand
+func NewInvokeCommand() *cobra.Command {
+	var servingType string
+	var version string
+	var data string
+	var protocol string
+	invokeArgs := &types.ServingInvokeArgs{}
+	var bashCompletionFlags = map[string]string{
+		"version": "__arena_serve_all_version",
+		"type":    "__arena_serve_all_type",
+	}
+	var command = &cobra.Command{
+		Use:   "invoke JOB [-T JOB_TYPE] [-v JOB_VERSION] [--data @FILE]",
+		Short: "Send a test request to a serving job and display the latency and the response",
+		Long: `Send a test request to a serving job through the service proxy of the api server,
+the request path is picked by the inference protocol of the serving job:
+  tfserving  /v1/models/<model>:predict (tensorflow serving)
+  v1         /v1/models/<model>:predict (kserve v1 protocol)
+  v2         /v2/models/<model>/infer (triton and kserve v2 protocol)
+  openai     /v1/chat/completions (vllm)
+  raw        the path given by --path (custom serving)`,
+		Example: `  arena serve invoke my-tf-serving --data @request.json
+  arena serve invoke my-triton --model resnet50 --data @request.json
+  arena serve invoke my-llm --data '{"messages": [{"role": "user", "content": "Hello"}]}'`,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return fmt.Errorf("not set job name,please set it")
+			}
+			name := args[0]
+			body, err := readInvokeData(data)
+			if err != nil {
+				return err
+			}
+			invokeArgs.Data = body
+			invokeArgs.Protocol = types.InvokeProtocol(protocol)
+			invokeArgs.Method = strings.ToUpper(invokeArgs.Method)
+			client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
+				Kubeconfig:     viper.GetString("config"),
+				LogLevel:       viper.GetString("loglevel"),
+				Namespace:      viper.GetString("namespace"),
+				ArenaNamespace: viper.GetString("arena-namespace"),
+				IsDaemonMode:   false,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create arena client: %v", err)
+			}
+			return client.Serving().InvokeAndPrint(name, version, utils.TransferServingJobType(servingType), invokeArgs)
+		},
+	}
+	command.Flags().StringVarP(&version, "version", "v", "", "Set the serving job version")
+	command.Flags().StringVarP(&servingType, "type", "T", "", fmt.Sprintf("The serving type, the possible option is [%v]. (optional)", utils.GetSupportServingJobTypesInfo()))
+	command.Flags().StringVarP(&data, "data", "d", "", "the request body,read it from a file by @FILE or from stdin by @-")
+	command.Flags().StringVar(&protocol, "protocol", "", "the inference protocol, the possible option is [tfserving|v1|v2|openai|raw],default to the protocol of the serving type")
+	command.Flags().StringVar(&invokeArgs.Model, "model", "", "the model name used in the request path or the OpenAI request body")
+	command.Flags().StringVar(&invokeArgs.Path, "path", "", "the request path,it overrides the path given by the protocol")
+	command.Flags().StringVarP(&invokeArgs.Method, "method", "X", "", "the http method,default to POST if --data is given,otherwise GET")
+	command.Flags().DurationVar(&invokeArgs.Timeout, "timeout", 60*time.Second, "the timeout of the request")
+	for name, completion := range bashCompletionFlags {
+		if command.Flag(name) != nil {
+			if command.Flag(name).Annotations == nil {
+				command.Flag(name).Annotations = map[string][]string{}
+			}
+			command.Flag(name).Annotations[cobra.BashCompCustom] = append(
+				command.Flag(name).Annotations[cobra.BashCompCustom],
+				completion,
+			)
+		}
+	}
+	return command
+}
+
+// readInvokeData reads the request body like curl,@FILE reads a file and @- reads the stdin
+func readInvokeData(data string) ([]byte, error) {
+	if !strings.HasPrefix(data, "@") {
+		return []byte(data), nil
+	}
+	file := strings.TrimPrefix(data, "@")
+	if file == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	body, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the request body from %v: %v", file, err)
+	}
+	return body, nil
+}
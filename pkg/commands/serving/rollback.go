@@ -0,0 +1,84 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kubeflow/arena/pkg/apis/arenaclient"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+)
+
+// NewRollbackCommand
+func NewRollbackCommand() *cobra.Command {
+	var servingType string
+	var version string
+	var revision int64
+	var bashCompletionFlags = map[string]string{
+		"version": "__arena_serve_all_version",
+		"type":    "__arena_serve_all_type",
+	}
+	var command = &cobra.Command{
+		Use:   "rollback JOB [--to-revision REVISION] [-T JOB_TYPE] [-v JOB_VERSION]",
+		Short: "Roll back a serving job to a revision listed by 'arena serve history'",
+		Long: `Roll back a serving job to a revision listed by 'arena serve history'.
+The deployment of the serving job is rolled back to the pod template of the revision,
+the kserve job routes all traffic to the previous rolled out revision.`,
+		Example: `  arena serve rollback mnist
+  arena serve rollback mnist --to-revision 2`,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return fmt.Errorf("not set job name,please set it")
+			}
+			if revision < 0 {
+				return fmt.Errorf("--to-revision must not be negative")
+			}
+			name := args[0]
+			client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
+				Kubeconfig:     viper.GetString("config"),
+				LogLevel:       viper.GetString("loglevel"),
+				Namespace:      viper.GetString("namespace"),
+				ArenaNamespace: viper.GetString("arena-namespace"),
+				IsDaemonMode:   false,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create arena client: %v", err)
+			}
+			return client.Serving().Rollback(name, version, utils.TransferServingJobType(servingType), revision)
+		},
+	}
+	command.Flags().StringVarP(&version, "version", "v", "", "Set the serving job version")
+	command.Flags().StringVarP(&servingType, "type", "T", "", fmt.Sprintf("The serving type, the possible option is [%v]. (optional)", utils.GetSupportServingJobTypesInfo()))
+	command.Flags().Int64Var(&revision, "to-revision", 0, "the revision to roll back to,default to the previous revision")
+	for name, completion := range bashCompletionFlags {
+		if command.Flag(name) != nil {
+			if command.Flag(name).Annotations == nil {
+				command.Flag(name).Annotations = map[string][]string{}
+			}
+			command.Flag(name).Annotations[cobra.BashCompCustom] = append(
+				command.Flag(name).Annotations[cobra.BashCompCustom],
+				completion,
+			)
+		}
+	}
+	return command
+}
@@ -0,0 +1,122 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kubeflow/arena/pkg/apis/arenaclient"
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+// NewRolloutCommand
+func NewRolloutCommand() *cobra.Command {
+	rolloutArgs := &types.ServingRolloutArgs{}
+	var maxErrorRate string
+	var command = &cobra.Command{
+		Use:   "rollout JOB --from VERSION --to VERSION [--steps 10,25,50,100] [--interval 5m]",
+		Short: "Shift the traffic of a serving job to a new version step by step,and roll back if the new version is unhealthy",
+		Long: `Shift the traffic of a serving job to a new version step by step by the traffic router (istio or gateway api),
+the error rate and the latency of the new version are queried from prometheus after each step,
+all traffic is routed back to the old version if they breach the thresholds.
+The analysis queries the istio request metrics,use --skip-analysis with the gateway api traffic router.
+The status of the rollout is saved in the configmap JOB-serving-rollout,use --resume to continue an interrupted rollout.`,
+		Example: `  arena serve rollout mnist --from v1 --to v2 --steps 10,25,50,100 --interval 5m --max-error-rate 1%
+  arena serve rollout mnist --resume`,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return fmt.Errorf("not set job name,please set it")
+			}
+			rolloutArgs.ServingName = args[0]
+			if !rolloutArgs.Resume {
+				if err := validateRolloutArgs(rolloutArgs, maxErrorRate); err != nil {
+					return err
+				}
+			}
+			client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
+				Kubeconfig:     viper.GetString("config"),
+				LogLevel:       viper.GetString("loglevel"),
+				Namespace:      viper.GetString("namespace"),
+				ArenaNamespace: viper.GetString("arena-namespace"),
+				IsDaemonMode:   false,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create arena client: %v", err)
+			}
+			return client.Serving().Rollout(rolloutArgs)
+		},
+	}
+	command.Flags().StringVar(&rolloutArgs.FromVersion, "from", "", "the version which serves all traffic before the rollout")
+	command.Flags().StringVar(&rolloutArgs.ToVersion, "to", "", "the version which serves all traffic after the rollout")
+	command.Flags().IntSliceVar(&rolloutArgs.Steps, "steps", []int{10, 25, 50, 100}, "the traffic weights of the new version,the last one must be 100")
+	command.Flags().DurationVar(&rolloutArgs.Interval, "interval", 5*time.Minute, "the time to wait and analyze the metrics before the next step")
+	command.Flags().StringVar(&maxErrorRate, "max-error-rate", "1%", "the max 5xx error rate of the new version,eg: 1% or 0.01")
+	command.Flags().DurationVar(&rolloutArgs.MaxLatency, "max-latency", 0, "the max p99 latency of the new version,eg: 500ms,no check if it is 0")
+	command.Flags().BoolVar(&rolloutArgs.SkipAnalysis, "skip-analysis", false, "shift the traffic step by step without analyzing the metrics,it is required by the gateway api traffic router")
+	command.Flags().BoolVar(&rolloutArgs.Resume, "resume", false, "continue the interrupted rollout from the saved status,other options are ignored")
+	return command
+}
+
+func validateRolloutArgs(args *types.ServingRolloutArgs, maxErrorRate string) error {
+	if args.FromVersion == "" || args.ToVersion == "" {
+		return fmt.Errorf("--from and --to must be set")
+	}
+	if args.FromVersion == args.ToVersion {
+		return fmt.Errorf("--from and --to must be different versions")
+	}
+	if len(args.Steps) == 0 || args.Steps[len(args.Steps)-1] != 100 {
+		return fmt.Errorf("the last step of --steps must be 100")
+	}
+	for i, step := range args.Steps {
+		if step <= 0 || step > 100 || (i > 0 && step <= args.Steps[i-1]) {
+			return fmt.Errorf("the steps %v are invalid,they must be increasing weights between 1 and 100", args.Steps)
+		}
+	}
+	if args.Interval <= 0 {
+		return fmt.Errorf("--interval must be positive")
+	}
+	rate, err := parseErrorRate(maxErrorRate)
+	if err != nil {
+		return err
+	}
+	args.MaxErrorRate = rate
+	return nil
+}
+
+// parseErrorRate parses the rate like 1% or 0.01
+func parseErrorRate(value string) (float64, error) {
+	value = strings.TrimSpace(value)
+	percent := strings.HasSuffix(value, "%")
+	rate, err := strconv.ParseFloat(strings.TrimSuffix(value, "%"), 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid --max-error-rate %v,it should be like 1%% or 0.01", value)
+	}
+	if percent {
+		rate = rate / 100
+	}
+	if rate < 0 || rate > 1 {
+		return 0, fmt.Errorf("invalid --max-error-rate %v,it should be between 0%% and 100%%", value)
+	}
+	return rate, nil
+}
@@ -11,7 +11,8 @@ Available Commands:
   custom         Submit a Custom Serving Job  
   kfserving,kfs  Submit a kubeflow Serving Job
   kserve         Submit a KServe Serving Job
-  seldon         Submit a Seldon Serving Job`
+  seldon         Submit a Seldon Serving Job
+  vllm           Submit a vLLM Serving Job with the OpenAI compatible api`
 )
 
 func NewServeCommand() *cobra.Command {
@@ -30,13 +31,18 @@ func NewServeCommand() *cobra.Command {
 	command.AddCommand(NewSubmitKServeJobCommand())
 	command.AddCommand(NewSubmitSeldonServingJobCommand())
 	command.AddCommand(NewSubmitTritonServingJobCommand())
+	command.AddCommand(NewSubmitVLLMServingJobCommand())
 	command.AddCommand(NewListCommand())
 	command.AddCommand(NewDeleteCommand())
 	command.AddCommand(NewGetCommand())
 	command.AddCommand(NewAttachCommand())
 	command.AddCommand(NewLogsCommand())
+	command.AddCommand(NewInvokeCommand())
 	command.AddCommand(NewTrafficRouterSplitCommand())
+	command.AddCommand(NewRolloutCommand())
 	command.AddCommand(NewUpdateCommand())
+	command.AddCommand(NewHistoryCommand())
+	command.AddCommand(NewRollbackCommand())
 
 	return command
 }
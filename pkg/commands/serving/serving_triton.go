@@ -39,5 +39,8 @@ func NewSubmitTritonServingJobCommand() *cobra.Command {
 		},
 	}
 	builder.AddCommandFlags(command)
+	command.AddCommand(NewTritonModelsCommand())
+	command.AddCommand(NewTritonLoadModelCommand())
+	command.AddCommand(NewTritonUnloadModelCommand())
 	return command
 }
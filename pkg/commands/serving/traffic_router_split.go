@@ -28,7 +28,7 @@ func NewTrafficRouterSplitCommand() *cobra.Command {
 	builder := serving.NewTrafficRouterBuilder()
 	var command = &cobra.Command{
 		Use:     "traffic-split",
-		Short:   "Adjust traffic routing dynamically for tfserving jobs",
+		Short:   "Adjust traffic routing dynamically for serving jobs by istio or gateway api",
 		Aliases: []string{"trs", "traffic-router", "traffic-router-split", "traffic-shift", "traffic-shifting"},
 		PreRun: func(cmd *cobra.Command, args []string) {
 			_ = viper.BindPFlags(cmd.Flags())
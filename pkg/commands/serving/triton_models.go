@@ -0,0 +1,141 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kubeflow/arena/pkg/apis/arenaclient"
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+// NewTritonModelsCommand lists the models in the model repository of a triton serving job
+func NewTritonModelsCommand() *cobra.Command {
+	var version string
+	var output string
+	var command = &cobra.Command{
+		Use:   "models JOB [-v JOB_VERSION]",
+		Short: "List the models in the model repository of a triton serving job and their states",
+		Example: `  arena serve triton models my-triton
+  arena serve triton models my-triton -o json`,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return fmt.Errorf("not set job name,please set it")
+			}
+			client, err := newTritonModelsArenaClient()
+			if err != nil {
+				return err
+			}
+			return client.Serving().TritonModelsAndPrint(args[0], version, output)
+		},
+	}
+	command.Flags().StringVarP(&version, "version", "v", "", "Set the serving job version")
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	addTritonVersionCompletion(command)
+	return command
+}
+
+// NewTritonLoadModelCommand loads models into a running triton serving job
+func NewTritonLoadModelCommand() *cobra.Command {
+	var version string
+	var models []string
+	var command = &cobra.Command{
+		Use:   "load JOB --model MODEL [-v JOB_VERSION]",
+		Short: "Load models from the model repository into a running triton serving job",
+		Long: `Load models from the model repository into a running triton serving job without redeploying it,
+the model is reloaded if it has been loaded. The triton server should be started with --model-control-mode=explicit.`,
+		Example: `  arena serve triton load my-triton --model resnet50
+  arena serve triton load my-triton --model resnet50 --model densenet_onnx`,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return fmt.Errorf("not set job name,please set it")
+			}
+			if len(models) == 0 {
+				return fmt.Errorf("not set the model to load,please set it by --model")
+			}
+			client, err := newTritonModelsArenaClient()
+			if err != nil {
+				return err
+			}
+			return client.Serving().LoadTritonModels(args[0], version, models)
+		},
+	}
+	command.Flags().StringVarP(&version, "version", "v", "", "Set the serving job version")
+	command.Flags().StringArrayVar(&models, "model", []string{}, "the name of the model to load")
+	addTritonVersionCompletion(command)
+	return command
+}
+
+// NewTritonUnloadModelCommand unloads models from a running triton serving job
+func NewTritonUnloadModelCommand() *cobra.Command {
+	var version string
+	var models []string
+	var command = &cobra.Command{
+		Use:     "unload JOB --model MODEL [-v JOB_VERSION]",
+		Short:   "Unload models from a running triton serving job",
+		Example: `  arena serve triton unload my-triton --model resnet50`,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return fmt.Errorf("not set job name,please set it")
+			}
+			if len(models) == 0 {
+				return fmt.Errorf("not set the model to unload,please set it by --model")
+			}
+			client, err := newTritonModelsArenaClient()
+			if err != nil {
+				return err
+			}
+			return client.Serving().UnloadTritonModels(args[0], version, models)
+		},
+	}
+	command.Flags().StringVarP(&version, "version", "v", "", "Set the serving job version")
+	command.Flags().StringArrayVar(&models, "model", []string{}, "the name of the model to unload")
+	addTritonVersionCompletion(command)
+	return command
+}
+
+func newTritonModelsArenaClient() (*arenaclient.ArenaClient, error) {
+	client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
+		Kubeconfig:     viper.GetString("config"),
+		LogLevel:       viper.GetString("loglevel"),
+		Namespace:      viper.GetString("namespace"),
+		ArenaNamespace: viper.GetString("arena-namespace"),
+		IsDaemonMode:   false,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create arena client: %v", err)
+	}
+	return client, nil
+}
+
+func addTritonVersionCompletion(command *cobra.Command) {
+	flag := command.Flag("version")
+	if flag.Annotations == nil {
+		flag.Annotations = map[string][]string{}
+	}
+	flag.Annotations[cobra.BashCompCustom] = append(flag.Annotations[cobra.BashCompCustom], "__arena_serve_all_version")
+}
@@ -9,7 +9,10 @@ Available Commands:
   tensorflow,tf  Update a TensorFlow Serving Job
   triton         Update a Nvidia Triton Serving Job
   custom         Update a Custom Serving Job
-  kserve         Update a KServe Serving Job`
+  kserve         Update a KServe Serving Job
+  seldon         Update a Seldon Serving Job
+  tensorrt,trt   Update a Nvidia TensorRT Serving Job
+  kfserving,kf   Update a KFServing Job`
 )
 
 func NewUpdateCommand() *cobra.Command {
@@ -25,6 +28,9 @@ func NewUpdateCommand() *cobra.Command {
 	command.AddCommand(NewUpdateTritonCommand())
 	command.AddCommand(NewUpdateCustomCommand())
 	command.AddCommand(NewUpdateKServeCommand())
+	command.AddCommand(NewUpdateSeldonCommand())
+	command.AddCommand(NewUpdateTensorRTCommand())
+	command.AddCommand(NewUpdateKFCommand())
 
 	return command
 }
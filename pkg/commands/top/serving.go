@@ -0,0 +1,58 @@
+package top
+
+import (
+	"fmt"
+
+	"github.com/kubeflow/arena/pkg/apis/arenaclient"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func NewTopServingCommand() *cobra.Command {
+	var (
+		allNamespaces bool
+		format        string
+		servingType   string
+		notStop       bool
+		version       string
+	)
+	var command = &cobra.Command{
+		Use:   "serving [JOB]",
+		Short: "Display QPS, latency, error rate and GPU usage of serving jobs.",
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			isDaemonMode := false
+			if notStop {
+				isDaemonMode = true
+			}
+			client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
+				Kubeconfig:     viper.GetString("config"),
+				LogLevel:       viper.GetString("loglevel"),
+				Namespace:      viper.GetString("namespace"),
+				ArenaNamespace: viper.GetString("arena-namespace"),
+				IsDaemonMode:   isDaemonMode,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create arena client: %v", err)
+			}
+			return client.Serving().Top(
+				args,
+				allNamespaces,
+				utils.TransferServingJobType(servingType),
+				version,
+				notStop,
+				utils.TransferPrintFormat(format),
+			)
+		},
+	}
+	command.Flags().BoolVarP(&allNamespaces, "all-namespaces", "A", false, "show all the namespaces")
+	command.Flags().StringVarP(&format, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().BoolVarP(&notStop, "refresh", "r", false, "Display continuously")
+	command.Flags().StringVarP(&servingType, "type", "T", "", fmt.Sprintf("The serving type, the possible option is [%v]. (optional)", utils.GetSupportServingJobTypesInfo()))
+	command.Flags().StringVarP(&version, "version", "v", "", "The serving version. (optional)")
+	return command
+}
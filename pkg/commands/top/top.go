@@ -25,6 +25,7 @@ var (
 Available Commands:
   node        Display Resource (GPU) usage of nodes
   job         Display Resource (GPU) usage of pods
+  serving     Display QPS, latency, error rate and GPU usage of serving jobs
     `
 )
 
@@ -41,6 +42,7 @@ func NewTopCommand() *cobra.Command {
 	// create subcommands
 	command.AddCommand(NewTopNodeCommand())
 	command.AddCommand(NewTopJobCommand())
+	command.AddCommand(NewTopServingCommand())
 
 	return command
 }
@@ -21,6 +21,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"gopkg.in/yaml.v2"
 
 	"github.com/kubeflow/arena/pkg/apis/arenaclient"
 	"github.com/kubeflow/arena/pkg/apis/types"
@@ -33,6 +34,8 @@ func NewGetCommand() *cobra.Command {
 	var showEvents bool
 	var showGPUs bool
 	var output string
+	var export bool
+	var diagnose bool
 	var command = &cobra.Command{
 		Use:   "get JOB [-T JOB_TYPE]",
 		Short: "Display a training job details",
@@ -59,12 +62,36 @@ func NewGetCommand() *cobra.Command {
 			if err != nil {
 				return fmt.Errorf("failed to create arena client: %v", err)
 			}
-			return client.Training().GetAndPrint(name, utils.TransferTrainingJobType(jobType), output, showEvents, showGPUs)
+			if export {
+				spec, err := client.Training().Export(name, utils.TransferTrainingJobType(jobType))
+				if err != nil {
+					return err
+				}
+				content, err := yaml.Marshal(spec)
+				if err != nil {
+					return err
+				}
+				fmt.Print(string(content))
+				return nil
+			}
+			if diagnose && output != "wide" {
+				return fmt.Errorf("--diagnose only supports the wide output,please use 'arena diagnose %v -o %v' instead", name, output)
+			}
+			if err := client.Training().GetAndPrint(name, utils.TransferTrainingJobType(jobType), output, showEvents, showGPUs); err != nil {
+				return err
+			}
+			if diagnose {
+				fmt.Println()
+				return client.Training().DiagnoseAndPrint(name, utils.TransferTrainingJobType(jobType), output)
+			}
+			return nil
 		},
 	}
 	command.Flags().StringVarP(&jobType, "type", "T", "", fmt.Sprintf("The training type to get, the possible option is %v. (optional)", utils.GetSupportTrainingJobTypesInfo()))
 	command.Flags().BoolVarP(&showEvents, "events", "e", false, "Specify if show pending pod's events.")
 	command.Flags().BoolVarP(&showGPUs, "gpus", "g", false, "Specify if show gpu utilizations of job.")
 	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().BoolVar(&diagnose, "diagnose", false, "Display the root-cause hints of the job failure.")
+	command.Flags().BoolVar(&export, "export", false, "Print the job spec in yaml format,it can be submitted again by 'arena submit -f'.")
 	return command
 }
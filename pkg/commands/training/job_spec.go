@@ -0,0 +1,194 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/kubeflow/arena/pkg/apis/training"
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+// getMapFlagPairs returns the key=value pairs of the map options,the ok is false if the option is not a map
+func getMapFlagPairs(flags *pflag.FlagSet, f *pflag.Flag) (pairs []string, ok bool, err error) {
+	values := map[string]string{}
+	switch f.Value.Type() {
+	case "stringToString":
+		values, err = flags.GetStringToString(f.Name)
+	case "stringToInt":
+		var ints map[string]int
+		ints, err = flags.GetStringToInt(f.Name)
+		for k, v := range ints {
+			values[k] = strconv.Itoa(v)
+		}
+	case "stringToInt64":
+		var ints map[string]int64
+		ints, err = flags.GetStringToInt64(f.Name)
+		for k, v := range ints {
+			values[k] = strconv.FormatInt(v, 10)
+		}
+	default:
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, true, err
+	}
+	for k, v := range values {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return pairs, true, nil
+}
+
+// quoteMapFlagPair quotes the key=value pair as a csv field when Set of the map option reads it by csv,
+// Set of stringToString only reads the pair by csv if it has more than one "=",so the commas,quotes
+// and "=" in the value are kept
+func quoteMapFlagPair(f *pflag.Flag, pair string) (string, error) {
+	if f.Value.Type() != "stringToString" || strings.Count(pair, "=") < 2 {
+		return pair, nil
+	}
+	buf := &bytes.Buffer{}
+	w := csv.NewWriter(buf)
+	if err := w.Write([]string{pair}); err != nil {
+		return "", err
+	}
+	w.Flush()
+	return strings.TrimSuffix(buf.String(), "\n"), w.Error()
+}
+
+// addJobSpecFileFlag adds option --file to the submit command
+func addJobSpecFileFlag(command *cobra.Command) {
+	command.Flags().StringP("file", "f", "", "the job spec file(yaml or json) to submit,the options given in command line override the values of the file, the spec can be exported by 'arena get --export'")
+}
+
+// applyJobSpecFile fills the submit args with the job spec file given by option --file,
+// the options set in command line have higher priority than the values of the file
+func applyJobSpecFile(cmd *cobra.Command, cmdArgs []string, jobType types.TrainingJobType, args interface{}) error {
+	file, _ := cmd.Flags().GetString("file")
+	if file == "" {
+		return nil
+	}
+	spec, err := training.LoadJobSpecFile(file)
+	if err != nil {
+		return err
+	}
+	if spec.Kind != jobType {
+		return fmt.Errorf("the kind of job spec file %v is %v,but the job type to submit is %v", file, spec.Kind, jobType)
+	}
+	// the command given in command line overrides the command of the file
+	if len(cmdArgs) != 0 {
+		delete(spec.Spec, "command")
+	}
+	// the options share the memory with the args,keep the values set in command line
+	// before decoding the spec and set them back again
+	changed := map[*pflag.Flag][]string{}
+	mapPairs := map[*pflag.Flag][]string{}
+	var visitErr error
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		if sliceValue, ok := f.Value.(pflag.SliceValue); ok {
+			changed[f] = append([]string{}, sliceValue.GetSlice()...)
+			return
+		}
+		pairs, ok, err := getMapFlagPairs(cmd.Flags(), f)
+		if err != nil && visitErr == nil {
+			visitErr = fmt.Errorf("failed to get option --%v: %v", f.Name, err)
+		}
+		if ok {
+			mapPairs[f] = pairs
+			return
+		}
+		changed[f] = []string{f.Value.String()}
+	})
+	if visitErr != nil {
+		return visitErr
+	}
+	if err := training.DecodeJobSpec(spec, args); err != nil {
+		return fmt.Errorf("failed to decode job spec file %v: %v", file, err)
+	}
+	for f, values := range changed {
+		if sliceValue, ok := f.Value.(pflag.SliceValue); ok {
+			err = sliceValue.Replace(values)
+		} else {
+			err = f.Value.Set(values[0])
+		}
+		if err != nil {
+			return fmt.Errorf("failed to set option --%v: %v", f.Name, err)
+		}
+	}
+	// Set merges the pairs into the map once the map option is changed,
+	// so the pairs of command line override the ones of the file
+	for f, pairs := range mapPairs {
+		for _, pair := range pairs {
+			quoted, err := quoteMapFlagPair(f, pair)
+			if err == nil {
+				err = f.Value.Set(quoted)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to set option --%v: %v", f.Name, err)
+			}
+		}
+	}
+	if !cmd.Flags().Changed("name") && spec.Name != "" {
+		if err := cmd.Flags().Set("name", spec.Name); err != nil {
+			return err
+		}
+	}
+	if !cmd.Flags().Changed("namespace") && spec.Namespace != "" {
+		if err := cmd.Flags().Set("namespace", spec.Namespace); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// submitJobSpecFile submits the job spec file with the submit command which matches the kind of spec,
+// the overrides are the options and command of the submit command which override the values of the file
+func submitJobSpecFile(cmd *cobra.Command, args []string, file string, overrides ...string) error {
+	spec, err := training.LoadJobSpecFile(file)
+	if err != nil {
+		return err
+	}
+	var subCommand *cobra.Command
+	for _, c := range cmd.Commands() {
+		if c.Name() == string(spec.Kind) {
+			subCommand = c
+			break
+		}
+	}
+	if subCommand == nil {
+		return fmt.Errorf("the kind %v of job spec file %v is not supported by 'arena submit'", spec.Kind, file)
+	}
+	if err := subCommand.ParseFlags(append([]string{"--file", file}, overrides...)); err != nil {
+		return err
+	}
+	args = append(args, subCommand.Flags().Args()...)
+	if subCommand.PreRunE != nil {
+		if err := subCommand.PreRunE(subCommand, args); err != nil {
+			return err
+		}
+	}
+	if err := subCommand.ValidateRequiredFlags(); err != nil {
+		return err
+	}
+	return subCommand.RunE(subCommand, args)
+}
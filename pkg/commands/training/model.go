@@ -13,7 +13,7 @@ import (
 	"github.com/kubeflow/arena/pkg/apis/types"
 )
 
-// Model registration
+// Model registration,the job submitted with --register-model-on-success registers its model version when it is succeeded
 func createRegisteredModelAndModelVersion(client *arenaclient.ArenaClient, job *training.Job, versionDescription string) (*types.RegisteredModel, *types.ModelVersion, error) {
 	var (
 		name        string
@@ -36,7 +36,7 @@ func createRegisteredModelAndModelVersion(client *arenaclient.ArenaClient, job *
 	case types.TFTrainingJob:
 		args := job.Args().(*types.SubmitTFJobArgs)
 		name = args.ModelName
-		if name == "" {
+		if name == "" || args.RegisterModelOnSuccess {
 			return nil, nil, nil
 		}
 		for key, value := range args.Labels {
@@ -49,7 +49,7 @@ func createRegisteredModelAndModelVersion(client *arenaclient.ArenaClient, job *
 	case types.PytorchTrainingJob:
 		args := job.Args().(*types.SubmitPyTorchJobArgs)
 		name = args.ModelName
-		if name == "" {
+		if name == "" || args.RegisterModelOnSuccess {
 			return nil, nil, nil
 		}
 		for key, value := range args.Labels {
@@ -62,7 +62,7 @@ func createRegisteredModelAndModelVersion(client *arenaclient.ArenaClient, job *
 	case types.MPITrainingJob:
 		args := job.Args().(*types.SubmitMPIJobArgs)
 		name = args.ModelName
-		if name == "" {
+		if name == "" || args.RegisterModelOnSuccess {
 			return nil, nil, nil
 		}
 		for key, value := range args.Labels {
@@ -75,7 +75,7 @@ func createRegisteredModelAndModelVersion(client *arenaclient.ArenaClient, job *
 	case types.HorovodTrainingJob:
 		args := job.Args().(*types.SubmitHorovodJobArgs)
 		name = args.ModelName
-		if name == "" {
+		if name == "" || args.RegisterModelOnSuccess {
 			return nil, nil, nil
 		}
 		for key, value := range args.Labels {
@@ -90,7 +90,7 @@ func createRegisteredModelAndModelVersion(client *arenaclient.ArenaClient, job *
 	case types.ETTrainingJob:
 		args := job.Args().(*types.SubmitETJobArgs)
 		name = args.ModelName
-		if name == "" {
+		if name == "" || args.RegisterModelOnSuccess {
 			return nil, nil, nil
 		}
 		for key, value := range args.Labels {
@@ -105,7 +105,59 @@ func createRegisteredModelAndModelVersion(client *arenaclient.ArenaClient, job *
 	case types.DeepSpeedTrainingJob:
 		args := job.Args().(*types.SubmitDeepSpeedJobArgs)
 		name = args.ModelName
-		if name == "" {
+		if name == "" || args.RegisterModelOnSuccess {
+			return nil, nil, nil
+		}
+		for key, value := range args.Labels {
+			versionTags = append(versionTags, &types.ModelVersionTag{
+				Key:   key,
+				Value: value,
+			})
+		}
+		source = args.ModelSource
+	case types.RayTrainingJob:
+		args := job.Args().(*types.SubmitRayJobArgs)
+		name = args.ModelName
+		if name == "" || args.RegisterModelOnSuccess {
+			return nil, nil, nil
+		}
+		for key, value := range args.Labels {
+			versionTags = append(versionTags, &types.ModelVersionTag{
+				Key:   key,
+				Value: value,
+			})
+		}
+		source = args.ModelSource
+	case types.XGBoostTrainingJob:
+		args := job.Args().(*types.SubmitXGBoostJobArgs)
+		name = args.ModelName
+		if name == "" || args.RegisterModelOnSuccess {
+			return nil, nil, nil
+		}
+		for key, value := range args.Labels {
+			versionTags = append(versionTags, &types.ModelVersionTag{
+				Key:   key,
+				Value: value,
+			})
+		}
+		source = args.ModelSource
+	case types.PaddleTrainingJob:
+		args := job.Args().(*types.SubmitPaddleJobArgs)
+		name = args.ModelName
+		if name == "" || args.RegisterModelOnSuccess {
+			return nil, nil, nil
+		}
+		for key, value := range args.Labels {
+			versionTags = append(versionTags, &types.ModelVersionTag{
+				Key:   key,
+				Value: value,
+			})
+		}
+		source = args.ModelSource
+	case types.JAXTrainingJob:
+		args := job.Args().(*types.SubmitJAXJobArgs)
+		name = args.ModelName
+		if name == "" || args.RegisterModelOnSuccess {
 			return nil, nil, nil
 		}
 		for key, value := range args.Labels {
@@ -120,7 +172,7 @@ func createRegisteredModelAndModelVersion(client *arenaclient.ArenaClient, job *
 	if err != nil {
 		return nil, nil, err
 	}
-	return modelClient.CreateRegisteredModelAndModelVersion(name, description, tags, "auto", versionDescription, versionTags, source)
+	return modelClient.CreateRegisteredModelAndModelVersion(name, description, tags, "auto", versionDescription, versionTags, source, "")
 }
 
 func getFullSubmitCommand(cmd *cobra.Command, args []string) string {
@@ -0,0 +1,113 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"fmt"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v2"
+
+	"github.com/kubeflow/arena/pkg/apis/arenaclient"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+)
+
+var rerunExample = `
+  # submit the job tf-dist again with a new name
+  arena rerun tf-dist --name tf-dist-2
+
+  # the options and the command after "--" override the recorded values of the job
+  arena rerun tf-dist --name tf-dist-3 -- --gpus 2 --workers 4 "python train.py --lr 0.01"
+`
+
+// NewRerunCommand
+func NewRerunCommand() *cobra.Command {
+	var jobType string
+	var name string
+	var command = &cobra.Command{
+		Use:     "rerun JOB --name NEW_JOB [-T JOB_TYPE] [-- SUBMIT_OPTIONS [COMMAND]]",
+		Short:   "Submit a new training job with the recorded submission of a job",
+		Example: rerunExample,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var overrides []string
+			if dash := cmd.ArgsLenAtDash(); dash >= 0 {
+				overrides = args[dash:]
+				args = args[:dash]
+			}
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+				return fmt.Errorf("not set job name,please set it")
+			}
+			if len(args) > 1 {
+				return fmt.Errorf("only one job can be rerun,the options of the new job should be given after \"--\"")
+			}
+			if name == "" {
+				return fmt.Errorf("--name must be set,the job %v still exists", args[0])
+			}
+			client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
+				Kubeconfig:     viper.GetString("config"),
+				LogLevel:       viper.GetString("loglevel"),
+				Namespace:      viper.GetString("namespace"),
+				ArenaNamespace: viper.GetString("arena-namespace"),
+				IsDaemonMode:   false,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create arena client: %v", err)
+			}
+			spec, err := client.Training().Export(args[0], utils.TransferTrainingJobType(jobType))
+			if err != nil {
+				return err
+			}
+			file, err := saveJobSpecToTempFile(spec)
+			if err != nil {
+				return err
+			}
+			defer os.Remove(file)
+			submitCommand, _, err := cmd.Root().Find([]string{"submit"})
+			if err != nil {
+				return err
+			}
+			log.Debugf("rerun job %v as %v with the options %v", args[0], name, overrides)
+			return submitJobSpecFile(submitCommand, []string{}, file, append([]string{"--name", name}, overrides...)...)
+		},
+	}
+	command.Flags().StringVarP(&jobType, "type", "T", "", fmt.Sprintf("The training type to rerun, the possible option is %v. (optional)", utils.GetSupportTrainingJobTypesInfo()))
+	command.Flags().StringVar(&name, "name", "", "the name of the new job")
+	return command
+}
+
+// saveJobSpecToTempFile saves the job spec to a temporary file which can be submitted by 'arena submit -f'
+func saveJobSpecToTempFile(spec *types.TrainingJobSpec) (string, error) {
+	content, err := yaml.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+	f, err := os.CreateTemp("", fmt.Sprintf("%v-spec-*.yaml", spec.Name))
+	if err != nil {
+		return "", fmt.Errorf("failed to create the job spec file: %v", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(content); err != nil {
+		return "", fmt.Errorf("failed to write the job spec file: %v", err)
+	}
+	return f.Name(), nil
+}
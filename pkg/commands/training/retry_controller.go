@@ -0,0 +1,61 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kubeflow/arena/pkg/apis/arenaclient"
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+// NewRetryControllerCommand
+func NewRetryControllerCommand() *cobra.Command {
+	args := types.RetryControllerArgs{}
+	var command = &cobra.Command{
+		Use:   "retry-controller",
+		Short: "Run the controller which submits the failed training jobs again by their --max-attempts and --retry-on",
+		Long: `Run the controller which submits the failed training jobs again by their --max-attempts and --retry-on,
+it is usually running as a deployment in the cluster. The attempt N of job JOB is submitted as JOB-attempt-N.`,
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if args.ResyncPeriod <= 0 || args.Backoff < 0 || args.MaxBackoff < args.Backoff {
+				return fmt.Errorf("--resync-period must be positive and --max-backoff must not be less than --backoff")
+			}
+			client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
+				Kubeconfig:     viper.GetString("config"),
+				LogLevel:       viper.GetString("loglevel"),
+				Namespace:      viper.GetString("namespace"),
+				ArenaNamespace: viper.GetString("arena-namespace"),
+				IsDaemonMode:   true,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create arena client: %v", err)
+			}
+			return client.Training().RunRetryController(args)
+		},
+	}
+	command.Flags().BoolVarP(&args.AllNamespaces, "all-namespaces", "A", false, "watch the failed jobs in all namespaces")
+	command.Flags().DurationVar(&args.ResyncPeriod, "resync-period", 30*time.Second, "the interval to check the failed jobs")
+	command.Flags().DurationVar(&args.Backoff, "backoff", 30*time.Second, "the delay before submitting the second attempt of a failed job,it is doubled by each attempt")
+	command.Flags().DurationVar(&args.MaxBackoff, "max-backoff", 10*time.Minute, "the max delay before submitting an attempt of a failed job")
+	return command
+}
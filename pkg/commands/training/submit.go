@@ -12,18 +12,28 @@ Available Commands:
   etjob,et             Submit a ETJob.
   horovod,hj           Submit a Horovod Job.
   volcanojob,vj        Submit a VolcanoJob.
+
+Submit a job from a spec file:
+  arena submit -f job.yaml
+  arena submit tfjob -f job.yaml --gpus 2   # the options override the values of the file
     `
 )
 
 func NewSubmitCommand() *cobra.Command {
+	var file string
 	var command = &cobra.Command{
 		Use:   "submit",
 		Short: "Submit a training job.",
 		Long:  submitLong,
-		Run: func(cmd *cobra.Command, args []string) {
-			cmd.HelpFunc()(cmd, args)
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if file == "" {
+				cmd.HelpFunc()(cmd, args)
+				return nil
+			}
+			return submitJobSpecFile(cmd, args, file)
 		},
 	}
+	command.Flags().StringVarP(&file, "file", "f", "", "the job spec file(yaml or json) to submit,the job type is decided by the kind of the file")
 	command.AddCommand(NewSubmitTFJobCommand())
 	command.AddCommand(NewSubmitMPIJobCommand())
 	command.AddCommand(NewSubmitPytorchJobCommand())
@@ -33,5 +43,9 @@ func NewSubmitCommand() *cobra.Command {
 	command.AddCommand(NewVolcanoJobCommand())
 	command.AddCommand(NewSubmitETJobCommand())
 	command.AddCommand(NewSubmitDeepSpeedJobCommand())
+	command.AddCommand(NewSubmitRayJobCommand())
+	command.AddCommand(NewSubmitXGBoostJobCommand())
+	command.AddCommand(NewSubmitPaddleJobCommand())
+	command.AddCommand(NewSubmitJAXJobCommand())
 	return command
 }
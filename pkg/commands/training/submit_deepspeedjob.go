@@ -32,11 +32,12 @@ func NewSubmitDeepSpeedJobCommand() *cobra.Command {
 		Use:     "deepspeedjob",
 		Short:   "Submit DeepSpeedJob as training job.",
 		Aliases: []string{"dp"},
-		PreRun: func(cmd *cobra.Command, args []string) {
+		PreRunE: func(cmd *cobra.Command, args []string) error {
 			_ = viper.BindPFlags(cmd.Flags())
+			return applyJobSpecFile(cmd, args, types.DeepSpeedTrainingJob, builder.GetArgs())
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if len(args) == 0 {
+			if len(args) == 0 && builder.GetArgs().Command == "" {
 				cmd.HelpFunc()(cmd, args)
 				return fmt.Errorf("not found command args")
 			}
@@ -57,6 +58,9 @@ func NewSubmitDeepSpeedJobCommand() *cobra.Command {
 			if err := client.Training().Submit(job); err != nil {
 				return err
 			}
+			if builder.GetArgs().DryRun != types.DryRunNone {
+				return nil
+			}
 			fullSubmitCommand := getFullSubmitCommand(cmd, args)
 			_, modelVersion, err := createRegisteredModelAndModelVersion(client, job, fullSubmitCommand)
 			if modelVersion == nil {
@@ -69,5 +73,6 @@ func NewSubmitDeepSpeedJobCommand() *cobra.Command {
 		},
 	}
 	builder.AddCommandFlags(command)
+	addJobSpecFileFlag(command)
 	return command
 }
@@ -19,11 +19,12 @@ func NewSubmitHorovodJobCommand() *cobra.Command {
 		Use:     "horovodjob",
 		Short:   "Submit horovodjob as training job.",
 		Aliases: []string{"horovod", "hj"},
-		PreRun: func(cmd *cobra.Command, args []string) {
+		PreRunE: func(cmd *cobra.Command, args []string) error {
 			_ = viper.BindPFlags(cmd.Flags())
+			return applyJobSpecFile(cmd, args, types.HorovodTrainingJob, builder.GetArgs())
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if len(args) == 0 {
+			if len(args) == 0 && builder.GetArgs().Command == "" {
 				cmd.HelpFunc()(cmd, args)
 				return fmt.Errorf("not found command args")
 			}
@@ -44,6 +45,9 @@ func NewSubmitHorovodJobCommand() *cobra.Command {
 			if err := client.Training().Submit(job); err != nil {
 				return err
 			}
+			if builder.GetArgs().DryRun != types.DryRunNone {
+				return nil
+			}
 			fullSubmitCommand := getFullSubmitCommand(cmd, args)
 			_, modelVersion, err := createRegisteredModelAndModelVersion(client, job, fullSubmitCommand)
 			if modelVersion == nil {
@@ -56,5 +60,6 @@ func NewSubmitHorovodJobCommand() *cobra.Command {
 		},
 	}
 	builder.AddCommandFlags(command)
+	addJobSpecFileFlag(command)
 	return command
 }
@@ -32,11 +32,12 @@ func NewSubmitMPIJobCommand() *cobra.Command {
 		Use:     "mpijob",
 		Short:   "Submit MPIjob as training job.",
 		Aliases: []string{"mpi", "mj"},
-		PreRun: func(cmd *cobra.Command, args []string) {
+		PreRunE: func(cmd *cobra.Command, args []string) error {
 			_ = viper.BindPFlags(cmd.Flags())
+			return applyJobSpecFile(cmd, args, types.MPITrainingJob, builder.GetArgs())
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if len(args) == 0 {
+			if len(args) == 0 && builder.GetArgs().Command == "" {
 				cmd.HelpFunc()(cmd, args)
 				return fmt.Errorf("not found command args")
 			}
@@ -57,6 +58,9 @@ func NewSubmitMPIJobCommand() *cobra.Command {
 			if err := client.Training().Submit(job); err != nil {
 				return err
 			}
+			if builder.GetArgs().DryRun != types.DryRunNone {
+				return nil
+			}
 			fullSubmitCommand := getFullSubmitCommand(cmd, args)
 			_, modelVersion, err := createRegisteredModelAndModelVersion(client, job, fullSubmitCommand)
 			if modelVersion == nil {
@@ -69,5 +73,6 @@ func NewSubmitMPIJobCommand() *cobra.Command {
 		},
 	}
 	builder.AddCommandFlags(command)
+	addJobSpecFileFlag(command)
 	return command
 }
@@ -18,11 +18,12 @@ func NewSubmitPytorchJobCommand() *cobra.Command {
 		Use:     "pytorchjob",
 		Short:   "Submit PyTorchJob as training job.",
 		Aliases: []string{"pytorch"},
-		PreRun: func(cmd *cobra.Command, args []string) {
+		PreRunE: func(cmd *cobra.Command, args []string) error {
 			_ = viper.BindPFlags(cmd.Flags())
+			return applyJobSpecFile(cmd, args, types.PytorchTrainingJob, builder.GetArgs())
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if len(args) == 0 {
+			if len(args) == 0 && builder.GetArgs().Command == "" {
 				cmd.HelpFunc()(cmd, args)
 				return fmt.Errorf("not found command args")
 			}
@@ -43,6 +44,9 @@ func NewSubmitPytorchJobCommand() *cobra.Command {
 			if err := client.Training().Submit(job); err != nil {
 				return err
 			}
+			if builder.GetArgs().DryRun != types.DryRunNone {
+				return nil
+			}
 			fullSubmitCommand := getFullSubmitCommand(cmd, args)
 			_, modelVersion, err := createRegisteredModelAndModelVersion(client, job, fullSubmitCommand)
 			if modelVersion == nil {
@@ -55,5 +59,6 @@ func NewSubmitPytorchJobCommand() *cobra.Command {
 		},
 	}
 	builder.AddCommandFlags(command)
+	addJobSpecFileFlag(command)
 	return command
 }
@@ -0,0 +1,88 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kubeflow/arena/pkg/apis/arenaclient"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+)
+
+// NewSuspendCommand
+func NewSuspendCommand() *cobra.Command {
+	var jobType string
+	var command = &cobra.Command{
+		Use:   "suspend JOB [-T JOB_TYPE]",
+		Short: "Suspend a training job, the pods of the job are deleted to release the resources",
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+				return fmt.Errorf("not set job name,please set it")
+			}
+			client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
+				Kubeconfig:     viper.GetString("config"),
+				LogLevel:       viper.GetString("loglevel"),
+				Namespace:      viper.GetString("namespace"),
+				ArenaNamespace: viper.GetString("arena-namespace"),
+				IsDaemonMode:   false,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create arena client: %v", err)
+			}
+			return client.Training().Suspend(args[0], utils.TransferTrainingJobType(jobType))
+		},
+	}
+	command.Flags().StringVarP(&jobType, "type", "T", "", "The training type to suspend, the possible option is tfjob,pytorchjob and mpijob. (optional)")
+	return command
+}
+
+// NewResumeCommand
+func NewResumeCommand() *cobra.Command {
+	var jobType string
+	var command = &cobra.Command{
+		Use:   "resume JOB [-T JOB_TYPE]",
+		Short: "Resume a suspended training job",
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+				return fmt.Errorf("not set job name,please set it")
+			}
+			client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
+				Kubeconfig:     viper.GetString("config"),
+				LogLevel:       viper.GetString("loglevel"),
+				Namespace:      viper.GetString("namespace"),
+				ArenaNamespace: viper.GetString("arena-namespace"),
+				IsDaemonMode:   false,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create arena client: %v", err)
+			}
+			return client.Training().Resume(args[0], utils.TransferTrainingJobType(jobType))
+		},
+	}
+	command.Flags().StringVarP(&jobType, "type", "T", "", "The training type to resume, the possible option is tfjob,pytorchjob and mpijob. (optional)")
+	return command
+}
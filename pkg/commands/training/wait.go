@@ -0,0 +1,134 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/kubeflow/arena/pkg/apis/arenaclient"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+	"github.com/kubeflow/arena/pkg/util"
+)
+
+const (
+	// WaitExitCodeStatusUnreachable is the exit code when the job finished with another status
+	WaitExitCodeStatusUnreachable = 2
+	// WaitExitCodeTimeout is the exit code when the job doesn't reach the status before timeout
+	WaitExitCodeTimeout = 3
+)
+
+var waitLong = `Wait for a training job to reach the expected status.
+
+If the job is succeeded and submitted with --register-model-on-success,its model version is registered.
+
+Exit codes:
+  0   the job reached the expected status
+  1   failed to wait the job,like the job is not found
+  2   the job finished with another status,like waiting for succeeded but the job failed
+  3   timed out waiting for the job
+`
+
+// NewWaitCommand
+func NewWaitCommand() *cobra.Command {
+	var jobType string
+	var forStatus string
+	var timeout time.Duration
+	var command = &cobra.Command{
+		Use:   "wait JOB [-T JOB_TYPE] [--for=succeeded|failed|running|suspended] [--timeout=2h]",
+		Short: "Wait for a training job to reach the expected status",
+		Long:  waitLong,
+		PreRun: func(cmd *cobra.Command, args []string) {
+			_ = viper.BindPFlags(cmd.Flags())
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				cmd.HelpFunc()(cmd, args)
+				return fmt.Errorf("not set job name,please set it")
+			}
+			name := args[0]
+			status, err := transferWaitStatus(forStatus)
+			if err != nil {
+				return err
+			}
+			client, err := arenaclient.NewArenaClient(types.ArenaClientArgs{
+				Kubeconfig:     viper.GetString("config"),
+				LogLevel:       viper.GetString("loglevel"),
+				Namespace:      viper.GetString("namespace"),
+				ArenaNamespace: viper.GetString("arena-namespace"),
+				IsDaemonMode:   false,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create arena client: %v", err)
+			}
+			ctx := context.Background()
+			if timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, timeout)
+				defer cancel()
+			}
+			jobStatus, err := client.Training().Wait(ctx, name, utils.TransferTrainingJobType(jobType), status)
+			switch {
+			case err == nil:
+				fmt.Printf("job %v is %v\n", name, jobStatus)
+				if jobStatus != types.TrainingJobSucceeded {
+					return nil
+				}
+				// register the model version of the job submitted with --register-model-on-success
+				modelVersion, err := client.Training().RegisterModelOnSuccess(name, utils.TransferTrainingJobType(jobType))
+				if modelVersion != nil {
+					fmt.Printf("model version %v/%v registered\n", modelVersion.Name, modelVersion.Version)
+				}
+				return err
+			case errors.Is(err, types.ErrTrainingJobStatusUnreachable):
+				return &util.ExitError{
+					Code: WaitExitCodeStatusUnreachable,
+					Err:  fmt.Errorf("job %v is %v,it can not reach the status %v", name, jobStatus, status),
+				}
+			case errors.Is(err, context.DeadlineExceeded):
+				return &util.ExitError{
+					Code: WaitExitCodeTimeout,
+					Err:  fmt.Errorf("timed out after %v waiting for job %v to be %v,the current status is %v", timeout, name, status, jobStatus),
+				}
+			}
+			return err
+		},
+	}
+	command.Flags().StringVarP(&jobType, "type", "T", "", fmt.Sprintf("The training type to wait, the possible option is %v. (optional)", utils.GetSupportTrainingJobTypesInfo()))
+	command.Flags().StringVar(&forStatus, "for", "succeeded", "The status to wait for. One of: succeeded|failed|running|suspended")
+	command.Flags().DurationVar(&timeout, "timeout", 0, "The max duration to wait(e.g. '30m', '2h'), 0 means waiting forever")
+	return command
+}
+
+func transferWaitStatus(status string) (types.TrainingJobStatus, error) {
+	for _, s := range []types.TrainingJobStatus{
+		types.TrainingJobSucceeded,
+		types.TrainingJobFailed,
+		types.TrainingJobRunning,
+		types.TrainingJobSuspended,
+	} {
+		if strings.EqualFold(string(s), status) {
+			return s, nil
+		}
+	}
+	return "", fmt.Errorf("unknown status %v to wait for,only support: [succeeded,failed,running,suspended]", status)
+}
@@ -0,0 +1,63 @@
+package evaluate
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/arena/pkg/k8saccesser"
+)
+
+// GetEvaluateJobMetrics returns the metrics of the completed evaluate job,the evaluator writes them
+// as a json object like {"accuracy": 0.93} to --metrics-path,and the evaluatejob chart copies the file
+// (or metrics.json if --metrics-path is a directory) to the termination message of the container
+func GetEvaluateJobMetrics(name, namespace string) (map[string]float64, error) {
+	job, err := k8saccesser.GetK8sResourceAccesser().GetJob(name, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if !isComplete(job.Status) {
+		return nil, fmt.Errorf("the evaluate job %v is %v,only the complete job has metrics", name, getJobStatus(job.Status))
+	}
+	selector, err := metav1.LabelSelectorAsSelector(job.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := k8saccesser.GetK8sResourceAccesser().ListPods(namespace, selector.String(), "", nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, pod := range pods {
+		for _, status := range pod.Status.ContainerStatuses {
+			terminated := status.State.Terminated
+			if terminated == nil || terminated.ExitCode != 0 || terminated.Message == "" {
+				continue
+			}
+			return parseEvaluateMetrics(job, terminated.Message)
+		}
+	}
+	return nil, fmt.Errorf("not found the metrics of evaluate job %v,the evaluator should write them as a json object to the metrics path", name)
+}
+
+func parseEvaluateMetrics(job *batchv1.Job, message string) (map[string]float64, error) {
+	values := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(message), &values); err != nil {
+		return nil, fmt.Errorf("failed to parse the metrics of evaluate job %v: %v", job.Name, err)
+	}
+	metrics := map[string]float64{}
+	for key, value := range values {
+		switch v := value.(type) {
+		case float64:
+			metrics[key] = v
+		case string:
+			// the metrics may be written as strings by the evaluator
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				metrics[key] = f
+			}
+		}
+	}
+	return metrics, nil
+}
@@ -18,4 +18,16 @@ const (
 
 	SparkCRDNameInDaemonMode = "Sparkapplication.sparkoperator.k8s.io"
 	SparkCRDName             = "sparkapplications.sparkoperator.k8s.io"
+
+	XGBoostCRDName             = "xgboostjobs.kubeflow.org"
+	XGBoostCRDNameInDaemonMode = "XGBoostJob.kubeflow.org"
+
+	PaddleCRDName             = "paddlejobs.kubeflow.org"
+	PaddleCRDNameInDaemonMode = "PaddleJob.kubeflow.org"
+
+	JAXCRDName             = "jaxjobs.kubeflow.org"
+	JAXCRDNameInDaemonMode = "JAXJob.kubeflow.org"
+
+	RayJobCRDName             = "rayjobs.ray.io"
+	RayJobCRDNameInDaemonMode = "RayJob.ray.io"
 )
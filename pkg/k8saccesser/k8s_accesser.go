@@ -17,6 +17,7 @@ import (
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
@@ -28,6 +29,8 @@ import (
 	etversioned "github.com/kubeflow/arena/pkg/operators/et-operator/client/clientset/versioned"
 	cron_v1alpha1 "github.com/kubeflow/arena/pkg/operators/kubedl-operator/apis/apps/v1alpha1"
 	cronversioned "github.com/kubeflow/arena/pkg/operators/kubedl-operator/client/clientset/versioned"
+	ray_v1 "github.com/kubeflow/arena/pkg/operators/kuberay-operator/apis/ray/v1"
+	rayversioned "github.com/kubeflow/arena/pkg/operators/kuberay-operator/client/clientset/versioned"
 	"github.com/kubeflow/arena/pkg/operators/mpi-operator/apis/kubeflow/v1alpha1"
 	mpiversioned "github.com/kubeflow/arena/pkg/operators/mpi-operator/client/clientset/versioned"
 	pytorch_v1 "github.com/kubeflow/arena/pkg/operators/pytorch-operator/apis/pytorch/v1"
@@ -36,6 +39,8 @@ import (
 	sparkversioned "github.com/kubeflow/arena/pkg/operators/spark-operator/client/clientset/versioned"
 	tfv1 "github.com/kubeflow/arena/pkg/operators/tf-operator/apis/tensorflow/v1"
 	tfversioned "github.com/kubeflow/arena/pkg/operators/tf-operator/client/clientset/versioned"
+	kubeflow_v1 "github.com/kubeflow/arena/pkg/operators/training-operator/apis/kubeflow/v1"
+	trainingversioned "github.com/kubeflow/arena/pkg/operators/training-operator/client/clientset/versioned"
 	volcano_v1alpha1 "github.com/kubeflow/arena/pkg/operators/volcano-operator/apis/batch/v1alpha1"
 	volcanovesioned "github.com/kubeflow/arena/pkg/operators/volcano-operator/client/clientset/versioned"
 )
@@ -51,6 +56,8 @@ func init() {
 	utilruntime.Must(spark_v1beta2.AddToScheme(scheme.Scheme))
 	utilruntime.Must(volcano_v1alpha1.AddToScheme(scheme.Scheme))
 	utilruntime.Must(cron_v1alpha1.AddToScheme(scheme.Scheme))
+	utilruntime.Must(ray_v1.AddToScheme(scheme.Scheme))
+	utilruntime.Must(kubeflow_v1.AddToScheme(scheme.Scheme))
 }
 
 func InitK8sResourceAccesser(config *rest.Config, clientset *kubernetes.Clientset, isDaemonMode bool) error {
@@ -599,6 +606,156 @@ func (k *k8sResourceAccesser) ListSparkJobs(sparkjobClient *sparkversioned.Clien
 	return jobs, nil
 }
 
+func (k *k8sResourceAccesser) ListRayJobs(rayjobClient *rayversioned.Clientset, namespace string, labels string) ([]*ray_v1.RayJob, error) {
+	jobs := []*ray_v1.RayJob{}
+	jobList := &ray_v1.RayJobList{}
+	var err error
+	labelSelector, err := parseLabelSelector(labels)
+	if err != nil {
+		return nil, err
+	}
+	if k.cacheEnabled {
+		err = k.cacheClient.List(
+			context.Background(),
+			jobList,
+			client.InNamespace(namespace),
+			&client.ListOptions{
+				LabelSelector: labelSelector,
+			})
+	} else {
+		jobList, err = rayjobClient.RayV1().RayJobs(namespace).List(context.TODO(), metav1.ListOptions{
+			LabelSelector: labelSelector.String(),
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, job := range jobList.Items {
+		jobs = append(jobs, job.DeepCopy())
+	}
+	return jobs, nil
+}
+
+func (k *k8sResourceAccesser) ListMPIJobsV1(trainingClient *trainingversioned.Clientset, namespace string, labels string) ([]*kubeflow_v1.MPIJob, error) {
+	jobs := []*kubeflow_v1.MPIJob{}
+	jobList := &kubeflow_v1.MPIJobList{}
+	var err error
+	labelSelector, err := parseLabelSelector(labels)
+	if err != nil {
+		return nil, err
+	}
+	if k.cacheEnabled {
+		err = k.cacheClient.List(
+			context.Background(),
+			jobList,
+			client.InNamespace(namespace),
+			&client.ListOptions{
+				LabelSelector: labelSelector,
+			})
+	} else {
+		jobList, err = trainingClient.KubeflowV1().MPIJobs(namespace).List(context.TODO(), metav1.ListOptions{
+			LabelSelector: labelSelector.String(),
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, job := range jobList.Items {
+		jobs = append(jobs, job.DeepCopy())
+	}
+	return jobs, nil
+}
+
+func (k *k8sResourceAccesser) ListXGBoostJobs(trainingClient *trainingversioned.Clientset, namespace string, labels string) ([]*kubeflow_v1.XGBoostJob, error) {
+	jobs := []*kubeflow_v1.XGBoostJob{}
+	jobList := &kubeflow_v1.XGBoostJobList{}
+	var err error
+	labelSelector, err := parseLabelSelector(labels)
+	if err != nil {
+		return nil, err
+	}
+	if k.cacheEnabled {
+		err = k.cacheClient.List(
+			context.Background(),
+			jobList,
+			client.InNamespace(namespace),
+			&client.ListOptions{
+				LabelSelector: labelSelector,
+			})
+	} else {
+		jobList, err = trainingClient.KubeflowV1().XGBoostJobs(namespace).List(context.TODO(), metav1.ListOptions{
+			LabelSelector: labelSelector.String(),
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, job := range jobList.Items {
+		jobs = append(jobs, job.DeepCopy())
+	}
+	return jobs, nil
+}
+
+func (k *k8sResourceAccesser) ListPaddleJobs(trainingClient *trainingversioned.Clientset, namespace string, labels string) ([]*kubeflow_v1.PaddleJob, error) {
+	jobs := []*kubeflow_v1.PaddleJob{}
+	jobList := &kubeflow_v1.PaddleJobList{}
+	var err error
+	labelSelector, err := parseLabelSelector(labels)
+	if err != nil {
+		return nil, err
+	}
+	if k.cacheEnabled {
+		err = k.cacheClient.List(
+			context.Background(),
+			jobList,
+			client.InNamespace(namespace),
+			&client.ListOptions{
+				LabelSelector: labelSelector,
+			})
+	} else {
+		jobList, err = trainingClient.KubeflowV1().PaddleJobs(namespace).List(context.TODO(), metav1.ListOptions{
+			LabelSelector: labelSelector.String(),
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, job := range jobList.Items {
+		jobs = append(jobs, job.DeepCopy())
+	}
+	return jobs, nil
+}
+
+func (k *k8sResourceAccesser) ListJAXJobs(trainingClient *trainingversioned.Clientset, namespace string, labels string) ([]*kubeflow_v1.JAXJob, error) {
+	jobs := []*kubeflow_v1.JAXJob{}
+	jobList := &kubeflow_v1.JAXJobList{}
+	var err error
+	labelSelector, err := parseLabelSelector(labels)
+	if err != nil {
+		return nil, err
+	}
+	if k.cacheEnabled {
+		err = k.cacheClient.List(
+			context.Background(),
+			jobList,
+			client.InNamespace(namespace),
+			&client.ListOptions{
+				LabelSelector: labelSelector,
+			})
+	} else {
+		jobList, err = trainingClient.KubeflowV1().JAXJobs(namespace).List(context.TODO(), metav1.ListOptions{
+			LabelSelector: labelSelector.String(),
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, job := range jobList.Items {
+		jobs = append(jobs, job.DeepCopy())
+	}
+	return jobs, nil
+}
+
 func (k *k8sResourceAccesser) GetCron(cronClient *cronversioned.Clientset, namespace string, name string) (*cron_v1alpha1.Cron, error) {
 	cron := &cron_v1alpha1.Cron{}
 	var err error
@@ -756,6 +913,121 @@ func (k *k8sResourceAccesser) GetSparkJob(sparkjobClient *sparkversioned.Clients
 	return sparkJob, err
 }
 
+func (k *k8sResourceAccesser) GetRayJob(rayjobClient *rayversioned.Clientset, namespace string, name string) (*ray_v1.RayJob, error) {
+	rayJob := &ray_v1.RayJob{}
+	var err error
+	if k.cacheEnabled {
+		err = k.cacheClient.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: name}, rayJob)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(`%v "%v" not found`, RayJobCRDNameInDaemonMode, name)) {
+				return nil, types.ErrTrainingJobNotFound
+			}
+			return nil, fmt.Errorf("failed to find rayjob %v from cache,reason: %v", name, err)
+		}
+	} else {
+		rayJob, err = rayjobClient.RayV1().RayJobs(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(`%v "%v" not found`, RayJobCRDName, name)) {
+				return nil, types.ErrTrainingJobNotFound
+			}
+			return nil, fmt.Errorf("failed to find rayjob %v from api server,reason: %v", name, err)
+		}
+	}
+	return rayJob, err
+}
+
+func (k *k8sResourceAccesser) GetMPIJobV1(trainingClient *trainingversioned.Clientset, namespace string, name string) (*kubeflow_v1.MPIJob, error) {
+	mpijob := &kubeflow_v1.MPIJob{}
+	var err error
+	if k.cacheEnabled {
+		err = k.cacheClient.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: name}, mpijob)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(`%v "%v" not found`, MPICRDNameInDaemonMode, name)) {
+				return nil, types.ErrTrainingJobNotFound
+			}
+			return nil, fmt.Errorf("failed to find mpijob %v from cache,reason: %v", name, err)
+		}
+	} else {
+		mpijob, err = trainingClient.KubeflowV1().MPIJobs(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(`%v "%v" not found`, MPICRDName, name)) {
+				return nil, types.ErrTrainingJobNotFound
+			}
+			return nil, fmt.Errorf("failed to find mpijob %v from api server,reason: %v", name, err)
+		}
+	}
+	return mpijob, err
+}
+
+func (k *k8sResourceAccesser) GetXGBoostJob(trainingClient *trainingversioned.Clientset, namespace string, name string) (*kubeflow_v1.XGBoostJob, error) {
+	xgboostjob := &kubeflow_v1.XGBoostJob{}
+	var err error
+	if k.cacheEnabled {
+		err = k.cacheClient.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: name}, xgboostjob)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(`%v "%v" not found`, XGBoostCRDNameInDaemonMode, name)) {
+				return nil, types.ErrTrainingJobNotFound
+			}
+			return nil, fmt.Errorf("failed to find xgboostjob %v from cache,reason: %v", name, err)
+		}
+	} else {
+		xgboostjob, err = trainingClient.KubeflowV1().XGBoostJobs(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(`%v "%v" not found`, XGBoostCRDName, name)) {
+				return nil, types.ErrTrainingJobNotFound
+			}
+			return nil, fmt.Errorf("failed to find xgboostjob %v from api server,reason: %v", name, err)
+		}
+	}
+	return xgboostjob, err
+}
+
+func (k *k8sResourceAccesser) GetPaddleJob(trainingClient *trainingversioned.Clientset, namespace string, name string) (*kubeflow_v1.PaddleJob, error) {
+	paddlejob := &kubeflow_v1.PaddleJob{}
+	var err error
+	if k.cacheEnabled {
+		err = k.cacheClient.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: name}, paddlejob)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(`%v "%v" not found`, PaddleCRDNameInDaemonMode, name)) {
+				return nil, types.ErrTrainingJobNotFound
+			}
+			return nil, fmt.Errorf("failed to find paddlejob %v from cache,reason: %v", name, err)
+		}
+	} else {
+		paddlejob, err = trainingClient.KubeflowV1().PaddleJobs(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(`%v "%v" not found`, PaddleCRDName, name)) {
+				return nil, types.ErrTrainingJobNotFound
+			}
+			return nil, fmt.Errorf("failed to find paddlejob %v from api server,reason: %v", name, err)
+		}
+	}
+	return paddlejob, err
+}
+
+func (k *k8sResourceAccesser) GetJAXJob(trainingClient *trainingversioned.Clientset, namespace string, name string) (*kubeflow_v1.JAXJob, error) {
+	jaxjob := &kubeflow_v1.JAXJob{}
+	var err error
+	if k.cacheEnabled {
+		err = k.cacheClient.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: name}, jaxjob)
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(`%v "%v" not found`, JAXCRDNameInDaemonMode, name)) {
+				return nil, types.ErrTrainingJobNotFound
+			}
+			return nil, fmt.Errorf("failed to find jaxjob %v from cache,reason: %v", name, err)
+		}
+	} else {
+		jaxjob, err = trainingClient.KubeflowV1().JAXJobs(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			if strings.Contains(err.Error(), fmt.Sprintf(`%v "%v" not found`, JAXCRDName, name)) {
+				return nil, types.ErrTrainingJobNotFound
+			}
+			return nil, fmt.Errorf("failed to find jaxjob %v from api server,reason: %v", name, err)
+		}
+	}
+	return jaxjob, err
+}
+
 func (k *k8sResourceAccesser) GetService(namespace, name string) (*v1.Service, error) {
 	service := &v1.Service{}
 	var err error
@@ -857,6 +1129,34 @@ func (k *k8sResourceAccesser) ListJobs(namespace string, filterLabels string, fi
 	return jobs, nil
 }
 
+// WatchPods watches the pods which match the labels and fields,the watch is always
+// served by the api server because the cache client does not expose the watch interface
+func (k *k8sResourceAccesser) WatchPods(ctx context.Context, namespace string, filterLabels string, filterFields string) (watch.Interface, error) {
+	labelSelector, err := parseLabelSelector(filterLabels)
+	if err != nil {
+		return nil, err
+	}
+	fieldSelector, err := parseFieldSelector(filterFields)
+	if err != nil {
+		return nil, err
+	}
+	return k.clientset.CoreV1().Pods(namespace).Watch(ctx, metav1.ListOptions{
+		LabelSelector: labelSelector.String(),
+		FieldSelector: fieldSelector.String(),
+	})
+}
+
+// WatchEvents watches the events which match the fields,like "involvedObject.name=test"
+func (k *k8sResourceAccesser) WatchEvents(ctx context.Context, namespace string, filterFields string) (watch.Interface, error) {
+	fieldSelector, err := parseFieldSelector(filterFields)
+	if err != nil {
+		return nil, err
+	}
+	return k.clientset.CoreV1().Events(namespace).Watch(ctx, metav1.ListOptions{
+		FieldSelector: fieldSelector.String(),
+	})
+}
+
 func parseLabelSelector(item string) (labels.Selector, error) {
 	if item == "" {
 		return labels.Everything(), nil
@@ -18,7 +18,7 @@ func SubmitModelEvaluateJob(namespace string, args *types.ModelEvaluateArgs) err
 	}
 
 	modelJobChart := util.GetChartsFolder() + "/modeljob"
-	err := workflow.SubmitJob(args.Name, string(types.ModelEvaluateJob), namespace, args, modelJobChart, args.HelmOptions...)
+	err := workflow.SubmitJob(args.Name, string(types.ModelEvaluateJob), namespace, args, modelJobChart, types.DryRunNone, args.HelmOptions...)
 	if err != nil {
 		return err
 	}
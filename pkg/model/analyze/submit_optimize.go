@@ -18,7 +18,7 @@ func SubmitModelOptimizeJob(namespace string, args *types.ModelOptimizeArgs) err
 	}
 
 	modelJobChart := util.GetChartsFolder() + "/modeljob"
-	err := workflow.SubmitJob(args.Name, string(types.ModelOptimizeJob), namespace, args, modelJobChart, args.HelmOptions...)
+	err := workflow.SubmitJob(args.Name, string(types.ModelOptimizeJob), namespace, args, modelJobChart, types.DryRunNone, args.HelmOptions...)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,214 @@
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+// the version tags recording the checksum of the model artifacts
+const (
+	// ArtifactSha256Tag is the sha256 of the sorted lines "<sha256>  <path>" of the files,like the output of sha256sum
+	ArtifactSha256Tag = "artifact.sha256"
+	// ArtifactFilesTag is the number of the files
+	ArtifactFilesTag = "artifact.files"
+	// ArtifactSizeTag is the total size of the files in bytes
+	ArtifactSizeTag = "artifact.size"
+)
+
+// ArtifactStore transfers the files of the model artifacts,the uris are like s3://bucket/path or mlflow-artifacts:/path
+type ArtifactStore interface {
+	// ListFiles returns the paths of the files under the uri relative to it,
+	// the uri pointing to a file returns an empty path
+	ListFiles(uri string) ([]string, error)
+	Download(uri string, w io.Writer) error
+	Upload(uri string, r io.ReadSeeker, size int64) error
+}
+
+// ArtifactChecksum is the checksum of the files of the model artifacts
+type ArtifactChecksum struct {
+	// Files are the sha256 of the files,the keys are the paths relative to the artifact root
+	Files map[string]string
+	Size  int64
+}
+
+// Digest returns the sha256 of the sorted lines "<sha256>  <path>" of the files
+func (c *ArtifactChecksum) Digest() string {
+	paths := []string{}
+	for p := range c.Files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	h := sha256.New()
+	for _, p := range paths {
+		fmt.Fprintf(h, "%s  %s\n", c.Files[p], p)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Tags returns the version tags recording the checksum
+func (c *ArtifactChecksum) Tags() []*types.ModelVersionTag {
+	return []*types.ModelVersionTag{
+		{Key: ArtifactSha256Tag, Value: c.Digest()},
+		{Key: ArtifactFilesTag, Value: strconv.Itoa(len(c.Files))},
+		{Key: ArtifactSizeTag, Value: strconv.FormatInt(c.Size, 10)},
+	}
+}
+
+// Verify returns an error if the checksum does not match the one recorded in the version tags,
+// the version without the checksum tags is not verified
+func (c *ArtifactChecksum) Verify(tags []*types.ModelVersionTag) error {
+	for _, tag := range tags {
+		if tag.Key == ArtifactSha256Tag && tag.Value != c.Digest() {
+			return fmt.Errorf("checksum mismatch,the %v is %v but the artifacts are %v", ArtifactSha256Tag, tag.Value, c.Digest())
+		}
+	}
+	return nil
+}
+
+// ArtifactScheme returns the scheme of the artifact uri,like s3 and mlflow-artifacts
+func ArtifactScheme(uri string) (string, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", fmt.Errorf("invalid artifact uri %v: %v", uri, err)
+	}
+	if u.Scheme == "" {
+		return "", fmt.Errorf("invalid artifact uri %v,it should be like s3://bucket/path or mlflow-artifacts:/path", uri)
+	}
+	return u.Scheme, nil
+}
+
+// PullArtifacts downloads the files under the uri to the dest directory and returns their checksum
+func PullArtifacts(store ArtifactStore, uri, dest string) (*ArtifactChecksum, error) {
+	files, err := store.ListFiles(uri)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the artifacts of %v: %v", uri, err)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("not found any artifact in %v", uri)
+	}
+	dest = filepath.Clean(dest)
+	checksum := &ArtifactChecksum{Files: map[string]string{}}
+	for _, file := range files {
+		fileUri := strings.TrimSuffix(uri, "/") + "/" + file
+		// the uri pointing to a file is downloaded as its base name
+		if file == "" {
+			fileUri = uri
+			file = path.Base(uri)
+		}
+		localPath := filepath.Join(dest, filepath.FromSlash(file))
+		// the files must be under the dest directory,the default dest "." has no separator to compare with
+		rel, err := filepath.Rel(dest, localPath)
+		if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(os.PathSeparator)) {
+			return nil, fmt.Errorf("invalid artifact path %v", file)
+		}
+		if err := os.MkdirAll(filepath.Dir(localPath), 0755); err != nil {
+			return nil, err
+		}
+		sum, size, err := downloadFile(store, fileUri, localPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to download %v: %v", fileUri, err)
+		}
+		log.Debugf("downloaded %v to %v", fileUri, localPath)
+		checksum.Files[file] = sum
+		checksum.Size += size
+	}
+	return checksum, nil
+}
+
+// PushArtifacts uploads the file or the files under the directory to the uri and returns their checksum
+func PushArtifacts(store ArtifactStore, localPath, uri string) (*ArtifactChecksum, error) {
+	info, err := os.Stat(localPath)
+	if err != nil {
+		return nil, err
+	}
+	files := map[string]string{}
+	if info.IsDir() {
+		err = filepath.Walk(localPath, func(p string, fi os.FileInfo, err error) error {
+			if err != nil || !fi.Mode().IsRegular() {
+				return err
+			}
+			rel, err := filepath.Rel(localPath, p)
+			if err != nil {
+				return err
+			}
+			files[filepath.ToSlash(rel)] = p
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		files[info.Name()] = localPath
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("not found any file in %v", localPath)
+	}
+	checksum := &ArtifactChecksum{Files: map[string]string{}}
+	for file, p := range files {
+		fileUri := strings.TrimSuffix(uri, "/") + "/" + path.Clean(file)
+		sum, size, err := uploadFile(store, p, fileUri)
+		if err != nil {
+			return nil, fmt.Errorf("failed to upload %v: %v", p, err)
+		}
+		log.Debugf("uploaded %v to %v", p, fileUri)
+		checksum.Files[file] = sum
+		checksum.Size += size
+	}
+	return checksum, nil
+}
+
+func downloadFile(store ArtifactStore, uri, localPath string) (string, int64, error) {
+	f, err := os.Create(localPath)
+	if err != nil {
+		return "", 0, err
+	}
+	defer f.Close()
+	h := sha256.New()
+	counter := &countingWriter{}
+	if err := store.Download(uri, io.MultiWriter(f, h, counter)); err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(h.Sum(nil)), counter.n, f.Close()
+}
+
+func uploadFile(store ArtifactStore, localPath, uri string) (string, int64, error) {
+	f, err := os.Open(localPath)
+	if err != nil {
+		return "", 0, err
+	}
+	defer f.Close()
+	h := sha256.New()
+	size, err := io.Copy(h, f)
+	if err != nil {
+		return "", 0, err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return "", 0, err
+	}
+	if err := store.Upload(uri, f, size); err != nil {
+		return "", 0, err
+	}
+	return hex.EncodeToString(h.Sum(nil)), size, nil
+}
+
+type countingWriter struct {
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
@@ -0,0 +1,152 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// MlflowArtifactsScheme is the scheme of the artifacts served by the mlflow artifacts proxy,like mlflow-artifacts:/models/my-model
+const MlflowArtifactsScheme = "mlflow-artifacts"
+
+const mlflowArtifactsApi = "api/2.0/mlflow-artifacts/artifacts"
+
+// MlflowArtifactStore transfers the artifacts through the mlflow artifacts proxy of the tracking server,
+// see https://mlflow.org/docs/latest/tracking/artifacts-stores.html
+type MlflowArtifactStore struct {
+	client *MlflowClient
+}
+
+// NewMlflowArtifactStore creates a MlflowArtifactStore with the same tracking server of the client
+func NewMlflowArtifactStore(client *MlflowClient) *MlflowArtifactStore {
+	return &MlflowArtifactStore{client: client}
+}
+
+func (s *MlflowArtifactStore) ListFiles(uri string) ([]string, error) {
+	artifactPath, err := mlflowArtifactPath(uri)
+	if err != nil {
+		return nil, err
+	}
+	files, err := s.listFiles(artifactPath, "")
+	if err != nil {
+		return nil, err
+	}
+	// listing a file returns nothing,download it directly
+	if len(files) == 0 {
+		return []string{""}, nil
+	}
+	return files, nil
+}
+
+func (s *MlflowArtifactStore) listFiles(root, dir string) ([]string, error) {
+	res := &struct {
+		Files []struct {
+			Path  string `json:"path"`
+			IsDir bool   `json:"is_dir"`
+		} `json:"files"`
+	}{}
+	resp, err := s.client.RestyClient.
+		R().
+		SetQueryParam("path", strings.Trim(root+"/"+dir, "/")).
+		Get(mlflowArtifactsApi)
+	if err != nil {
+		return nil, err
+	}
+	if resp.IsError() {
+		return nil, fmt.Errorf("%v: %v", resp.Status(), string(resp.Body()))
+	}
+	if err := json.Unmarshal(resp.Body(), res); err != nil {
+		return nil, err
+	}
+	files := []string{}
+	for _, file := range res.Files {
+		// the proxy returns the base names of the files
+		p := strings.TrimPrefix(dir+"/"+file.Path, "/")
+		if !file.IsDir {
+			files = append(files, p)
+			continue
+		}
+		children, err := s.listFiles(root, p)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, children...)
+	}
+	return files, nil
+}
+
+func (s *MlflowArtifactStore) Download(uri string, w io.Writer) error {
+	artifactPath, err := mlflowArtifactPath(uri)
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.RestyClient.
+		R().
+		SetDoNotParseResponse(true).
+		Get(mlflowArtifactsApi + "/" + escapeArtifactPath(artifactPath))
+	if err != nil {
+		return err
+	}
+	body := resp.RawBody()
+	defer body.Close()
+	if resp.IsError() {
+		if resp.StatusCode() == http.StatusNotFound {
+			return fmt.Errorf("%v: artifact %v", RESOURCE_DOES_NOT_EXIST_ERROR, uri)
+		}
+		return fmt.Errorf("%v", resp.Status())
+	}
+	_, err = io.Copy(w, body)
+	return err
+}
+
+func (s *MlflowArtifactStore) Upload(uri string, r io.ReadSeeker, size int64) error {
+	artifactPath, err := mlflowArtifactPath(uri)
+	if err != nil {
+		return err
+	}
+	// resty sends an io.Reader body chunked,the model files are uploaded with their length by the http client
+	restyClient := s.client.RestyClient
+	u := strings.TrimSuffix(restyClient.BaseURL, "/") + "/" + mlflowArtifactsApi + "/" + escapeArtifactPath(artifactPath)
+	req, err := http.NewRequest(http.MethodPut, u, io.NopCloser(r))
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", "application/octet-stream")
+	if restyClient.UserInfo != nil {
+		req.SetBasicAuth(restyClient.UserInfo.Username, restyClient.UserInfo.Password)
+	}
+	resp, err := restyClient.GetClient().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("%v: %v", resp.Status, string(body))
+	}
+	return nil
+}
+
+// mlflowArtifactPath returns the path of the uri like mlflow-artifacts:/path or mlflow-artifacts://host/path
+func mlflowArtifactPath(uri string) (string, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme != MlflowArtifactsScheme {
+		return "", fmt.Errorf("invalid mlflow artifacts uri %v,it should be like %v:/path", uri, MlflowArtifactsScheme)
+	}
+	return strings.Trim(u.Path, "/"), nil
+}
+
+func escapeArtifactPath(p string) string {
+	segments := strings.Split(p, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
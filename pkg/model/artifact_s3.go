@@ -0,0 +1,134 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// S3ArtifactsScheme is the scheme of the artifacts stored in s3 or an s3 compatible storage,like s3://bucket/models/my-model
+const S3ArtifactsScheme = "s3"
+
+// S3ArtifactStore transfers the artifacts of an s3 compatible endpoint
+type S3ArtifactStore struct {
+	client   *s3.Client
+	uploader *manager.Uploader
+}
+
+// NewS3ArtifactStore creates a S3ArtifactStore,the endpoint is empty for aws s3 and the credentials
+// are found from the environment if the access key is empty
+func NewS3ArtifactStore(endpoint, region, accessKeyId, secretAccessKey string) (*S3ArtifactStore, error) {
+	if region == "" {
+		region = "us-east-1"
+	}
+	options := []func(*config.LoadOptions) error{config.WithRegion(region)}
+	if accessKeyId != "" {
+		options = append(options, config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(accessKeyId, secretAccessKey, "")))
+	}
+	cfg, err := config.LoadDefaultConfig(context.TODO(), options...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load s3 config: %v", err)
+	}
+	if endpoint != "" && !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+	client := s3.NewFromConfig(cfg, func(o *s3.Options) {
+		if endpoint != "" {
+			// the s3 compatible storages like minio only support the path style
+			o.BaseEndpoint = aws.String(endpoint)
+			o.UsePathStyle = true
+		}
+	})
+	return &S3ArtifactStore{
+		client:   client,
+		uploader: manager.NewUploader(client),
+	}, nil
+}
+
+func (s *S3ArtifactStore) ListFiles(uri string) ([]string, error) {
+	bucket, key, err := parseS3Uri(uri)
+	if err != nil {
+		return nil, err
+	}
+	prefix := strings.TrimSuffix(key, "/") + "/"
+	if key == "" {
+		prefix = ""
+	}
+	files := []string{}
+	paginator := s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			return nil, err
+		}
+		for _, object := range page.Contents {
+			// skip the directory markers
+			if strings.HasSuffix(aws.ToString(object.Key), "/") {
+				continue
+			}
+			files = append(files, strings.TrimPrefix(aws.ToString(object.Key), prefix))
+		}
+	}
+	if len(files) != 0 || key == "" {
+		return files, nil
+	}
+	// the uri may point to a file
+	if _, err := s.client.HeadObject(context.TODO(), &s3.HeadObjectInput{Bucket: aws.String(bucket), Key: aws.String(key)}); err == nil {
+		return []string{""}, nil
+	}
+	return files, nil
+}
+
+func (s *S3ArtifactStore) Download(uri string, w io.Writer) error {
+	bucket, key, err := parseS3Uri(uri)
+	if err != nil {
+		return err
+	}
+	output, err := s.client.GetObject(context.TODO(), &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return err
+	}
+	defer output.Body.Close()
+	_, err = io.Copy(w, output.Body)
+	return err
+}
+
+func (s *S3ArtifactStore) Upload(uri string, r io.ReadSeeker, size int64) error {
+	bucket, key, err := parseS3Uri(uri)
+	if err != nil {
+		return err
+	}
+	// the uploader uploads the large files in parts
+	_, err = s.uploader.Upload(context.TODO(), &s3.PutObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Body:   r,
+	})
+	return err
+}
+
+// parseS3Uri returns the bucket and key of the uri like s3://bucket/key
+func parseS3Uri(uri string) (string, string, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", "", err
+	}
+	if u.Scheme != S3ArtifactsScheme || u.Host == "" {
+		return "", "", fmt.Errorf("invalid s3 uri %v,it should be like s3://bucket/path", uri)
+	}
+	return u.Host, strings.TrimPrefix(u.Path, "/"), nil
+}
@@ -0,0 +1,252 @@
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+// fakeArtifactStore is an in-memory ArtifactStore,the keys of files are the uris
+type fakeArtifactStore struct {
+	files map[string][]byte
+}
+
+func newFakeArtifactStore(files map[string]string) *fakeArtifactStore {
+	s := &fakeArtifactStore{files: map[string][]byte{}}
+	for uri, content := range files {
+		s.files[uri] = []byte(content)
+	}
+	return s
+}
+
+func (s *fakeArtifactStore) ListFiles(uri string) ([]string, error) {
+	if _, ok := s.files[uri]; ok {
+		return []string{""}, nil
+	}
+	prefix := strings.TrimSuffix(uri, "/") + "/"
+	files := []string{}
+	for u := range s.files {
+		if strings.HasPrefix(u, prefix) {
+			files = append(files, strings.TrimPrefix(u, prefix))
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+func (s *fakeArtifactStore) Download(uri string, w io.Writer) error {
+	content, ok := s.files[uri]
+	if !ok {
+		return fmt.Errorf("%v not found", uri)
+	}
+	_, err := w.Write(content)
+	return err
+}
+
+func (s *fakeArtifactStore) Upload(uri string, r io.ReadSeeker, size int64) error {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if int64(len(content)) != size {
+		return fmt.Errorf("the size of %v is %v,but %v is read", uri, size, len(content))
+	}
+	s.files[uri] = content
+	return nil
+}
+
+func sha256Hex(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestPullArtifacts(t *testing.T) {
+	store := newFakeArtifactStore(map[string]string{
+		"s3://bucket/model/config.json":         "{}",
+		"s3://bucket/model/weights/model.bin":   "weights",
+		"s3://bucket/single/model.safetensors":  "tensors",
+		"s3://bucket/escape/../../outside.txt":  "outside",
+		"s3://bucket/escape-dot/../escaped.txt": "escaped",
+	})
+	tests := []struct {
+		name    string
+		uri     string
+		files   map[string]string
+		wantErr bool
+	}{
+		{
+			name:  "directory",
+			uri:   "s3://bucket/model/",
+			files: map[string]string{"config.json": "{}", "weights/model.bin": "weights"},
+		},
+		{
+			name:  "single file",
+			uri:   "s3://bucket/single/model.safetensors",
+			files: map[string]string{"model.safetensors": "tensors"},
+		},
+		{name: "path traversal", uri: "s3://bucket/escape", wantErr: true},
+		{name: "path traversal to the parent", uri: "s3://bucket/escape-dot", wantErr: true},
+		{name: "no artifact", uri: "s3://bucket/missing", wantErr: true},
+	}
+	for _, test := range tests {
+		for _, relative := range []bool{false, true} {
+			dir := t.TempDir()
+			// the escaping files are written to dir if the path traversal is not rejected
+			dest := filepath.Join(dir, "a", "b")
+			if err := os.MkdirAll(dest, 0755); err != nil {
+				t.Fatalf("failed to create %v: %v", dest, err)
+			}
+			checksum, err := pullArtifactsTo(t, store, test.uri, dest, relative)
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("%v: expected an error when pulling %v to %v", test.name, test.uri, dest)
+				}
+				for _, p := range []string{filepath.Join(dir, "outside.txt"), filepath.Join(dir, "a", "escaped.txt")} {
+					if _, err := os.Stat(p); err == nil {
+						t.Fatalf("%v: the file %v outside the dest directory is written", test.name, p)
+					}
+				}
+				continue
+			}
+			if err != nil {
+				t.Fatalf("%v: failed to pull %v to %v (relative: %v): %v", test.name, test.uri, dest, relative, err)
+			}
+			var size int64
+			for file, content := range test.files {
+				data, err := os.ReadFile(filepath.Join(dest, filepath.FromSlash(file)))
+				if err != nil {
+					t.Fatalf("%v: failed to read the pulled file %v: %v", test.name, file, err)
+				}
+				if string(data) != content {
+					t.Fatalf("%v: expected %v of %v, got %v", test.name, content, file, string(data))
+				}
+				if checksum.Files[file] != sha256Hex(content) {
+					t.Fatalf("%v: expected the sha256 of %v to be %v, got %v", test.name, file, sha256Hex(content), checksum.Files[file])
+				}
+				size += int64(len(content))
+			}
+			if len(checksum.Files) != len(test.files) || checksum.Size != size {
+				t.Fatalf("%v: expected %v files of %v bytes, got %v files of %v bytes", test.name, len(test.files), size, len(checksum.Files), checksum.Size)
+			}
+		}
+	}
+}
+
+// pullArtifactsTo pulls the artifacts to dest,or to "." in dest like the default of 'arena model pull' if relative is set
+func pullArtifactsTo(t *testing.T, store ArtifactStore, uri, dest string, relative bool) (*ArtifactChecksum, error) {
+	if !relative {
+		return PullArtifacts(store, uri, dest)
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("failed to get the working directory: %v", err)
+	}
+	if err := os.Chdir(dest); err != nil {
+		t.Fatalf("failed to change the working directory to %v: %v", dest, err)
+	}
+	defer func() {
+		if err := os.Chdir(wd); err != nil {
+			t.Fatalf("failed to restore the working directory: %v", err)
+		}
+	}()
+	return PullArtifacts(store, uri, ".")
+}
+
+func TestPushArtifacts(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{"config.json": "{}", "weights/model.bin": "weights"}
+	for file, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(file))
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatalf("failed to create %v: %v", filepath.Dir(p), err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write %v: %v", p, err)
+		}
+	}
+
+	store := newFakeArtifactStore(nil)
+	checksum, err := PushArtifacts(store, dir, "s3://bucket/model/")
+	if err != nil {
+		t.Fatalf("failed to push %v: %v", dir, err)
+	}
+	for file, content := range files {
+		uri := "s3://bucket/model/" + file
+		if string(store.files[uri]) != content {
+			t.Fatalf("expected %v of %v, got %v", content, uri, string(store.files[uri]))
+		}
+		if checksum.Files[file] != sha256Hex(content) {
+			t.Fatalf("expected the sha256 of %v to be %v, got %v", file, sha256Hex(content), checksum.Files[file])
+		}
+	}
+	if len(store.files) != len(files) || checksum.Size != int64(len("{}")+len("weights")) {
+		t.Fatalf("expected %v uploaded files of %v bytes, got %v files of %v bytes", len(files), len("{}")+len("weights"), len(store.files), checksum.Size)
+	}
+
+	// the pulled artifacts have the same checksum as the pushed ones
+	pulled, err := PullArtifacts(store, "s3://bucket/model", t.TempDir())
+	if err != nil {
+		t.Fatalf("failed to pull the pushed artifacts: %v", err)
+	}
+	if pulled.Digest() != checksum.Digest() {
+		t.Fatalf("expected the digest of the pulled artifacts to be %v, got %v", checksum.Digest(), pulled.Digest())
+	}
+
+	single := filepath.Join(dir, "config.json")
+	checksum, err = PushArtifacts(store, single, "s3://bucket/single")
+	if err != nil {
+		t.Fatalf("failed to push %v: %v", single, err)
+	}
+	if string(store.files["s3://bucket/single/config.json"]) != "{}" || len(checksum.Files) != 1 {
+		t.Fatalf("expected the file to be pushed as s3://bucket/single/config.json, got %v", checksum.Files)
+	}
+
+	if _, err := PushArtifacts(store, t.TempDir(), "s3://bucket/empty"); err == nil {
+		t.Fatalf("expected an error when pushing an empty directory")
+	}
+}
+
+func TestArtifactChecksum(t *testing.T) {
+	checksum := &ArtifactChecksum{
+		Files: map[string]string{"weights/model.bin": sha256Hex("weights"), "config.json": sha256Hex("{}")},
+		Size:  int64(len("weights") + len("{}")),
+	}
+	// the digest is the sha256 of the sorted sha256sum lines
+	expected := sha256Hex(fmt.Sprintf("%s  config.json\n%s  weights/model.bin\n", sha256Hex("{}"), sha256Hex("weights")))
+	if checksum.Digest() != expected {
+		t.Fatalf("expected digest %v, got %v", expected, checksum.Digest())
+	}
+
+	tags := map[string]string{}
+	for _, tag := range checksum.Tags() {
+		tags[tag.Key] = tag.Value
+	}
+	if tags[ArtifactSha256Tag] != expected || tags[ArtifactFilesTag] != "2" || tags[ArtifactSizeTag] != "9" {
+		t.Fatalf("unexpected checksum tags %v", tags)
+	}
+
+	tests := []struct {
+		name    string
+		tags    []*types.ModelVersionTag
+		wantErr bool
+	}{
+		{name: "matched", tags: checksum.Tags()},
+		{name: "no checksum tags", tags: []*types.ModelVersionTag{{Key: "createdBy", Value: "arena"}}},
+		{name: "mismatched", tags: []*types.ModelVersionTag{{Key: ArtifactSha256Tag, Value: sha256Hex("other")}}, wantErr: true},
+	}
+	for _, test := range tests {
+		err := checksum.Verify(test.tags)
+		if test.wantErr != (err != nil) {
+			t.Fatalf("%v: expected error %v, got %v", test.name, test.wantErr, err)
+		}
+	}
+
+}
@@ -0,0 +1,175 @@
+package lineage
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/evaluate"
+	"github.com/kubeflow/arena/pkg/model/analyze"
+	"github.com/kubeflow/arena/pkg/serving"
+	"github.com/kubeflow/arena/pkg/training"
+)
+
+// the order of the relations in the lineage
+var relationOrder = map[types.ModelLineageRelation]int{
+	types.ModelLineageProduced:    0,
+	types.ModelLineageEvaluated:   1,
+	types.ModelLineageProfiled:    2,
+	types.ModelLineageOptimized:   3,
+	types.ModelLineageBenchmarked: 4,
+	types.ModelLineageServed:      5,
+}
+
+var modelJobRelations = map[types.ModelJobType]types.ModelLineageRelation{
+	types.ModelProfileJob:   types.ModelLineageProfiled,
+	types.ModelOptimizeJob:  types.ModelLineageOptimized,
+	types.ModelBenchmarkJob: types.ModelLineageBenchmarked,
+	types.ModelEvaluateJob:  types.ModelLineageEvaluated,
+}
+
+// BuildModelLineage walks the training jobs,evaluate jobs,model analyze jobs and serving jobs labeled with
+// the model name,and groups them by the model version. If version is not empty,only the version is returned.
+// The jobs which can not be listed,like the crds are not installed,are skipped with warnings.
+func BuildModelLineage(name, version, namespace string, allNamespaces bool) *types.ModelLineage {
+	builder := &lineageBuilder{
+		lineage:  &types.ModelLineage{Name: name, Versions: []*types.ModelVersionLineage{}},
+		versions: map[string]*types.ModelVersionLineage{},
+		name:     name,
+		version:  version,
+	}
+
+	trainingJobs, err := training.ListTrainingJobs(namespace, allNamespaces, types.AllTrainingJob)
+	if err != nil {
+		log.Warnf("failed to list the training jobs: %v", err)
+	}
+	for _, job := range trainingJobs {
+		builder.addJob(job.GetLabels()["modelName"], job.GetLabels()["modelVersion"], &types.ModelLineageJob{
+			Name:      job.Name(),
+			Namespace: job.Namespace(),
+			Type:      string(job.Trainer()),
+			Status:    job.GetStatus(),
+			Relation:  types.ModelLineageProduced,
+		})
+	}
+
+	evaluateJobs, err := evaluate.ListEvaluateJobs(namespace, allNamespaces)
+	if err != nil {
+		log.Warnf("failed to list the evaluate jobs: %v", err)
+	}
+	for _, job := range evaluateJobs {
+		builder.addJob(job.ModelName, job.ModelVersion, &types.ModelLineageJob{
+			Name:      job.Name,
+			Namespace: job.Namespace,
+			Type:      string(types.EvaluateJob),
+			Status:    job.Status,
+			Relation:  types.ModelLineageEvaluated,
+		})
+	}
+
+	modelJobs, err := analyze.ListModelJobs(namespace, allNamespaces, types.AllModelJob)
+	if err != nil {
+		log.Warnf("failed to list the model analyze jobs: %v", err)
+	}
+	for _, job := range modelJobs {
+		if job.Job() == nil {
+			continue
+		}
+		labels := job.Job().Labels
+		builder.addJob(labels["modelName"], labels["modelVersion"], &types.ModelLineageJob{
+			Name:      job.Name(),
+			Namespace: job.Namespace(),
+			Type:      string(job.Type()),
+			Status:    job.Status(),
+			Relation:  modelJobRelations[job.Type()],
+		})
+	}
+
+	servingJobs, err := serving.ListServingJobs(namespace, allNamespaces, types.AllServingJob)
+	if err != nil {
+		log.Warnf("failed to list the serving jobs: %v", err)
+	}
+	for _, job := range servingJobs {
+		builder.addJob(job.GetLabels()["modelName"], job.GetLabels()["modelVersion"], &types.ModelLineageJob{
+			Name:      job.Name(),
+			Namespace: job.Namespace(),
+			Type:      string(job.Type()),
+			Status:    fmt.Sprintf("%d/%d available", job.AvailableInstances(), job.DesiredInstances()),
+			Relation:  types.ModelLineageServed,
+		})
+	}
+	SortModelLineage(builder.lineage)
+	return builder.lineage
+}
+
+// AddModelVersions adds the registered model versions which have no jobs to the lineage
+func AddModelVersions(lineage *types.ModelLineage, modelVersions []*types.ModelVersion) {
+	versions := map[string]*types.ModelVersionLineage{}
+	for _, v := range lineage.Versions {
+		versions[v.Version] = v
+	}
+	for _, mv := range modelVersions {
+		if v, ok := versions[mv.Version]; ok {
+			v.Source = mv.Source
+			continue
+		}
+		lineage.Versions = append(lineage.Versions, &types.ModelVersionLineage{
+			Version: mv.Version,
+			Source:  mv.Source,
+			Jobs:    []*types.ModelLineageJob{},
+		})
+	}
+	SortModelLineage(lineage)
+}
+
+// SortModelLineage sorts the versions by the version number and the jobs by their relations,
+// the jobs without model version are the last
+func SortModelLineage(lineage *types.ModelLineage) {
+	sort.SliceStable(lineage.Versions, func(i, j int) bool {
+		vi, vj := lineage.Versions[i].Version, lineage.Versions[j].Version
+		if vi == "" || vj == "" {
+			return vj == "" && vi != ""
+		}
+		ni, erri := strconv.Atoi(vi)
+		nj, errj := strconv.Atoi(vj)
+		if erri == nil && errj == nil {
+			return ni < nj
+		}
+		return vi < vj
+	})
+	for _, v := range lineage.Versions {
+		sort.SliceStable(v.Jobs, func(i, j int) bool {
+			ji, jj := v.Jobs[i], v.Jobs[j]
+			if relationOrder[ji.Relation] != relationOrder[jj.Relation] {
+				return relationOrder[ji.Relation] < relationOrder[jj.Relation]
+			}
+			if ji.Namespace != jj.Namespace {
+				return ji.Namespace < jj.Namespace
+			}
+			return ji.Name < jj.Name
+		})
+	}
+}
+
+type lineageBuilder struct {
+	lineage  *types.ModelLineage
+	versions map[string]*types.ModelVersionLineage
+	name     string
+	version  string
+}
+
+func (b *lineageBuilder) addJob(modelName, modelVersion string, job *types.ModelLineageJob) {
+	if modelName != b.name || (b.version != "" && modelVersion != b.version) {
+		return
+	}
+	v, ok := b.versions[modelVersion]
+	if !ok {
+		v = &types.ModelVersionLineage{Version: modelVersion, Jobs: []*types.ModelLineageJob{}}
+		b.versions[modelVersion] = v
+		b.lineage.Versions = append(b.lineage.Versions, v)
+	}
+	v.Jobs = append(v.Jobs, job)
+}
@@ -0,0 +1,199 @@
+package lineage
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+// newTestLineage returns an unsorted lineage whose version 2 has the jobs of all relations
+func newTestLineage() *types.ModelLineage {
+	return &types.ModelLineage{
+		Name: "mnist",
+		Versions: []*types.ModelVersionLineage{
+			{
+				Version: "",
+				Jobs: []*types.ModelLineageJob{
+					{Name: "train-next", Namespace: "default", Type: "tfjob", Status: "RUNNING", Relation: types.ModelLineageProduced},
+				},
+			},
+			{
+				Version: "10",
+				Jobs: []*types.ModelLineageJob{
+					{Name: "serve-10", Namespace: "default", Type: "tf-serving", Status: "1/1 available", Relation: types.ModelLineageServed},
+				},
+			},
+			{
+				Version: "2",
+				Jobs: []*types.ModelLineageJob{
+					{Name: "serve-2", Namespace: "default", Type: "tf-serving", Status: "1/1 available", Relation: types.ModelLineageServed},
+					{Name: "eval-2", Namespace: "prod", Type: "evaluate", Status: "SUCCEEDED", Relation: types.ModelLineageEvaluated},
+					{Name: "train-2", Namespace: "default", Type: "tfjob", Status: "SUCCEEDED", Relation: types.ModelLineageProduced},
+					{Name: "eval-2", Namespace: "default", Type: "evaluate", Status: "SUCCEEDED", Relation: types.ModelLineageEvaluated},
+					{Name: "profile-2", Namespace: "default", Type: "profile", Status: "COMPLETE", Relation: types.ModelLineageProfiled},
+				},
+			},
+		},
+	}
+}
+
+func versionsOf(lineage *types.ModelLineage) []string {
+	versions := []string{}
+	for _, v := range lineage.Versions {
+		versions = append(versions, v.Version)
+	}
+	return versions
+}
+
+func TestSortModelLineage(t *testing.T) {
+	tests := []struct {
+		name          string
+		modelVersions []*types.ModelVersion
+		versions      []string
+		sources       map[string]string
+	}{
+		{
+			name:     "sort only",
+			versions: []string{"2", "10", ""},
+			sources:  map[string]string{},
+		},
+		{
+			name: "add model versions",
+			modelVersions: []*types.ModelVersion{
+				{Name: "mnist", Version: "2", Source: "pvc://default/models/mnist-2"},
+				{Name: "mnist", Version: "1", Source: "pvc://default/models/mnist-1"},
+			},
+			// the version without jobs is added in order,the unregistered version is the last
+			versions: []string{"1", "2", "10", ""},
+			sources:  map[string]string{"1": "pvc://default/models/mnist-1", "2": "pvc://default/models/mnist-2"},
+		},
+	}
+	for _, test := range tests {
+		lineage := newTestLineage()
+		if test.modelVersions != nil {
+			AddModelVersions(lineage, test.modelVersions)
+		} else {
+			SortModelLineage(lineage)
+		}
+		if versions := versionsOf(lineage); !reflect.DeepEqual(versions, test.versions) {
+			t.Fatalf("%v: expected versions %v, got %v", test.name, test.versions, versions)
+		}
+		for _, v := range lineage.Versions {
+			if v.Source != test.sources[v.Version] {
+				t.Fatalf("%v: expected the source of version %v to be %v, got %v", test.name, v.Version, test.sources[v.Version], v.Source)
+			}
+			if v.Jobs == nil {
+				t.Fatalf("%v: expected the jobs of version %v to be empty instead of nil", test.name, v.Version)
+			}
+		}
+		for _, v := range lineage.Versions {
+			if v.Version != "2" {
+				continue
+			}
+			// the jobs are sorted by the relation,then the namespace and the name
+			expected := []string{"default/train-2", "default/eval-2", "prod/eval-2", "default/profile-2", "default/serve-2"}
+			jobs := []string{}
+			for _, job := range v.Jobs {
+				jobs = append(jobs, job.Namespace+"/"+job.Name)
+			}
+			if !reflect.DeepEqual(jobs, expected) {
+				t.Fatalf("%v: expected jobs %v, got %v", test.name, expected, jobs)
+			}
+		}
+	}
+}
+
+func TestPrintModelLineage(t *testing.T) {
+	lineage := newTestLineage()
+	AddModelVersions(lineage, []*types.ModelVersion{{Name: "mnist", Version: "2", Source: "pvc://default/models/mnist-2"}})
+
+	tests := []struct {
+		format   string
+		expected string
+		contains []string
+		excludes []string
+		wantErr  bool
+	}{
+		{
+			format: "tree",
+			expected: `mnist
+├── version 2 (pvc://default/models/mnist-2)
+│   ├── produced by tfjob default/train-2 [SUCCEEDED]
+│   ├── evaluated by evaluate default/eval-2 [SUCCEEDED]
+│   ├── evaluated by evaluate prod/eval-2 [SUCCEEDED]
+│   ├── profiled by profile default/profile-2 [COMPLETE]
+│   └── served by tf-serving default/serve-2 [1/1 available]
+├── version 10
+│   └── served by tf-serving default/serve-10 [1/1 available]
+└── <unregistered>
+    └── produced by tfjob default/train-next [RUNNING]
+`,
+		},
+		{
+			format: "dot",
+			contains: []string{
+				`digraph "mnist" {`,
+				`"model/mnist" -> "version/mnist/2";`,
+				// the training job points to the version it produced
+				`"job/tfjob/default/train-2" -> "version/mnist/2" [label="produced"];`,
+				`"job/tfjob/default/train-next" -> "version/mnist/" [label="produced"];`,
+				// the version points to the jobs using it
+				`"version/mnist/2" -> "job/evaluate/prod/eval-2" [label="evaluated"];`,
+				`"version/mnist/10" -> "job/tf-serving/default/serve-10" [label="served"];`,
+				`"version/mnist/" [shape=box, label="<unregistered>"];`,
+			},
+			excludes: []string{
+				`"version/mnist/2" -> "job/tfjob/default/train-2"`,
+				`"job/tf-serving/default/serve-10" -> "version/mnist/10"`,
+			},
+		},
+		{format: "yaml", wantErr: true},
+	}
+	for _, test := range tests {
+		var buf bytes.Buffer
+		err := PrintModelLineage(&buf, lineage, test.format)
+		if test.wantErr {
+			if err == nil {
+				t.Fatalf("%v: expected an error of the unknown format", test.format)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%v: failed to print the lineage: %v", test.format, err)
+		}
+		output := buf.String()
+		if test.expected != "" && output != test.expected {
+			t.Fatalf("%v: expected output:\n%v\ngot:\n%v", test.format, test.expected, output)
+		}
+		for _, s := range test.contains {
+			if !strings.Contains(output, s) {
+				t.Fatalf("%v: expected the output to contain %v, got:\n%v", test.format, s, output)
+			}
+		}
+		for _, s := range test.excludes {
+			if strings.Contains(output, s) {
+				t.Fatalf("%v: expected the output not to contain %v, got:\n%v", test.format, s, output)
+			}
+		}
+	}
+
+	// the json output keeps the sorted lineage
+	var buf bytes.Buffer
+	if err := PrintModelLineage(&buf, lineage, "json"); err != nil {
+		t.Fatalf("json: failed to print the lineage: %v", err)
+	}
+	printed := &types.ModelLineage{}
+	if err := json.Unmarshal(buf.Bytes(), printed); err != nil {
+		t.Fatalf("json: failed to parse the output %v: %v", buf.String(), err)
+	}
+	if !reflect.DeepEqual(printed, lineage) {
+		t.Fatalf("json: expected the printed lineage to be %+v, got %+v", lineage, printed)
+	}
+	if versions := versionsOf(printed); !reflect.DeepEqual(versions, []string{"2", "10", ""}) {
+		t.Fatalf("json: expected versions [2 10 ], got %v", versions)
+	}
+}
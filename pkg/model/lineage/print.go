@@ -0,0 +1,96 @@
+package lineage
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+// unregisteredVersion is displayed for the jobs labeled with the model name only
+const unregisteredVersion = "<unregistered>"
+
+// PrintModelLineage prints the lineage as a tree,json or graphviz dot
+func PrintModelLineage(w io.Writer, lineage *types.ModelLineage, format string) error {
+	switch format {
+	case "", "tree":
+		printTree(w, lineage)
+	case "json":
+		data, err := json.MarshalIndent(lineage, "", "    ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(w, string(data))
+	case "dot":
+		printDot(w, lineage)
+	default:
+		return fmt.Errorf("unknown output format %v,only support: [tree,json,dot]", format)
+	}
+	return nil
+}
+
+// printTree prints the lineage like:
+//
+//	my-model
+//	└── version 1 (pvc://default/models/my-model)
+//	    ├── produced by tfjob default/mnist [SUCCEEDED]
+//	    └── served by tf-serving default/mnist [1/1 available]
+func printTree(w io.Writer, lineage *types.ModelLineage) {
+	fmt.Fprintln(w, lineage.Name)
+	for i, v := range lineage.Versions {
+		last := i == len(lineage.Versions)-1
+		fmt.Fprintf(w, "%s%s\n", treeBranch(last), versionLabel(v))
+		indent := "│   "
+		if last {
+			indent = "    "
+		}
+		for j, job := range v.Jobs {
+			fmt.Fprintf(w, "%s%s%s by %s %s/%s [%s]\n", indent, treeBranch(j == len(v.Jobs)-1), job.Relation, job.Type, job.Namespace, job.Name, job.Status)
+		}
+	}
+}
+
+func treeBranch(last bool) string {
+	if last {
+		return "└── "
+	}
+	return "├── "
+}
+
+func versionLabel(v *types.ModelVersionLineage) string {
+	if v.Version == "" {
+		return unregisteredVersion
+	}
+	if v.Source == "" {
+		return "version " + v.Version
+	}
+	return fmt.Sprintf("version %s (%s)", v.Version, v.Source)
+}
+
+// printDot prints the lineage in graphviz dot,the training jobs point to the versions they produced
+// and the versions point to the jobs using them
+func printDot(w io.Writer, lineage *types.ModelLineage) {
+	modelNode := "model/" + lineage.Name
+	fmt.Fprintf(w, "digraph %s {\n", strconv.Quote(lineage.Name))
+	fmt.Fprintln(w, "  rankdir=LR;")
+	fmt.Fprintf(w, "  %s [shape=box3d, label=%s];\n", strconv.Quote(modelNode), strconv.Quote(lineage.Name))
+	for _, v := range lineage.Versions {
+		versionNode := fmt.Sprintf("version/%s/%s", lineage.Name, v.Version)
+		fmt.Fprintf(w, "  %s [shape=box, label=%s];\n", strconv.Quote(versionNode), strconv.Quote(versionLabel(v)))
+		fmt.Fprintf(w, "  %s -> %s;\n", strconv.Quote(modelNode), strconv.Quote(versionNode))
+		for _, job := range v.Jobs {
+			jobNode := fmt.Sprintf("job/%s/%s/%s", job.Type, job.Namespace, job.Name)
+			label := strings.Join([]string{job.Type, job.Namespace + "/" + job.Name, job.Status}, "\n")
+			fmt.Fprintf(w, "  %s [shape=ellipse, label=%s];\n", strconv.Quote(jobNode), strconv.Quote(label))
+			from, to := versionNode, jobNode
+			if job.Relation == types.ModelLineageProduced {
+				from, to = jobNode, versionNode
+			}
+			fmt.Fprintf(w, "  %s -> %s [label=%s];\n", strconv.Quote(from), strconv.Quote(to), strconv.Quote(string(job.Relation)))
+		}
+	}
+	fmt.Fprintln(w, "}")
+}
@@ -104,6 +104,10 @@ func NewProxiedMlflowClient(configr *config.ArenaConfiger, service *corev1.Servi
 	return defaultProxiedMlflowClient
 }
 
+func (c *MlflowClient) Type() types.ModelRegistryType {
+	return types.MlflowModelRegistry
+}
+
 func (c *MlflowClient) CheckHealth() (bool, error) {
 	resp, err := c.RestyClient.
 		R().
@@ -829,33 +833,7 @@ func (c *MlflowClient) CreateRegisteredModelAndModelVersion(
 	versionDescription string,
 	versionTags []*types.ModelVersionTag,
 	source string,
+	runLink string,
 ) (*types.RegisteredModel, *types.ModelVersion, error) {
-	// Create a registered model if not exists
-	var registeredModel *types.RegisteredModel
-	var modelVersion *types.ModelVersion
-	registeredModel, err := c.GetRegisteredModel(name)
-	if err != nil {
-		if !strings.Contains(err.Error(), RESOURCE_DOES_NOT_EXIST_ERROR) {
-			return nil, nil, err
-		}
-	}
-	if registeredModel == nil {
-		registeredModel, err := c.CreateRegisteredModel(name, tags, description)
-		// TODO: fix mlflow bug that deletes a registered model does not delete associated permissions when basic authentication is enabled
-		if err != nil && !strings.Contains(err.Error(), RESOURCE_ALREADY_EXISTS_ERROR) {
-			return registeredModel, nil, err
-		}
-		log.Infof("registered model \"%s\" created\n", name)
-	}
-
-	// Create a model version
-	if version != "auto" {
-		return registeredModel, modelVersion, fmt.Errorf("model version currently only supports `auto`")
-	}
-	modelVersion, err = c.CreateModelVersion(name, source, "", versionTags, "", versionDescription)
-	if err != nil {
-		return registeredModel, modelVersion, err
-	}
-	log.Infof("model version %s for \"%s\" created\n", modelVersion.Version, modelVersion.Name)
-	return registeredModel, modelVersion, err
+	return createRegisteredModelAndModelVersion(c, name, description, tags, version, versionDescription, versionTags, source, runLink)
 }
@@ -0,0 +1,1237 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	ggcrtypes "github.com/google/go-containerregistry/pkg/v1/types"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+// The models are stored in the oci registry as follows:
+//   - a registered model is the repository REPOSITORY_PREFIX/MODEL_NAME
+//   - the registered model itself is stored in the manifest tagged with ociRegisteredModelTag
+//   - a model version is the manifest tagged with the version number,the next version number is kept
+//     in the manifest of the registered model,so the numbers of the deleted versions are never reused
+//   - an alias is a tag pointing to the same manifest of the model version
+//
+// The metadata (description, tags, timestamps...) are stored in the annotations of the manifests.
+const (
+	ociManifestMediaType           = "application/vnd.oci.image.manifest.v1+json"
+	ociEmptyMediaType              = "application/vnd.oci.empty.v1+json"
+	ociRegisteredModelArtifactType = "application/vnd.kubeflow.arena.registered-model.v1+json"
+	ociModelVersionArtifactType    = "application/vnd.kubeflow.arena.model.v1+json"
+	ociDeletedAliasArtifactType    = "application/vnd.kubeflow.arena.deleted-alias.v1+json"
+
+	// ociRegisteredModelTag is the tag of the manifest which stores the registered model
+	ociRegisteredModelTag = "_registered-model"
+
+	ociAnnotationName                 = "org.kubeflow.arena.model.name"
+	ociAnnotationVersion              = "org.kubeflow.arena.model.version"
+	ociAnnotationDescription          = "org.kubeflow.arena.model.description"
+	ociAnnotationTags                 = "org.kubeflow.arena.model.tags"
+	ociAnnotationSource               = "org.kubeflow.arena.model.source"
+	ociAnnotationRunId                = "org.kubeflow.arena.model.run-id"
+	ociAnnotationRunLink              = "org.kubeflow.arena.model.run-link"
+	ociAnnotationCreationTimestamp    = "org.kubeflow.arena.model.creation-timestamp"
+	ociAnnotationLastUpdatedTimestamp = "org.kubeflow.arena.model.last-updated-timestamp"
+	ociAnnotationAlias                = "org.kubeflow.arena.model.alias"
+	ociAnnotationNextVersion          = "org.kubeflow.arena.model.next-version"
+	// ociAnnotationVersionClaim makes the manifests of the registered model and the model version unique for each claim of a version number
+	ociAnnotationVersionClaim = "org.kubeflow.arena.model.version-claim"
+
+	// ociVersionClaimRetries is the max times to claim a version number when the registered model is updated concurrently
+	ociVersionClaimRetries = 5
+)
+
+var (
+	// ociEmptyBlob is the config blob of the manifests
+	ociEmptyBlob = []byte("{}")
+	// ociRepositoryComponentRegexp is the format of the path component of a repository name
+	ociRepositoryComponentRegexp = regexp.MustCompile(`^[a-z0-9]+((\.|_|__|-+)[a-z0-9]+)*$`)
+	// ociTagRegexp is the format of a tag
+	ociTagRegexp = regexp.MustCompile(`^[a-zA-Z0-9_][a-zA-Z0-9._-]{0,127}$`)
+	// ociNameFilterRegexp is the supported search filter, like: name='mnist' or name LIKE 'mnist%'
+	ociNameFilterRegexp = regexp.MustCompile(`^\s*name\s*(=|(?i:i?like))\s*'([^']*)'\s*$`)
+	// errOCIConcurrentRegistration means the claimed version number is taken by a concurrent registration
+	errOCIConcurrentRegistration = errors.New("the version number is taken by a concurrent registration")
+)
+
+type ociDescriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type ociManifest struct {
+	SchemaVersion int               `json:"schemaVersion"`
+	MediaType     string            `json:"mediaType"`
+	ArtifactType  string            `json:"artifactType,omitempty"`
+	Config        ociDescriptor     `json:"config"`
+	Layers        []ociDescriptor   `json:"layers"`
+	Annotations   map[string]string `json:"annotations,omitempty"`
+}
+
+// OCIRegistryClient stores the models as artifacts in an oci registry,the manifests and blobs are pulled and pushed
+// by go-containerregistry which also handles the authentication of the registry
+type OCIRegistryClient struct {
+	// Registry is the host of the oci registry,eg: registry.example.com
+	Registry string
+	// RepositoryPrefix is the prefix of the repositories which store the models
+	RepositoryPrefix string
+
+	registry name.Registry
+	auth     authn.Authenticator
+	// puller and pusher reuse the authenticated transport of each repository,so the bearer token of a scope
+	// is requested once and requested again when the registry rejects it
+	puller *remote.Puller
+	pusher *remote.Pusher
+}
+
+// NewOCIRegistryClient creates an oci model registry client,the address is like https://registry.example.com,
+// https is used if the scheme is not specified
+func NewOCIRegistryClient(address, repositoryPrefix, username, password string) (*OCIRegistryClient, error) {
+	if !strings.Contains(address, "://") {
+		address = "https://" + address
+	}
+	baseUrl, err := url.Parse(strings.TrimSuffix(address, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid oci registry address %v: %v", address, err)
+	}
+	repositoryPrefix = strings.Trim(repositoryPrefix, "/")
+	if repositoryPrefix != "" {
+		for _, component := range strings.Split(repositoryPrefix, "/") {
+			if !ociRepositoryComponentRegexp.MatchString(component) {
+				return nil, fmt.Errorf("invalid oci repository prefix %v", repositoryPrefix)
+			}
+		}
+	}
+	nameOptions := []name.Option{}
+	if baseUrl.Scheme == "http" {
+		nameOptions = append(nameOptions, name.Insecure)
+	}
+	registry, err := name.NewRegistry(baseUrl.Host, nameOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("invalid oci registry address %v: %v", address, err)
+	}
+	auth := authn.Anonymous
+	if username != "" && password != "" {
+		auth = &authn.Basic{Username: username, Password: password}
+	}
+	puller, err := remote.NewPuller(remote.WithAuth(auth))
+	if err != nil {
+		return nil, err
+	}
+	pusher, err := remote.NewPusher(remote.WithAuth(auth))
+	if err != nil {
+		return nil, err
+	}
+	return &OCIRegistryClient{
+		Registry:         baseUrl.Host,
+		RepositoryPrefix: repositoryPrefix,
+		registry:         registry,
+		auth:             auth,
+		puller:           puller,
+		pusher:           pusher,
+	}, nil
+}
+
+func (c *OCIRegistryClient) Type() types.ModelRegistryType {
+	return types.OCIModelRegistry
+}
+
+// CheckHealth pings the /v2/ api of the registry and authenticates with it
+func (c *OCIRegistryClient) CheckHealth() (bool, error) {
+	rt, err := transport.NewWithContext(context.TODO(), c.registry, c.auth, http.DefaultTransport, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to check whether oci registry %v is healthy: %v", c.Registry, err)
+	}
+	resp, err := (&http.Client{Transport: rt}).Get(fmt.Sprintf("%v://%v/v2/", c.registry.Scheme(), c.registry.RegistryStr()))
+	if err != nil {
+		return false, fmt.Errorf("failed to check whether oci registry %v is healthy: %v", c.Registry, err)
+	}
+	defer resp.Body.Close()
+	if err := transport.CheckError(resp, http.StatusOK); err != nil {
+		return false, fmt.Errorf("failed to check whether oci registry %v is healthy: %v", c.Registry, err)
+	}
+	return true, nil
+}
+
+func (c *OCIRegistryClient) CreateRegisteredModel(name string, tags []*types.RegisteredModelTag, description string) (*types.RegisteredModel, error) {
+	repository, err := c.repository(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create registered model \"%v\": %v", name, err)
+	}
+	_, _, err = c.getManifest(repository, ociRegisteredModelTag)
+	if err == nil {
+		return nil, fmt.Errorf("failed to create registered model \"%v\": %v: registered model already exists", name, RESOURCE_ALREADY_EXISTS_ERROR)
+	}
+	if !strings.Contains(err.Error(), RESOURCE_DOES_NOT_EXIST_ERROR) {
+		return nil, fmt.Errorf("failed to create registered model \"%v\": %v", name, err)
+	}
+	now := strconv.FormatInt(time.Now().UnixMilli(), 10)
+	annotations := map[string]string{
+		ociAnnotationName:                 name,
+		ociAnnotationDescription:          description,
+		ociAnnotationCreationTimestamp:    now,
+		ociAnnotationLastUpdatedTimestamp: now,
+	}
+	if err := setOCITagsAnnotation(annotations, registeredModelTagsToMap(tags)); err != nil {
+		return nil, err
+	}
+	manifest := newOCIManifest(ociRegisteredModelArtifactType, annotations)
+	if _, err := c.putManifest(repository, ociRegisteredModelTag, manifest); err != nil {
+		return nil, fmt.Errorf("failed to create registered model \"%v\": %v", name, err)
+	}
+	log.Debugf("create registered model \"%v\" successfully", name)
+	return registeredModelFromOCIManifest(name, manifest), nil
+}
+
+func (c *OCIRegistryClient) GetRegisteredModel(name string) (*types.RegisteredModel, error) {
+	repository, err := c.repository(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get registered model \"%v\": %v", name, err)
+	}
+	manifest, _, err := c.getManifest(repository, ociRegisteredModelTag)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get registered model \"%v\": %v", name, err)
+	}
+	registeredModel := registeredModelFromOCIManifest(name, manifest)
+	versions, aliases, err := c.listVersionsAndAliases(repository)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get registered model \"%v\": %v", name, err)
+	}
+	if len(versions) > 0 {
+		latest := strconv.Itoa(versions[len(versions)-1])
+		latestVersion, err := c.getModelVersion(repository, name, latest)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get registered model \"%v\": %v", name, err)
+		}
+		registeredModel.LatestVersions = []*types.ModelVersion{latestVersion}
+	}
+	aliasVersions, err := c.resolveAliases(repository, aliases)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get registered model \"%v\": %v", name, err)
+	}
+	for _, alias := range aliases {
+		registeredModel.Aliases = append(registeredModel.Aliases, &types.RegisteredModelAlias{
+			Alias:   alias,
+			Version: aliasVersions[alias],
+		})
+	}
+	log.Debugf("get registered model \"%v\" successfully", name)
+	return registeredModel, nil
+}
+
+// RenameRegisteredModel copies all the manifests to the new repository and deletes the old repository
+func (c *OCIRegistryClient) RenameRegisteredModel(name, newName string) (*types.RegisteredModel, error) {
+	repository, err := c.repository(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rename registered model \"%s\" to \"%s\": %v", name, newName, err)
+	}
+	newRepository, err := c.repository(newName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rename registered model \"%s\" to \"%s\": %v", name, newName, err)
+	}
+	if _, _, err := c.getManifest(newRepository, ociRegisteredModelTag); err == nil {
+		return nil, fmt.Errorf("failed to rename registered model \"%s\" to \"%s\": %v: registered model \"%s\" already exists", name, newName, RESOURCE_ALREADY_EXISTS_ERROR, newName)
+	}
+	tags, err := c.listTags(repository)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rename registered model \"%s\" to \"%s\": %v", name, newName, err)
+	}
+	digests := map[string]bool{}
+	for _, tag := range tags {
+		manifest, digest, err := c.getManifest(repository, tag)
+		if err != nil {
+			return nil, fmt.Errorf("failed to rename registered model \"%s\" to \"%s\": %v", name, newName, err)
+		}
+		digests[digest] = true
+		for _, blob := range append([]ociDescriptor{manifest.Config}, manifest.Layers...) {
+			if err := c.copyBlob(repository, newRepository, blob); err != nil {
+				return nil, fmt.Errorf("failed to rename registered model \"%s\" to \"%s\": %v", name, newName, err)
+			}
+		}
+		manifest.Annotations[ociAnnotationName] = newName
+		if _, err := c.putManifest(newRepository, tag, manifest); err != nil {
+			return nil, fmt.Errorf("failed to rename registered model \"%s\" to \"%s\": %v", name, newName, err)
+		}
+	}
+	for digest := range digests {
+		if err := c.deleteManifest(repository, digest); err != nil {
+			return nil, fmt.Errorf("failed to rename registered model \"%s\" to \"%s\": %v", name, newName, err)
+		}
+	}
+	log.Debugf("rename registered model \"%s\" to \"%s\" successfully", name, newName)
+	return c.GetRegisteredModel(newName)
+}
+
+func (c *OCIRegistryClient) UpdateRegisteredModel(name string, description string) (*types.RegisteredModel, error) {
+	manifest, err := c.updateRegisteredModel(name, func(annotations map[string]string) error {
+		annotations[ociAnnotationDescription] = description
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update registered model \"%s\": %v", name, err)
+	}
+	log.Debugf("update registered model \"%s\" successfully", name)
+	return registeredModelFromOCIManifest(name, manifest), nil
+}
+
+// DeleteRegisteredModel deletes all the manifests of the registered model,
+// the oci distribution api has no way to delete a repository
+func (c *OCIRegistryClient) DeleteRegisteredModel(name string) error {
+	repository, err := c.repository(name)
+	if err != nil {
+		return fmt.Errorf("failed to delete registered model \"%s\": %v", name, err)
+	}
+	if _, _, err := c.getManifest(repository, ociRegisteredModelTag); err != nil {
+		return fmt.Errorf("failed to delete registered model \"%s\": %v", name, err)
+	}
+	tags, err := c.listTags(repository)
+	if err != nil {
+		return fmt.Errorf("failed to delete registered model \"%s\": %v", name, err)
+	}
+	digests := map[string]bool{}
+	for _, tag := range tags {
+		digest, err := c.headManifest(repository, tag)
+		if err != nil {
+			return fmt.Errorf("failed to delete registered model \"%s\": %v", name, err)
+		}
+		digests[digest] = true
+	}
+	for digest := range digests {
+		if err := c.deleteManifest(repository, digest); err != nil {
+			return fmt.Errorf("failed to delete registered model \"%s\": %v", name, err)
+		}
+	}
+	log.Debugf("delete registered model \"%s\" successfully", name)
+	return nil
+}
+
+func (c *OCIRegistryClient) SearchRegisteredModels(filter string, maxResults int, orderBy []string) ([]*types.RegisteredModel, error) {
+	match, err := parseOCINameFilter(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search registered models: %v", err)
+	}
+	names, err := c.listModelNames(match)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search registered models: %v", err)
+	}
+	registeredModels := []*types.RegisteredModel{}
+	for _, name := range names {
+		registeredModel, err := c.GetRegisteredModel(name)
+		if err != nil {
+			if strings.Contains(err.Error(), RESOURCE_DOES_NOT_EXIST_ERROR) {
+				log.Debugf("skip the repository of %v which is not a registered model", name)
+				continue
+			}
+			return nil, fmt.Errorf("failed to search registered models: %v", err)
+		}
+		registeredModels = append(registeredModels, registeredModel)
+	}
+	less, err := ociOrderBy(orderBy, func(i int) (string, int, int64, int64) {
+		rm := registeredModels[i]
+		return rm.Name, 0, rm.CreationTimestamp, rm.LastUpdatedTimestamp
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search registered models: %v", err)
+	}
+	sort.SliceStable(registeredModels, less)
+	if maxResults > 0 && len(registeredModels) > maxResults {
+		registeredModels = registeredModels[:maxResults]
+	}
+	log.Debugf("search registered models successfully")
+	return registeredModels, nil
+}
+
+func (c *OCIRegistryClient) SetRegisteredModelTag(name, key, value string) error {
+	_, err := c.updateRegisteredModel(name, func(annotations map[string]string) error {
+		tags := getOCITagsAnnotation(annotations)
+		tags[key] = value
+		return setOCITagsAnnotation(annotations, tags)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set registered model tag of \"%v\": %v", name, err)
+	}
+	log.Debugf("set registered model tag of \"%v\" successfully", name)
+	return nil
+}
+
+func (c *OCIRegistryClient) DeleteRegisteredModelTag(name, key string) error {
+	_, err := c.updateRegisteredModel(name, func(annotations map[string]string) error {
+		tags := getOCITagsAnnotation(annotations)
+		if _, ok := tags[key]; !ok {
+			return fmt.Errorf("%v: tag \"%v\" not found", RESOURCE_DOES_NOT_EXIST_ERROR, key)
+		}
+		delete(tags, key)
+		return setOCITagsAnnotation(annotations, tags)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete registered model tag of \"%v\": %v", name, err)
+	}
+	log.Debugf("delete registered model tag of \"%v\" successfully", name)
+	return nil
+}
+
+// CreateModelVersion creates the model version whose number is claimed from the registered model
+func (c *OCIRegistryClient) CreateModelVersion(name, source, runId string, tags []*types.ModelVersionTag, runLink, description string) (*types.ModelVersion, error) {
+	if source == "" {
+		return nil, errors.New("model version source must be specified when registering a model version")
+	}
+	repository, err := c.repository(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create model version of model \"%s\": %v", name, err)
+	}
+	for i := 0; i < ociVersionClaimRetries; i++ {
+		modelVersion, err := c.createModelVersion(repository, name, source, runId, tags, runLink, description)
+		if errors.Is(err, errOCIConcurrentRegistration) {
+			log.Debugf("%v,create the model version of model \"%s\" again", err, name)
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to create model version of model \"%s\": %v", name, err)
+		}
+		log.Debugf("create model version of model \"%s\" successfully", name)
+		return modelVersion, nil
+	}
+	return nil, fmt.Errorf("failed to create model version of model \"%s\": %v after %v retries", name, errOCIConcurrentRegistration, ociVersionClaimRetries)
+}
+
+// createModelVersion claims a version number and pushes the manifest of the version,
+// errOCIConcurrentRegistration is returned if the number is taken by a concurrent registration
+func (c *OCIRegistryClient) createModelVersion(repository, name, source, runId string, tags []*types.ModelVersionTag, runLink, description string) (*types.ModelVersion, error) {
+	version, claim, err := c.claimModelVersion(repository)
+	if err != nil {
+		return nil, err
+	}
+	now := strconv.FormatInt(time.Now().UnixMilli(), 10)
+	annotations := map[string]string{
+		ociAnnotationName:                 name,
+		ociAnnotationVersion:              strconv.Itoa(version),
+		ociAnnotationSource:               source,
+		ociAnnotationRunId:                runId,
+		ociAnnotationRunLink:              runLink,
+		ociAnnotationDescription:          description,
+		ociAnnotationCreationTimestamp:    now,
+		ociAnnotationLastUpdatedTimestamp: now,
+		ociAnnotationVersionClaim:         claim,
+	}
+	if err := setOCITagsAnnotation(annotations, modelVersionTagsToMap(tags)); err != nil {
+		return nil, err
+	}
+	manifest := newOCIManifest(ociModelVersionArtifactType, annotations)
+	// the claim makes the manifest unique,so a version pushed by a concurrent registration is not mistaken for this one
+	if _, err := c.headManifest(repository, strconv.Itoa(version)); err == nil {
+		return nil, fmt.Errorf("version %v: %w", version, errOCIConcurrentRegistration)
+	}
+	digest, err := c.putManifest(repository, strconv.Itoa(version), manifest)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkModelVersionClaim(repository, version, claim, digest); err != nil {
+		return nil, err
+	}
+	return modelVersionFromOCIManifest(name, strconv.Itoa(version), manifest), nil
+}
+
+func (c *OCIRegistryClient) GetModelVersion(name, version string) (*types.ModelVersion, error) {
+	repository, err := c.repository(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get model version \"%s/%s\": %v", name, version, err)
+	}
+	modelVersion, err := c.getModelVersion(repository, name, version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get model version \"%s/%s\": %v", name, version, err)
+	}
+	_, aliases, err := c.listVersionsAndAliases(repository)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get model version \"%s/%s\": %v", name, version, err)
+	}
+	aliasVersions, err := c.resolveAliases(repository, aliases)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get model version \"%s/%s\": %v", name, version, err)
+	}
+	for _, alias := range aliases {
+		if aliasVersions[alias] == modelVersion.Version {
+			modelVersion.Aliases = append(modelVersion.Aliases, alias)
+		}
+	}
+	log.Debugf("get model version \"%s/%s\" successfully", name, version)
+	return modelVersion, nil
+}
+
+func (c *OCIRegistryClient) UpdateModelVersion(name string, version string, description string) (*types.ModelVersion, error) {
+	manifest, err := c.updateModelVersion(name, version, func(annotations map[string]string) error {
+		annotations[ociAnnotationDescription] = description
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update model version \"%s/%s\": %v", name, version, err)
+	}
+	log.Debugf("update model version \"%s/%s\" successfully", name, version)
+	return modelVersionFromOCIManifest(name, version, manifest), nil
+}
+
+// DeleteModelVersion deletes the manifest of the model version,the aliases of it are deleted too
+func (c *OCIRegistryClient) DeleteModelVersion(name string, version string) error {
+	repository, err := c.repository(name)
+	if err != nil {
+		return fmt.Errorf("failed to delete model version \"%s/%s\": %v", name, version, err)
+	}
+	if err := validateOCIVersion(version); err != nil {
+		return fmt.Errorf("failed to delete model version \"%s/%s\": %v", name, version, err)
+	}
+	digest, err := c.headManifest(repository, version)
+	if err != nil {
+		return fmt.Errorf("failed to delete model version \"%s/%s\": %v", name, version, err)
+	}
+	if err := c.deleteManifest(repository, digest); err != nil {
+		return fmt.Errorf("failed to delete model version \"%s/%s\": %v", name, version, err)
+	}
+	log.Debugf("delete model version \"%s/%s\" successfully", name, version)
+	return nil
+}
+
+func (c *OCIRegistryClient) SearchModelVersions(filter string, maxResults int, orderBy []string) ([]*types.ModelVersion, error) {
+	match, err := parseOCINameFilter(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search model versions: %v", err)
+	}
+	names := []string{}
+	if m := ociNameFilterRegexp.FindStringSubmatch(filter); m != nil && m[1] == "=" {
+		names = append(names, m[2])
+	} else if names, err = c.listModelNames(match); err != nil {
+		return nil, fmt.Errorf("failed to search model versions: %v", err)
+	}
+	modelVersions := []*types.ModelVersion{}
+	for _, name := range names {
+		repository, err := c.repository(name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search model versions: %v", err)
+		}
+		versions, _, err := c.listVersionsAndAliases(repository)
+		if err != nil {
+			if strings.Contains(err.Error(), RESOURCE_DOES_NOT_EXIST_ERROR) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to search model versions: %v", err)
+		}
+		for _, version := range versions {
+			modelVersion, err := c.getModelVersion(repository, name, strconv.Itoa(version))
+			if err != nil {
+				return nil, fmt.Errorf("failed to search model versions: %v", err)
+			}
+			modelVersions = append(modelVersions, modelVersion)
+		}
+	}
+	less, err := ociOrderBy(orderBy, func(i int) (string, int, int64, int64) {
+		mv := modelVersions[i]
+		version, _ := strconv.Atoi(mv.Version)
+		return mv.Name, version, mv.CreationTimestamp, mv.LastUpdatedTimestamp
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search model versions: %v", err)
+	}
+	sort.SliceStable(modelVersions, less)
+	if maxResults > 0 && len(modelVersions) > maxResults {
+		modelVersions = modelVersions[:maxResults]
+	}
+	log.Debugf("search model versions successfully")
+	return modelVersions, nil
+}
+
+func (c *OCIRegistryClient) SetModelVersionTag(name, version, key, value string) error {
+	_, err := c.updateModelVersion(name, version, func(annotations map[string]string) error {
+		tags := getOCITagsAnnotation(annotations)
+		tags[key] = value
+		return setOCITagsAnnotation(annotations, tags)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set model version tag of \"%v/%v\": %v", name, version, err)
+	}
+	log.Debugf("set model version tag of \"%v/%v\" successfully", name, version)
+	return nil
+}
+
+func (c *OCIRegistryClient) DeleteModelVersionTag(name, version, key string) error {
+	_, err := c.updateModelVersion(name, version, func(annotations map[string]string) error {
+		tags := getOCITagsAnnotation(annotations)
+		if _, ok := tags[key]; !ok {
+			return fmt.Errorf("%v: tag \"%v\" not found", RESOURCE_DOES_NOT_EXIST_ERROR, key)
+		}
+		delete(tags, key)
+		return setOCITagsAnnotation(annotations, tags)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete model version tag of \"%v/%v\": %v", name, version, err)
+	}
+	log.Debugf("delete model version tag of \"%v/%v\" successfully", name, version)
+	return nil
+}
+
+// GetDownloadUri returns the source of the model version
+func (c *OCIRegistryClient) GetDownloadUri(name, version string) (string, error) {
+	repository, err := c.repository(name)
+	if err != nil {
+		return "", fmt.Errorf("failed to get artifacts download uri of \"%v/%v\": %v", name, version, err)
+	}
+	modelVersion, err := c.getModelVersion(repository, name, version)
+	if err != nil {
+		return "", fmt.Errorf("failed to get artifacts download uri of \"%v/%v\": %v", name, version, err)
+	}
+	log.Debugf("get artifacts download uri of \"%v/%v\" successfully", name, version)
+	return modelVersion.Source, nil
+}
+
+// SetRegisteredModelAlias tags the manifest of the model version with the alias
+func (c *OCIRegistryClient) SetRegisteredModelAlias(name, version, alias string) error {
+	repository, err := c.repository(name)
+	if err != nil {
+		return fmt.Errorf("failed to set registered model alias \"%v\" to version \"%v\" of model \"%v\": %v", alias, version, name, err)
+	}
+	if err := validateOCIAlias(alias); err != nil {
+		return fmt.Errorf("failed to set registered model alias \"%v\" to version \"%v\" of model \"%v\": %v", alias, version, name, err)
+	}
+	if err := validateOCIVersion(version); err != nil {
+		return fmt.Errorf("failed to set registered model alias \"%v\" to version \"%v\" of model \"%v\": %v", alias, version, name, err)
+	}
+	manifest, _, err := c.getManifest(repository, version)
+	if err != nil {
+		return fmt.Errorf("failed to set registered model alias \"%v\" to version \"%v\" of model \"%v\": %v", alias, version, name, err)
+	}
+	if _, err := c.putManifest(repository, alias, manifest); err != nil {
+		return fmt.Errorf("failed to set registered model alias \"%v\" to version \"%v\" of model \"%v\": %v", alias, version, name, err)
+	}
+	log.Debugf("set registered model alias \"%v\" to version \"%v\" of model \"%v\" successfully", alias, version, name)
+	return nil
+}
+
+// DeleteRegisteredModelAlias points the alias to a new manifest and deletes the manifest,
+// because the oci distribution api can not delete a tag without deleting the manifest it points to
+func (c *OCIRegistryClient) DeleteRegisteredModelAlias(name, alias string) error {
+	repository, err := c.repository(name)
+	if err != nil {
+		return fmt.Errorf("failed to delete registered model alias \"%v@%v\": %v", name, alias, err)
+	}
+	if err := validateOCIAlias(alias); err != nil {
+		return fmt.Errorf("failed to delete registered model alias \"%v@%v\": %v", name, alias, err)
+	}
+	if _, err := c.headManifest(repository, alias); err != nil {
+		return fmt.Errorf("failed to delete registered model alias \"%v@%v\": %v", name, alias, err)
+	}
+	manifest := newOCIManifest(ociDeletedAliasArtifactType, map[string]string{
+		ociAnnotationAlias:                alias,
+		ociAnnotationLastUpdatedTimestamp: strconv.FormatInt(time.Now().UnixNano(), 10),
+	})
+	digest, err := c.putManifest(repository, alias, manifest)
+	if err != nil {
+		return fmt.Errorf("failed to delete registered model alias \"%v@%v\": %v", name, alias, err)
+	}
+	if err := c.deleteManifest(repository, digest); err != nil {
+		return fmt.Errorf("failed to delete registered model alias \"%v@%v\": %v", name, alias, err)
+	}
+	log.Debugf("delete registered model alias \"%v@%v\" successfully", name, alias)
+	return nil
+}
+
+func (c *OCIRegistryClient) GetModelVersionByAlias(name, alias string) (*types.ModelVersion, error) {
+	repository, err := c.repository(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get model version by alias \"%v@%v\": %v", name, alias, err)
+	}
+	if err := validateOCIAlias(alias); err != nil {
+		return nil, fmt.Errorf("failed to get model version by alias \"%v@%v\": %v", name, alias, err)
+	}
+	manifest, _, err := c.getManifest(repository, alias)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get model version by alias \"%v@%v\": %v", name, alias, err)
+	}
+	if manifest.ArtifactType != ociModelVersionArtifactType {
+		return nil, fmt.Errorf("failed to get model version by alias \"%v@%v\": the alias is not a model version", name, alias)
+	}
+	modelVersion := modelVersionFromOCIManifest(name, manifest.Annotations[ociAnnotationVersion], manifest)
+	log.Debugf("get model version by alias \"%v@%v\" successfully", name, alias)
+	return modelVersion, nil
+}
+
+func (c *OCIRegistryClient) CreateRegisteredModelAndModelVersion(
+	name string,
+	description string,
+	tags []*types.RegisteredModelTag,
+	version string,
+	versionDescription string,
+	versionTags []*types.ModelVersionTag,
+	source string,
+	runLink string,
+) (*types.RegisteredModel, *types.ModelVersion, error) {
+	return createRegisteredModelAndModelVersion(c, name, description, tags, version, versionDescription, versionTags, source, runLink)
+}
+
+// repository returns the repository of the registered model
+func (c *OCIRegistryClient) repository(name string) (string, error) {
+	if !ociRepositoryComponentRegexp.MatchString(name) {
+		return "", fmt.Errorf("invalid model name \"%v\" for the oci registry,it should only contain lowercase letters,digits and separators(.,_,-)", name)
+	}
+	if c.RepositoryPrefix == "" {
+		return name, nil
+	}
+	return path.Join(c.RepositoryPrefix, name), nil
+}
+
+func (c *OCIRegistryClient) getModelVersion(repository, name, version string) (*types.ModelVersion, error) {
+	if err := validateOCIVersion(version); err != nil {
+		return nil, err
+	}
+	manifest, _, err := c.getManifest(repository, version)
+	if err != nil {
+		return nil, err
+	}
+	return modelVersionFromOCIManifest(name, version, manifest), nil
+}
+
+func (c *OCIRegistryClient) updateRegisteredModel(name string, update func(annotations map[string]string) error) (*ociManifest, error) {
+	repository, err := c.repository(name)
+	if err != nil {
+		return nil, err
+	}
+	manifest, _, err := c.getManifest(repository, ociRegisteredModelTag)
+	if err != nil {
+		return nil, err
+	}
+	if err := update(manifest.Annotations); err != nil {
+		return nil, err
+	}
+	manifest.Annotations[ociAnnotationLastUpdatedTimestamp] = strconv.FormatInt(time.Now().UnixMilli(), 10)
+	if _, err := c.putManifest(repository, ociRegisteredModelTag, manifest); err != nil {
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// updateModelVersion updates the manifest of the model version and moves its aliases to the new manifest
+func (c *OCIRegistryClient) updateModelVersion(name, version string, update func(annotations map[string]string) error) (*ociManifest, error) {
+	repository, err := c.repository(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateOCIVersion(version); err != nil {
+		return nil, err
+	}
+	manifest, _, err := c.getManifest(repository, version)
+	if err != nil {
+		return nil, err
+	}
+	_, aliases, err := c.listVersionsAndAliases(repository)
+	if err != nil {
+		return nil, err
+	}
+	aliasVersions, err := c.resolveAliases(repository, aliases)
+	if err != nil {
+		return nil, err
+	}
+	if err := update(manifest.Annotations); err != nil {
+		return nil, err
+	}
+	manifest.Annotations[ociAnnotationLastUpdatedTimestamp] = strconv.FormatInt(time.Now().UnixMilli(), 10)
+	if _, err := c.putManifest(repository, version, manifest); err != nil {
+		return nil, err
+	}
+	for _, alias := range aliases {
+		if aliasVersions[alias] != version {
+			continue
+		}
+		if _, err := c.putManifest(repository, alias, manifest); err != nil {
+			return nil, err
+		}
+	}
+	return manifest, nil
+}
+
+// claimModelVersion increases the next version number kept in the manifest of the registered model and
+// returns the claimed number and the claim.
+//
+// The claim is best-effort. The oci distribution api has no compare-and-swap,so the digest of the manifest
+// is checked before and after pushing it,and the number is claimed again if another registration changes it.
+// Two registrations which both push between the checks may still claim the same number. checkModelVersionClaim
+// detects most of them after the version is pushed,but the later push of the version tag silently wins if both
+// registrations pass the check. Immutable tags can not close the race,because the version tags and the tag of
+// the registered model are pushed again when their metadata is updated.
+func (c *OCIRegistryClient) claimModelVersion(repository string) (int, string, error) {
+	for i := 0; i < ociVersionClaimRetries; i++ {
+		manifest, digest, err := c.getManifest(repository, ociRegisteredModelTag)
+		if err != nil {
+			return 0, "", err
+		}
+		// the registered models created before the counter start from the largest version number plus one
+		versions, _, err := c.listVersionsAndAliases(repository)
+		if err != nil {
+			return 0, "", err
+		}
+		version := 1
+		if len(versions) > 0 {
+			version = versions[len(versions)-1] + 1
+		}
+		if next, err := strconv.Atoi(manifest.Annotations[ociAnnotationNextVersion]); err == nil && next > version {
+			version = next
+		}
+		manifest.Annotations[ociAnnotationNextVersion] = strconv.Itoa(version + 1)
+		claim := strconv.FormatInt(time.Now().UnixNano(), 10)
+		manifest.Annotations[ociAnnotationVersionClaim] = claim
+		if current, err := c.headManifest(repository, ociRegisteredModelTag); err != nil {
+			return 0, "", err
+		} else if current != digest {
+			log.Debugf("the registered model %v is changed,claim the version number again", repository)
+			continue
+		}
+		claimed, err := c.putManifest(repository, ociRegisteredModelTag, manifest)
+		if err != nil {
+			return 0, "", err
+		}
+		if current, err := c.headManifest(repository, ociRegisteredModelTag); err != nil {
+			return 0, "", err
+		} else if current != claimed {
+			log.Debugf("the claim of version %v of %v is overwritten,claim the version number again", version, repository)
+			continue
+		}
+		if _, err := c.headManifest(repository, strconv.Itoa(version)); err == nil {
+			continue
+		} else if !strings.Contains(err.Error(), RESOURCE_DOES_NOT_EXIST_ERROR) {
+			return 0, "", err
+		}
+		return version, claim, nil
+	}
+	return 0, "", fmt.Errorf("failed to claim a version number after %v retries,the registered model is updated concurrently", ociVersionClaimRetries)
+}
+
+// checkModelVersionClaim checks the version is not claimed or overwritten by a concurrent registration after pushing it,
+// the pushed manifest is deleted if the claim is lost
+func (c *OCIRegistryClient) checkModelVersionClaim(repository string, version int, claim, digest string) error {
+	manifest, _, err := c.getManifest(repository, ociRegisteredModelTag)
+	if err != nil {
+		return err
+	}
+	// the next version is larger if a later registration has claimed its number after this claim
+	if manifest.Annotations[ociAnnotationNextVersion] == strconv.Itoa(version+1) && manifest.Annotations[ociAnnotationVersionClaim] != claim {
+		if current, err := c.headManifest(repository, strconv.Itoa(version)); err == nil && current == digest {
+			if err := c.deleteManifest(repository, digest); err != nil {
+				log.Warnf("failed to delete the manifest of version %v whose claim is lost: %v", version, err)
+			}
+		}
+		return fmt.Errorf("version %v: %w", version, errOCIConcurrentRegistration)
+	}
+	if current, err := c.headManifest(repository, strconv.Itoa(version)); err != nil || current != digest {
+		return fmt.Errorf("version %v: %w", version, errOCIConcurrentRegistration)
+	}
+	return nil
+}
+
+// listModelNames returns the names of the repositories under the repository prefix which match the filter
+func (c *OCIRegistryClient) listModelNames(match func(string) bool) ([]string, error) {
+	repositories, err := c.listRepositories()
+	if err != nil {
+		return nil, err
+	}
+	prefix := ""
+	if c.RepositoryPrefix != "" {
+		prefix = c.RepositoryPrefix + "/"
+	}
+	names := []string{}
+	for _, repository := range repositories {
+		if !strings.HasPrefix(repository, prefix) {
+			continue
+		}
+		name := strings.TrimPrefix(repository, prefix)
+		if strings.Contains(name, "/") || !match(name) {
+			continue
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// listVersionsAndAliases returns the sorted version numbers and the aliases of a registered model
+func (c *OCIRegistryClient) listVersionsAndAliases(repository string) ([]int, []string, error) {
+	tags, err := c.listTags(repository)
+	if err != nil {
+		return nil, nil, err
+	}
+	versions := []int{}
+	aliases := []string{}
+	for _, tag := range tags {
+		if tag == ociRegisteredModelTag {
+			continue
+		}
+		if version, err := strconv.Atoi(tag); err == nil {
+			versions = append(versions, version)
+			continue
+		}
+		aliases = append(aliases, tag)
+	}
+	sort.Ints(versions)
+	sort.Strings(aliases)
+	return versions, aliases, nil
+}
+
+// resolveAliases returns the versions which the aliases point to
+func (c *OCIRegistryClient) resolveAliases(repository string, aliases []string) (map[string]string, error) {
+	aliasVersions := map[string]string{}
+	for _, alias := range aliases {
+		manifest, _, err := c.getManifest(repository, alias)
+		if err != nil {
+			return nil, err
+		}
+		aliasVersions[alias] = manifest.Annotations[ociAnnotationVersion]
+	}
+	return aliasVersions, nil
+}
+func (c *OCIRegistryClient) listRepositories() ([]string, error) {
+	repositories, err := c.puller.Catalog(context.TODO(), c.registry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list repositories,the catalog api may be disabled by the registry: %v", ociResponseError(err))
+	}
+	return repositories, nil
+}
+
+func (c *OCIRegistryClient) listTags(repository string) ([]string, error) {
+	tags, err := c.puller.List(context.TODO(), c.registry.Repo(repository))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags of %v: %v", repository, ociResponseError(err))
+	}
+	return tags, nil
+}
+
+func (c *OCIRegistryClient) getManifest(repository, reference string) (*ociManifest, string, error) {
+	desc, err := c.puller.Get(context.TODO(), c.reference(repository, reference))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get manifest %v:%v: %v", repository, reference, ociResponseError(err))
+	}
+	manifest := &ociManifest{}
+	if err := json.Unmarshal(desc.Manifest, manifest); err != nil {
+		return nil, "", fmt.Errorf("failed to parse manifest %v:%v: %v", repository, reference, err)
+	}
+	if manifest.Annotations == nil {
+		manifest.Annotations = map[string]string{}
+	}
+	return manifest, desc.Digest.String(), nil
+}
+
+func (c *OCIRegistryClient) headManifest(repository, reference string) (string, error) {
+	desc, err := c.puller.Head(context.TODO(), c.reference(repository, reference))
+	if err == nil {
+		return desc.Digest.String(), nil
+	}
+	if isOCINotFound(err) {
+		return "", fmt.Errorf("failed to get manifest %v:%v: %v", repository, reference, ociResponseError(err))
+	}
+	// some registries do not return the digest of the manifest with HEAD
+	log.Debugf("failed to get the digest of manifest %v:%v by HEAD,get the manifest instead: %v", repository, reference, err)
+	_, digest, err := c.getManifest(repository, reference)
+	return digest, err
+}
+
+// putManifest uploads the config blob and pushes the manifest,it returns the digest of the manifest
+func (c *OCIRegistryClient) putManifest(repository, reference string, manifest *ociManifest) (string, error) {
+	if manifest.Config.Digest == ociDigest(ociEmptyBlob) {
+		if err := c.pusher.Upload(context.TODO(), c.registry.Repo(repository), static.NewLayer(ociEmptyBlob, ociEmptyMediaType)); err != nil {
+			return "", fmt.Errorf("failed to upload blob %v@%v: %v", repository, manifest.Config.Digest, err)
+		}
+	}
+	body, err := json.Marshal(manifest)
+	if err != nil {
+		return "", err
+	}
+	if err := c.pusher.Push(context.TODO(), c.reference(repository, reference), ociRawManifest(body)); err != nil {
+		return "", fmt.Errorf("failed to push manifest %v:%v: %v", repository, reference, err)
+	}
+	return ociDigest(body), nil
+}
+
+func (c *OCIRegistryClient) deleteManifest(repository, digest string) error {
+	if err := c.pusher.Delete(context.TODO(), c.registry.Repo(repository).Digest(digest)); err != nil {
+		return fmt.Errorf("failed to delete manifest %v@%v: %v", repository, digest, ociResponseError(err))
+	}
+	return nil
+}
+
+// copyBlob copies the blob to another repository,the blob is mounted from the repository if the registry supports it
+func (c *OCIRegistryClient) copyBlob(from, to string, blob ociDescriptor) error {
+	layer, err := c.puller.Layer(context.TODO(), c.registry.Repo(from).Digest(blob.Digest))
+	if err != nil {
+		return fmt.Errorf("failed to get blob %v@%v: %v", from, blob.Digest, ociResponseError(err))
+	}
+	if err := c.pusher.Upload(context.TODO(), c.registry.Repo(to), layer); err != nil {
+		return fmt.Errorf("failed to copy blob %v from %v to %v: %v", blob.Digest, from, to, err)
+	}
+	return nil
+}
+
+// reference returns the tag or the digest in the repository
+func (c *OCIRegistryClient) reference(repository, reference string) name.Reference {
+	if strings.HasPrefix(reference, "sha256:") {
+		return c.registry.Repo(repository).Digest(reference)
+	}
+	return c.registry.Repo(repository).Tag(reference)
+}
+
+// ociRawManifest is the content of a manifest which is pushed as it is
+type ociRawManifest []byte
+
+func (m ociRawManifest) RawManifest() ([]byte, error) {
+	return m, nil
+}
+
+func (m ociRawManifest) MediaType() (ggcrtypes.MediaType, error) {
+	return ociManifestMediaType, nil
+}
+
+// ociResponseError returns the error of the response,the error contains RESOURCE_DOES_NOT_EXIST_ERROR if the status is 404
+func ociResponseError(err error) error {
+	if isOCINotFound(err) {
+		return fmt.Errorf("%v: %v", err, RESOURCE_DOES_NOT_EXIST_ERROR)
+	}
+	return err
+}
+
+func isOCINotFound(err error) bool {
+	var terr *transport.Error
+	return errors.As(err, &terr) && terr.StatusCode == http.StatusNotFound
+}
+
+func newOCIManifest(artifactType string, annotations map[string]string) *ociManifest {
+	empty := ociDescriptor{
+		MediaType: ociEmptyMediaType,
+		Digest:    ociDigest(ociEmptyBlob),
+		Size:      int64(len(ociEmptyBlob)),
+	}
+	return &ociManifest{
+		SchemaVersion: 2,
+		MediaType:     ociManifestMediaType,
+		ArtifactType:  artifactType,
+		Config:        empty,
+		Layers:        []ociDescriptor{empty},
+		Annotations:   annotations,
+	}
+}
+
+func registeredModelFromOCIManifest(name string, manifest *ociManifest) *types.RegisteredModel {
+	registeredModel := &types.RegisteredModel{
+		Name:                 name,
+		Description:          manifest.Annotations[ociAnnotationDescription],
+		CreationTimestamp:    getOCITimestampAnnotation(manifest.Annotations, ociAnnotationCreationTimestamp),
+		LastUpdatedTimestamp: getOCITimestampAnnotation(manifest.Annotations, ociAnnotationLastUpdatedTimestamp),
+	}
+	tags := getOCITagsAnnotation(manifest.Annotations)
+	for _, key := range sortedKeys(tags) {
+		registeredModel.Tags = append(registeredModel.Tags, &types.RegisteredModelTag{Key: key, Value: tags[key]})
+	}
+	return registeredModel
+}
+
+func modelVersionFromOCIManifest(name, version string, manifest *ociManifest) *types.ModelVersion {
+	modelVersion := &types.ModelVersion{
+		Name:                 name,
+		Version:              version,
+		Description:          manifest.Annotations[ociAnnotationDescription],
+		Source:               manifest.Annotations[ociAnnotationSource],
+		RunId:                manifest.Annotations[ociAnnotationRunId],
+		RunLink:              manifest.Annotations[ociAnnotationRunLink],
+		Status:               types.READY,
+		CreationTimestamp:    getOCITimestampAnnotation(manifest.Annotations, ociAnnotationCreationTimestamp),
+		LastUpdatedTimestamp: getOCITimestampAnnotation(manifest.Annotations, ociAnnotationLastUpdatedTimestamp),
+	}
+	tags := getOCITagsAnnotation(manifest.Annotations)
+	for _, key := range sortedKeys(tags) {
+		modelVersion.Tags = append(modelVersion.Tags, &types.ModelVersionTag{Key: key, Value: tags[key]})
+	}
+	return modelVersion
+}
+
+func getOCITimestampAnnotation(annotations map[string]string, key string) int64 {
+	timestamp, err := strconv.ParseInt(annotations[key], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return timestamp
+}
+
+func getOCITagsAnnotation(annotations map[string]string) map[string]string {
+	tags := map[string]string{}
+	if annotations[ociAnnotationTags] == "" {
+		return tags
+	}
+	if err := json.Unmarshal([]byte(annotations[ociAnnotationTags]), &tags); err != nil {
+		log.Debugf("failed to parse the model tags %v: %v", annotations[ociAnnotationTags], err)
+	}
+	return tags
+}
+
+func setOCITagsAnnotation(annotations map[string]string, tags map[string]string) error {
+	if len(tags) == 0 {
+		delete(annotations, ociAnnotationTags)
+		return nil
+	}
+	data, err := json.Marshal(tags)
+	if err != nil {
+		return err
+	}
+	annotations[ociAnnotationTags] = string(data)
+	return nil
+}
+
+func registeredModelTagsToMap(tags []*types.RegisteredModelTag) map[string]string {
+	m := map[string]string{}
+	for _, tag := range tags {
+		m[tag.Key] = tag.Value
+	}
+	return m
+}
+
+func modelVersionTagsToMap(tags []*types.ModelVersionTag) map[string]string {
+	m := map[string]string{}
+	for _, tag := range tags {
+		m[tag.Key] = tag.Value
+	}
+	return m
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := []string{}
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func validateOCIVersion(version string) error {
+	if _, err := strconv.Atoi(version); err != nil {
+		return fmt.Errorf("invalid model version \"%v\",it should be a number", version)
+	}
+	return nil
+}
+
+func validateOCIAlias(alias string) error {
+	if !ociTagRegexp.MatchString(alias) || alias == ociRegisteredModelTag {
+		return fmt.Errorf("invalid alias \"%v\",it should match %v", alias, ociTagRegexp.String())
+	}
+	if _, err := strconv.Atoi(alias); err == nil {
+		return fmt.Errorf("invalid alias \"%v\",it should not be a number", alias)
+	}
+	return nil
+}
+
+func ociDigest(content []byte) string {
+	sum := sha256.Sum256(content)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// parseOCINameFilter parses the filter name='NAME' or name LIKE 'PATTERN',
+// the % in the pattern matches any characters and the _ matches a single character
+func parseOCINameFilter(filter string) (func(string) bool, error) {
+	if strings.TrimSpace(filter) == "" {
+		return func(string) bool { return true }, nil
+	}
+	m := ociNameFilterRegexp.FindStringSubmatch(filter)
+	if m == nil {
+		return nil, fmt.Errorf("unsupported filter \"%v\",the oci registry only supports name='NAME' and name LIKE 'PATTERN'", filter)
+	}
+	if m[1] == "=" {
+		return func(name string) bool { return name == m[2] }, nil
+	}
+	pattern := regexp.QuoteMeta(m[2])
+	pattern = strings.ReplaceAll(pattern, "%", ".*")
+	pattern = strings.ReplaceAll(pattern, "_", ".")
+	if strings.EqualFold(m[1], "ilike") {
+		pattern = "(?i)" + pattern
+	}
+	re, err := regexp.Compile("^" + pattern + "$")
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter \"%v\": %v", filter, err)
+	}
+	return re.MatchString, nil
+}
+
+// ociOrderBy returns the less function of the order by clauses,like: ["name ASC", "version_number DESC"],
+// the items are ordered by name and version number if no clause is specified
+func ociOrderBy(orderBy []string, keys func(i int) (string, int, int64, int64)) (func(i, j int) bool, error) {
+	type clause struct {
+		key  string
+		desc bool
+	}
+	clauses := []clause{}
+	for _, c := range orderBy {
+		fields := strings.Fields(c)
+		if len(fields) == 0 || len(fields) > 2 {
+			return nil, fmt.Errorf("invalid order by clause \"%v\"", c)
+		}
+		switch fields[0] {
+		case "name", "version_number", "creation_timestamp", "last_updated_timestamp":
+		default:
+			return nil, fmt.Errorf("unsupported order by key \"%v\"", fields[0])
+		}
+		desc := len(fields) == 2 && strings.EqualFold(fields[1], "DESC")
+		clauses = append(clauses, clause{key: fields[0], desc: desc})
+	}
+	if len(clauses) == 0 {
+		clauses = []clause{{key: "name"}, {key: "version_number"}}
+	}
+	return func(i, j int) bool {
+		ni, vi, ci, ui := keys(i)
+		nj, vj, cj, uj := keys(j)
+		for _, c := range clauses {
+			var less, greater bool
+			switch c.key {
+			case "name":
+				less, greater = ni < nj, ni > nj
+			case "version_number":
+				less, greater = vi < vj, vi > vj
+			case "creation_timestamp":
+				less, greater = ci < cj, ci > cj
+			case "last_updated_timestamp":
+				less, greater = ui < uj, ui > uj
+			}
+			if c.desc {
+				less, greater = greater, less
+			}
+			if less || greater {
+				return less
+			}
+		}
+		return false
+	}, nil
+}
@@ -0,0 +1,575 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeOCIRegistry is an in-memory registry which implements the parts of the oci distribution api used by OCIRegistryClient,
+// the requests must carry a bearer token granting their scopes if requireToken is set
+type fakeOCIRegistry struct {
+	mu        sync.Mutex
+	manifests map[string]map[string][]byte
+	tags      map[string]map[string]string
+	blobs     map[string]map[string][]byte
+	uploads   map[string][]byte
+
+	requireToken bool
+	// tokens stores the scopes granted by the valid tokens
+	tokens map[string][]string
+	// tokenRequests counts the token requests of the scopes
+	tokenRequests map[string]int
+	server        *httptest.Server
+}
+
+func newFakeOCIRegistry(t *testing.T) *fakeOCIRegistry {
+	r := &fakeOCIRegistry{
+		manifests:     map[string]map[string][]byte{},
+		tags:          map[string]map[string]string{},
+		blobs:         map[string]map[string][]byte{},
+		uploads:       map[string][]byte{},
+		tokens:        map[string][]string{},
+		tokenRequests: map[string]int{},
+	}
+	r.server = httptest.NewServer(r)
+	t.Cleanup(r.server.Close)
+	return r
+}
+
+func newFakeOCIRegistryClient(t *testing.T, r *fakeOCIRegistry) *OCIRegistryClient {
+	client, err := NewOCIRegistryClient(r.server.URL, "models", "user", "password")
+	if err != nil {
+		t.Fatalf("failed to create oci registry client: %v", err)
+	}
+	return client
+}
+
+func (r *fakeOCIRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if req.URL.Path == "/token" {
+		r.serveToken(w, req)
+		return
+	}
+	path := strings.TrimPrefix(req.URL.Path, "/v2/")
+	repository, kind, reference := path, "", ""
+	for _, sep := range []string{"/manifests/", "/blobs/uploads/", "/blobs/", "/tags/"} {
+		if i := strings.LastIndex(path, sep); i >= 0 {
+			repository, kind, reference = path[:i], strings.Trim(sep, "/"), path[i+len(sep):]
+			break
+		}
+	}
+	if r.requireToken && !r.authorized(w, req, repository, kind) {
+		return
+	}
+	switch {
+	case req.URL.Path == "/v2/":
+		w.WriteHeader(http.StatusOK)
+	case req.URL.Path == "/v2/_catalog":
+		repositories := []string{}
+		for repository, tags := range r.tags {
+			if len(tags) > 0 {
+				repositories = append(repositories, repository)
+			}
+		}
+		sort.Strings(repositories)
+		writeFakeOCIJSON(w, map[string][]string{"repositories": repositories})
+	case kind == "manifests":
+		r.serveManifest(w, req, repository, reference)
+	case kind == "tags":
+		tags := []string{}
+		for tag := range r.tags[repository] {
+			tags = append(tags, tag)
+		}
+		if len(tags) == 0 {
+			writeFakeOCIError(w, http.StatusNotFound, "NAME_UNKNOWN")
+			return
+		}
+		sort.Strings(tags)
+		writeFakeOCIJSON(w, map[string]interface{}{"name": repository, "tags": tags})
+	case kind == "blobs/uploads":
+		r.serveBlobUpload(w, req, repository, reference)
+	case kind == "blobs":
+		content, ok := r.blobs[repository][reference]
+		if !ok {
+			writeFakeOCIError(w, http.StatusNotFound, "BLOB_UNKNOWN")
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+		w.Header().Set("Docker-Content-Digest", reference)
+		w.Write(content)
+	default:
+		writeFakeOCIError(w, http.StatusNotFound, "UNSUPPORTED")
+	}
+}
+
+// authorized checks the bearer token of the request grants its scope,the registry responds with a bearer challenge if not
+func (r *fakeOCIRegistry) authorized(w http.ResponseWriter, req *http.Request, repository, kind string) bool {
+	scope := ""
+	switch {
+	case req.URL.Path == "/v2/":
+	case req.URL.Path == "/v2/_catalog":
+		scope = "registry:catalog:*"
+	case req.Method == http.MethodGet || req.Method == http.MethodHead:
+		scope = fmt.Sprintf("repository:%v:pull", repository)
+	default:
+		scope = fmt.Sprintf("repository:%v:push,pull", repository)
+	}
+	token := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+	if scopes, ok := r.tokens[token]; ok && grantsFakeOCIScope(scopes, scope) {
+		return true
+	}
+	challenge := fmt.Sprintf(`Bearer realm="%v/token",service="fake"`, r.server.URL)
+	if scope != "" {
+		challenge += fmt.Sprintf(`,scope="%v"`, scope)
+	}
+	w.Header().Set("WWW-Authenticate", challenge)
+	writeFakeOCIError(w, http.StatusUnauthorized, "UNAUTHORIZED")
+	return false
+}
+
+// grantsFakeOCIScope returns true if the scopes grant all the actions of the scope
+func grantsFakeOCIScope(scopes []string, scope string) bool {
+	if scope == "" {
+		return true
+	}
+	i := strings.LastIndex(scope, ":")
+	granted := map[string]bool{}
+	for _, s := range scopes {
+		j := strings.LastIndex(s, ":")
+		if j >= 0 && s[:j] == scope[:i] {
+			for _, action := range strings.Split(s[j+1:], ",") {
+				granted[action] = true
+			}
+		}
+	}
+	for _, action := range strings.Split(scope[i+1:], ",") {
+		if !granted[action] {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *fakeOCIRegistry) serveBlobUpload(w http.ResponseWriter, req *http.Request, repository, id string) {
+	switch req.Method {
+	case http.MethodPost:
+		id = strconv.Itoa(len(r.uploads) + 1)
+		r.uploads[id] = []byte{}
+	case http.MethodPatch:
+		content, _ := io.ReadAll(req.Body)
+		r.uploads[id] = append(r.uploads[id], content...)
+	case http.MethodPut:
+		content, _ := io.ReadAll(req.Body)
+		content = append(r.uploads[id], content...)
+		if ociDigest(content) != req.URL.Query().Get("digest") {
+			writeFakeOCIError(w, http.StatusBadRequest, "DIGEST_INVALID")
+			return
+		}
+		if r.blobs[repository] == nil {
+			r.blobs[repository] = map[string][]byte{}
+		}
+		r.blobs[repository][ociDigest(content)] = content
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+	w.Header().Set("Location", fmt.Sprintf("/v2/%v/blobs/uploads/%v", repository, id))
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (r *fakeOCIRegistry) serveManifest(w http.ResponseWriter, req *http.Request, repository, reference string) {
+	digest := reference
+	if !strings.HasPrefix(reference, "sha256:") {
+		digest = r.tags[repository][reference]
+	}
+	switch req.Method {
+	case http.MethodGet, http.MethodHead:
+		content, ok := r.manifests[repository][digest]
+		if !ok {
+			writeFakeOCIError(w, http.StatusNotFound, "MANIFEST_UNKNOWN")
+			return
+		}
+		w.Header().Set("Content-Type", ociManifestMediaType)
+		w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+		w.Header().Set("Docker-Content-Digest", digest)
+		w.Write(content)
+	case http.MethodPut:
+		content, _ := io.ReadAll(req.Body)
+		manifest := &ociManifest{}
+		if err := json.Unmarshal(content, manifest); err != nil {
+			writeFakeOCIError(w, http.StatusBadRequest, "MANIFEST_INVALID")
+			return
+		}
+		if _, ok := r.blobs[repository][manifest.Config.Digest]; !ok {
+			writeFakeOCIError(w, http.StatusBadRequest, "MANIFEST_BLOB_UNKNOWN")
+			return
+		}
+		digest = ociDigest(content)
+		if r.manifests[repository] == nil {
+			r.manifests[repository] = map[string][]byte{}
+			r.tags[repository] = map[string]string{}
+		}
+		r.manifests[repository][digest] = content
+		if !strings.HasPrefix(reference, "sha256:") {
+			r.tags[repository][reference] = digest
+		}
+		w.WriteHeader(http.StatusCreated)
+	case http.MethodDelete:
+		if _, ok := r.manifests[repository][digest]; !ok || digest != reference {
+			writeFakeOCIError(w, http.StatusNotFound, "MANIFEST_UNKNOWN")
+			return
+		}
+		// deleting a manifest deletes all the tags pointing to it
+		delete(r.manifests[repository], digest)
+		for tag, d := range r.tags[repository] {
+			if d == digest {
+				delete(r.tags[repository], tag)
+			}
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}
+}
+
+func (r *fakeOCIRegistry) serveToken(w http.ResponseWriter, req *http.Request) {
+	if username, password, ok := req.BasicAuth(); !ok || username != "user" || password != "password" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	scopes := req.URL.Query()["scope"]
+	r.tokenRequests[strings.Join(scopes, " ")]++
+	token := fmt.Sprintf("token-%d", len(r.tokens)+1)
+	r.tokens[token] = scopes
+	writeFakeOCIJSON(w, map[string]interface{}{"token": token})
+}
+
+// expireTokens makes all the issued tokens invalid
+func (r *fakeOCIRegistry) expireTokens() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for token := range r.tokens {
+		r.tokens[token] = nil
+	}
+}
+
+func writeFakeOCIJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeFakeOCIError(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, `{"errors":[{"code":%q,"message":"fake error"}]}`, code)
+}
+
+func TestOCICreateModelVersion(t *testing.T) {
+	client := newFakeOCIRegistryClient(t, newFakeOCIRegistry(t))
+
+	if _, err := client.CreateModelVersion("mnist", "pvc://default/models/mnist", "", nil, "", ""); err == nil || !strings.Contains(err.Error(), RESOURCE_DOES_NOT_EXIST_ERROR) {
+		t.Fatalf("expected creating a version of a missing model fails with %v, got %v", RESOURCE_DOES_NOT_EXIST_ERROR, err)
+	}
+	if _, err := client.CreateRegisteredModel("mnist", nil, "mnist model"); err != nil {
+		t.Fatalf("failed to create registered model: %v", err)
+	}
+	// the version 10 must follow the version 9 although the tag "10" is listed before "9"
+	for i := 1; i <= 10; i++ {
+		mv, err := client.CreateModelVersion("mnist", fmt.Sprintf("pvc://default/models/mnist/%d", i), "", nil, "http://mlflow/runs/1", "")
+		if err != nil {
+			t.Fatalf("failed to create model version: %v", err)
+		}
+		if mv.Version != fmt.Sprint(i) {
+			t.Fatalf("expected version %d, got %v", i, mv.Version)
+		}
+	}
+	if err := client.DeleteModelVersion("mnist", "5"); err != nil {
+		t.Fatalf("failed to delete model version: %v", err)
+	}
+	mv, err := client.CreateModelVersion("mnist", "pvc://default/models/mnist/11", "", nil, "", "")
+	if err != nil {
+		t.Fatalf("failed to create model version: %v", err)
+	}
+	if mv.Version != "11" {
+		t.Fatalf("expected version 11 after deleting version 5, got %v", mv.Version)
+	}
+
+	mv, err = client.GetModelVersion("mnist", "3")
+	if err != nil {
+		t.Fatalf("failed to get model version: %v", err)
+	}
+	if mv.Source != "pvc://default/models/mnist/3" || mv.RunLink != "http://mlflow/runs/1" {
+		t.Fatalf("unexpected model version: %+v", mv)
+	}
+	rm, err := client.GetRegisteredModel("mnist")
+	if err != nil {
+		t.Fatalf("failed to get registered model: %v", err)
+	}
+	if len(rm.LatestVersions) != 1 || rm.LatestVersions[0].Version != "11" {
+		t.Fatalf("expected the latest version is 11, got %+v", rm.LatestVersions)
+	}
+}
+
+func TestOCICreateModelVersionAfterDeletingLatest(t *testing.T) {
+	registry := newFakeOCIRegistry(t)
+	client := newFakeOCIRegistryClient(t, registry)
+	if _, err := client.CreateRegisteredModel("mnist", nil, ""); err != nil {
+		t.Fatalf("failed to create registered model: %v", err)
+	}
+	for i := 1; i <= 2; i++ {
+		if _, err := client.CreateModelVersion("mnist", "pvc://default/models/mnist", "", nil, "", ""); err != nil {
+			t.Fatalf("failed to create model version: %v", err)
+		}
+	}
+	if err := client.DeleteModelVersion("mnist", "2"); err != nil {
+		t.Fatalf("failed to delete model version: %v", err)
+	}
+	// the number of the deleted latest version must not be reused
+	mv, err := client.CreateModelVersion("mnist", "pvc://default/models/mnist", "", nil, "", "")
+	if err != nil {
+		t.Fatalf("failed to create model version: %v", err)
+	}
+	if mv.Version != "3" {
+		t.Fatalf("expected version 3 after deleting the latest version 2, got %v", mv.Version)
+	}
+	// the counter never goes back below the largest version number,like the registered models created without it
+	manifest, _, err := client.getManifest("models/mnist", ociRegisteredModelTag)
+	if err != nil {
+		t.Fatalf("failed to get the manifest of the registered model: %v", err)
+	}
+	if manifest.Annotations[ociAnnotationNextVersion] != "4" {
+		t.Fatalf("expected the next version 4, got %v", manifest.Annotations[ociAnnotationNextVersion])
+	}
+	manifest.Annotations[ociAnnotationNextVersion] = "2"
+	if _, err := client.putManifest("models/mnist", ociRegisteredModelTag, manifest); err != nil {
+		t.Fatalf("failed to push the manifest of the registered model: %v", err)
+	}
+	if mv, err = client.CreateModelVersion("mnist", "pvc://default/models/mnist", "", nil, "", ""); err != nil || mv.Version != "4" {
+		t.Fatalf("expected version 4 with a stale counter, got %v, %v", mv, err)
+	}
+}
+
+func TestOCIUpdateModelVersionMovesAliases(t *testing.T) {
+	client := newFakeOCIRegistryClient(t, newFakeOCIRegistry(t))
+	if _, err := client.CreateRegisteredModel("mnist", nil, ""); err != nil {
+		t.Fatalf("failed to create registered model: %v", err)
+	}
+	for i := 1; i <= 2; i++ {
+		if _, err := client.CreateModelVersion("mnist", fmt.Sprintf("pvc://default/models/mnist/%d", i), "", nil, "", "old"); err != nil {
+			t.Fatalf("failed to create model version: %v", err)
+		}
+	}
+	for alias, version := range map[string]string{"prod": "1", "champion": "1", "staging": "2"} {
+		if err := client.SetRegisteredModelAlias("mnist", version, alias); err != nil {
+			t.Fatalf("failed to set alias: %v", err)
+		}
+	}
+
+	if _, err := client.UpdateModelVersion("mnist", "1", "new"); err != nil {
+		t.Fatalf("failed to update model version: %v", err)
+	}
+	if err := client.SetModelVersionTag("mnist", "1", "accuracy", "0.99"); err != nil {
+		t.Fatalf("failed to set model version tag: %v", err)
+	}
+	for _, alias := range []string{"prod", "champion"} {
+		mv, err := client.GetModelVersionByAlias("mnist", alias)
+		if err != nil {
+			t.Fatalf("failed to get model version by alias %v: %v", alias, err)
+		}
+		if mv.Version != "1" || mv.Description != "new" || len(mv.Tags) != 1 || mv.Tags[0].Value != "0.99" {
+			t.Fatalf("expected alias %v is moved to the updated version 1, got %+v", alias, mv)
+		}
+	}
+	mv, err := client.GetModelVersionByAlias("mnist", "staging")
+	if err != nil {
+		t.Fatalf("failed to get model version by alias staging: %v", err)
+	}
+	if mv.Version != "2" || mv.Description != "old" {
+		t.Fatalf("expected alias staging still points to version 2, got %+v", mv)
+	}
+	mv, err = client.GetModelVersion("mnist", "1")
+	if err != nil {
+		t.Fatalf("failed to get model version: %v", err)
+	}
+	if !reflect.DeepEqual(mv.Aliases, []string{"champion", "prod"}) {
+		t.Fatalf("expected aliases [champion prod] of version 1, got %v", mv.Aliases)
+	}
+}
+
+func TestOCIDeleteRegisteredModelAlias(t *testing.T) {
+	registry := newFakeOCIRegistry(t)
+	client := newFakeOCIRegistryClient(t, registry)
+	if _, err := client.CreateRegisteredModel("mnist", nil, ""); err != nil {
+		t.Fatalf("failed to create registered model: %v", err)
+	}
+	if _, err := client.CreateModelVersion("mnist", "pvc://default/models/mnist/1", "", nil, "", ""); err != nil {
+		t.Fatalf("failed to create model version: %v", err)
+	}
+	if err := client.SetRegisteredModelAlias("mnist", "1", "prod"); err != nil {
+		t.Fatalf("failed to set alias: %v", err)
+	}
+
+	if err := client.DeleteRegisteredModelAlias("mnist", "prod"); err != nil {
+		t.Fatalf("failed to delete alias: %v", err)
+	}
+	if _, err := client.GetModelVersionByAlias("mnist", "prod"); err == nil || !strings.Contains(err.Error(), RESOURCE_DOES_NOT_EXIST_ERROR) {
+		t.Fatalf("expected the alias is deleted, got %v", err)
+	}
+	// the manifest of the version must not be deleted with the alias
+	mv, err := client.GetModelVersion("mnist", "1")
+	if err != nil {
+		t.Fatalf("failed to get model version: %v", err)
+	}
+	if len(mv.Aliases) != 0 {
+		t.Fatalf("expected no aliases of version 1, got %v", mv.Aliases)
+	}
+	tags := []string{}
+	for tag := range registry.tags["models/mnist"] {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	if !reflect.DeepEqual(tags, []string{"1", ociRegisteredModelTag}) {
+		t.Fatalf("unexpected tags after deleting the alias: %v", tags)
+	}
+	if err := client.DeleteRegisteredModelAlias("mnist", "prod"); err == nil || !strings.Contains(err.Error(), RESOURCE_DOES_NOT_EXIST_ERROR) {
+		t.Fatalf("expected deleting a missing alias fails with %v, got %v", RESOURCE_DOES_NOT_EXIST_ERROR, err)
+	}
+	if err := client.DeleteRegisteredModelAlias("mnist", "1"); err == nil {
+		t.Fatalf("expected deleting a version number as an alias fails")
+	}
+}
+
+func TestParseOCINameFilter(t *testing.T) {
+	tests := []struct {
+		filter    string
+		matched   []string
+		unmatched []string
+		invalid   bool
+	}{
+		{filter: "", matched: []string{"mnist", "bert"}},
+		{filter: "name='mnist'", matched: []string{"mnist"}, unmatched: []string{"mnist-v2", "MNIST"}},
+		{filter: " name = 'mnist' ", matched: []string{"mnist"}},
+		{filter: "name LIKE 'mnist%'", matched: []string{"mnist", "mnist-v2"}, unmatched: []string{"bert", "MNIST-v2"}},
+		{filter: "name like 'mnist_v_'", matched: []string{"mnist-v2", "mnist.v3"}, unmatched: []string{"mnist-v10"}},
+		{filter: "name ILIKE '%Mnist%'", matched: []string{"mnist", "my-mnist-v2"}, unmatched: []string{"bert"}},
+		{filter: "name LIKE 'a.b'", matched: []string{"a.b"}, unmatched: []string{"axb"}},
+		{filter: "tags.framework='tensorflow'", invalid: true},
+		{filter: "name='mnist' AND version_number=1", invalid: true},
+	}
+	for _, test := range tests {
+		match, err := parseOCINameFilter(test.filter)
+		if test.invalid {
+			if err == nil {
+				t.Errorf("expected filter %q is invalid", test.filter)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("failed to parse filter %q: %v", test.filter, err)
+			continue
+		}
+		for _, name := range test.matched {
+			if !match(name) {
+				t.Errorf("expected filter %q matches %q", test.filter, name)
+			}
+		}
+		for _, name := range test.unmatched {
+			if match(name) {
+				t.Errorf("expected filter %q does not match %q", test.filter, name)
+			}
+		}
+	}
+}
+
+func TestOCICreateModelVersionConcurrently(t *testing.T) {
+	client := newFakeOCIRegistryClient(t, newFakeOCIRegistry(t))
+	if _, err := client.CreateRegisteredModel("mnist", nil, ""); err != nil {
+		t.Fatalf("failed to create registered model: %v", err)
+	}
+	var wg sync.WaitGroup
+	versions := make(chan string, 6)
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 3; j++ {
+				mv, err := client.CreateModelVersion("mnist", "pvc://default/models/mnist", "", nil, "", "")
+				if err != nil {
+					t.Errorf("failed to create model version: %v", err)
+					return
+				}
+				versions <- mv.Version
+			}
+		}()
+	}
+	wg.Wait()
+	close(versions)
+	seen := map[string]bool{}
+	for version := range versions {
+		if seen[version] {
+			t.Fatalf("version %v is registered twice", version)
+		}
+		seen[version] = true
+	}
+}
+
+func TestOCIRegistryClientWithBearerToken(t *testing.T) {
+	registry := newFakeOCIRegistry(t)
+	registry.requireToken = true
+	client := newFakeOCIRegistryClient(t, registry)
+	if health, err := client.CheckHealth(); !health || err != nil {
+		t.Fatalf("expected the registry is healthy, got %v, %v", health, err)
+	}
+	if _, err := client.CreateRegisteredModel("mnist", nil, ""); err != nil {
+		t.Fatalf("failed to create registered model: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := client.CreateModelVersion("mnist", "pvc://default/models/mnist", "", nil, "", ""); err != nil {
+			t.Fatalf("failed to create model version: %v", err)
+		}
+	}
+	if _, err := client.SearchModelVersions("", 0, nil); err != nil {
+		t.Fatalf("failed to search model versions: %v", err)
+	}
+	// the health check authenticates without any scope
+	expected := map[string]int{
+		"":                                  1,
+		"repository:models/mnist:pull":      1,
+		"repository:models/mnist:push,pull": 1,
+		"registry:catalog:*":                1,
+	}
+	if !reflect.DeepEqual(registry.tokenRequests, expected) {
+		t.Fatalf("expected the token of each scope is requested once, got %v", registry.tokenRequests)
+	}
+
+	// the token is requested again when the registry rejects it
+	registry.expireTokens()
+	requests := registry.tokenRequests["repository:models/mnist:pull"]
+	if _, err := client.GetModelVersion("mnist", "1"); err != nil {
+		t.Fatalf("failed to get model version with the expired token: %v", err)
+	}
+	if registry.tokenRequests["repository:models/mnist:pull"] != requests+1 {
+		t.Fatalf("expected the expired token is requested again, got %v", registry.tokenRequests)
+	}
+}
@@ -0,0 +1,100 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+var metricThresholdRegexp = regexp.MustCompile(`^\s*([\w./-]+)\s*(>=|<=|==|>|<)\s*([-+.\deE]+)\s*$`)
+
+// ParseMetricThresholds parses the thresholds like accuracy>=0.9,loss<0.1
+func ParseMetricThresholds(thresholds []string) ([]*types.ModelMetricThreshold, error) {
+	result := []*types.ModelMetricThreshold{}
+	for _, threshold := range thresholds {
+		matches := metricThresholdRegexp.FindStringSubmatch(threshold)
+		if matches == nil {
+			return nil, fmt.Errorf("invalid threshold %v,it should be like accuracy>=0.9,the operator is one of [>=, >, <=, <, ==]", threshold)
+		}
+		value, err := strconv.ParseFloat(matches[3], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of threshold %v: %v", threshold, err)
+		}
+		result = append(result, &types.ModelMetricThreshold{
+			Metric:   matches[1],
+			Operator: matches[2],
+			Value:    value,
+		})
+	}
+	return result, nil
+}
+
+// CheckMetricThresholds returns an error if a metric is missing or does not meet its threshold
+func CheckMetricThresholds(metrics map[string]float64, thresholds []*types.ModelMetricThreshold) error {
+	for _, threshold := range thresholds {
+		value, ok := metrics[threshold.Metric]
+		if !ok {
+			return fmt.Errorf("not found the metric %v in the evaluate job", threshold.Metric)
+		}
+		passed := false
+		switch threshold.Operator {
+		case ">=":
+			passed = value >= threshold.Value
+		case ">":
+			passed = value > threshold.Value
+		case "<=":
+			passed = value <= threshold.Value
+		case "<":
+			passed = value < threshold.Value
+		case "==":
+			passed = value == threshold.Value
+		}
+		if !passed {
+			return fmt.Errorf("the metric %v=%v does not meet the threshold %v", threshold.Metric, value, threshold)
+		}
+	}
+	return nil
+}
+
+// DiffModelVersions returns the differences of the description,source and tags between two model versions
+func DiffModelVersions(from, to *types.ModelVersion) []*types.ModelVersionDiff {
+	diffs := []*types.ModelVersionDiff{}
+	if from.Description != to.Description {
+		diffs = append(diffs, &types.ModelVersionDiff{Field: "description", From: from.Description, To: to.Description})
+	}
+	if from.Source != to.Source {
+		diffs = append(diffs, &types.ModelVersionDiff{Field: "source", From: from.Source, To: to.Source})
+	}
+	fromTags := map[string]string{}
+	toTags := map[string]string{}
+	keys := []string{}
+	for _, tag := range from.Tags {
+		fromTags[tag.Key] = tag.Value
+		keys = append(keys, tag.Key)
+	}
+	for _, tag := range to.Tags {
+		toTags[tag.Key] = tag.Value
+		if _, ok := fromTags[tag.Key]; !ok {
+			keys = append(keys, tag.Key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fromValue, inFrom := fromTags[key]
+		toValue, inTo := toTags[key]
+		if inFrom && inTo && fromValue == toValue {
+			continue
+		}
+		if !inFrom {
+			fromValue = "<none>"
+		}
+		if !inTo {
+			toValue = "<none>"
+		}
+		diffs = append(diffs, &types.ModelVersionDiff{Field: "tag:" + key, From: fromValue, To: toValue})
+	}
+	return diffs
+}
@@ -0,0 +1,111 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+// ModelRegistry manages the registered models and their versions,
+// the errors of the missing or existing resources contain RESOURCE_DOES_NOT_EXIST_ERROR or RESOURCE_ALREADY_EXISTS_ERROR
+type ModelRegistry interface {
+	// Type returns the type of the model registry
+	Type() types.ModelRegistryType
+	// CheckHealth returns true if the model registry is healthy
+	CheckHealth() (bool, error)
+
+	CreateRegisteredModel(name string, tags []*types.RegisteredModelTag, description string) (*types.RegisteredModel, error)
+	GetRegisteredModel(name string) (*types.RegisteredModel, error)
+	RenameRegisteredModel(name, newName string) (*types.RegisteredModel, error)
+	UpdateRegisteredModel(name string, description string) (*types.RegisteredModel, error)
+	DeleteRegisteredModel(name string) error
+	// SearchRegisteredModels searches the registered models,the filter supports name='NAME' and name LIKE 'PATTERN'
+	SearchRegisteredModels(filter string, maxResults int, orderBy []string) ([]*types.RegisteredModel, error)
+	SetRegisteredModelTag(name, key, value string) error
+	DeleteRegisteredModelTag(name, key string) error
+
+	CreateModelVersion(name, source, runId string, tags []*types.ModelVersionTag, runLink, description string) (*types.ModelVersion, error)
+	GetModelVersion(name, version string) (*types.ModelVersion, error)
+	UpdateModelVersion(name string, version string, description string) (*types.ModelVersion, error)
+	DeleteModelVersion(name string, version string) error
+	// SearchModelVersions searches the model versions,the filter supports name='NAME' and name LIKE 'PATTERN'
+	SearchModelVersions(filter string, maxResults int, orderBy []string) ([]*types.ModelVersion, error)
+	SetModelVersionTag(name, version, key, value string) error
+	DeleteModelVersionTag(name, version, key string) error
+	// GetDownloadUri returns the uri of the model version artifacts
+	GetDownloadUri(name, version string) (string, error)
+
+	SetRegisteredModelAlias(name, version, alias string) error
+	DeleteRegisteredModelAlias(name, alias string) error
+	GetModelVersionByAlias(name, alias string) (*types.ModelVersion, error)
+
+	// CreateRegisteredModelAndModelVersion creates a model version and its registered model if not exists
+	CreateRegisteredModelAndModelVersion(
+		name string,
+		description string,
+		tags []*types.RegisteredModelTag,
+		version string,
+		versionDescription string,
+		versionTags []*types.ModelVersionTag,
+		source string,
+		runLink string,
+	) (*types.RegisteredModel, *types.ModelVersion, error)
+}
+
+func createRegisteredModelAndModelVersion(
+	registry ModelRegistry,
+	name string,
+	description string,
+	tags []*types.RegisteredModelTag,
+	version string,
+	versionDescription string,
+	versionTags []*types.ModelVersionTag,
+	source string,
+	runLink string,
+) (*types.RegisteredModel, *types.ModelVersion, error) {
+	// Create a registered model if not exists
+	var registeredModel *types.RegisteredModel
+	var modelVersion *types.ModelVersion
+	registeredModel, err := registry.GetRegisteredModel(name)
+	if err != nil {
+		if !strings.Contains(err.Error(), RESOURCE_DOES_NOT_EXIST_ERROR) {
+			return nil, nil, err
+		}
+	}
+	if registeredModel == nil {
+		registeredModel, err = registry.CreateRegisteredModel(name, tags, description)
+		// TODO: fix mlflow bug that deletes a registered model does not delete associated permissions when basic authentication is enabled
+		if err != nil && !strings.Contains(err.Error(), RESOURCE_ALREADY_EXISTS_ERROR) {
+			return registeredModel, nil, err
+		}
+		log.Infof("registered model \"%s\" created\n", name)
+	}
+
+	// Create a model version
+	if version != "auto" {
+		return registeredModel, modelVersion, fmt.Errorf("model version currently only supports `auto`")
+	}
+	modelVersion, err = registry.CreateModelVersion(name, source, "", versionTags, runLink, versionDescription)
+	if err != nil {
+		return registeredModel, modelVersion, err
+	}
+	log.Infof("model version %s for \"%s\" created\n", modelVersion.Version, modelVersion.Name)
+	return registeredModel, modelVersion, err
+}
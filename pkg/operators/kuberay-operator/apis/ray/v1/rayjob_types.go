@@ -0,0 +1,164 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// The types are a subset of the RayJob API of KubeRay(https://github.com/ray-project/kuberay),
+// only the fields which arena needs are kept.
+
+// JobStatus is the status of the ray job which is reported by the ray dashboard.
+type JobStatus string
+
+const (
+	JobStatusNew       JobStatus = ""
+	JobStatusPending   JobStatus = "PENDING"
+	JobStatusRunning   JobStatus = "RUNNING"
+	JobStatusStopped   JobStatus = "STOPPED"
+	JobStatusSucceeded JobStatus = "SUCCEEDED"
+	JobStatusFailed    JobStatus = "FAILED"
+)
+
+// JobDeploymentStatus is the status of the ray cluster and the job submission.
+type JobDeploymentStatus string
+
+const (
+	JobDeploymentStatusNew              JobDeploymentStatus = ""
+	JobDeploymentStatusInitializing     JobDeploymentStatus = "Initializing"
+	JobDeploymentStatusRunning          JobDeploymentStatus = "Running"
+	JobDeploymentStatusComplete         JobDeploymentStatus = "Complete"
+	JobDeploymentStatusFailed           JobDeploymentStatus = "Failed"
+	JobDeploymentStatusValidationFailed JobDeploymentStatus = "ValidationFailed"
+	JobDeploymentStatusSuspending       JobDeploymentStatus = "Suspending"
+	JobDeploymentStatusSuspended        JobDeploymentStatus = "Suspended"
+)
+
+// RayJobSpec defines the desired state of RayJob
+type RayJobSpec struct {
+	// ActiveDeadlineSeconds is the duration in seconds that the RayJob may be active before
+	// KubeRay actively tries to terminate the RayJob.
+	ActiveDeadlineSeconds *int32 `json:"activeDeadlineSeconds,omitempty"`
+	// Entrypoint is the command to run the ray job.
+	Entrypoint string `json:"entrypoint,omitempty"`
+	// Metadata is data to store along with this job.
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// RuntimeEnvYAML represents the runtime environment configuration provided as a multi-line YAML string.
+	RuntimeEnvYAML string `json:"runtimeEnvYAML,omitempty"`
+	// JobId is the id of the ray job,it is generated by KubeRay if not set.
+	JobId string `json:"jobId,omitempty"`
+	// ShutdownAfterJobFinishes will determine whether to delete the ray cluster once the job is finished.
+	ShutdownAfterJobFinishes bool `json:"shutdownAfterJobFinishes,omitempty"`
+	// TTLSecondsAfterFinished is the TTL to clean up the ray cluster after the job is finished.
+	TTLSecondsAfterFinished int32 `json:"ttlSecondsAfterFinished,omitempty"`
+	// RayClusterSpec is the cluster template to run the job.
+	RayClusterSpec *RayClusterSpec `json:"rayClusterSpec,omitempty"`
+	// ClusterSelector is used to select running rayclusters by labels.
+	ClusterSelector map[string]string `json:"clusterSelector,omitempty"`
+	// Suspend specifies whether the RayJob controller should create a RayCluster instance.
+	Suspend bool `json:"suspend,omitempty"`
+	// SubmitterPodTemplate is the template for the pod that will run `ray job submit`.
+	SubmitterPodTemplate *corev1.PodTemplateSpec `json:"submitterPodTemplate,omitempty"`
+	// EntrypointNumCpus specifies the number of cpus to reserve for the entrypoint command.
+	EntrypointNumCpus float32 `json:"entrypointNumCpus,omitempty"`
+	// EntrypointNumGpus specifies the number of gpus to reserve for the entrypoint command.
+	EntrypointNumGpus float32 `json:"entrypointNumGpus,omitempty"`
+}
+
+// RayClusterSpec defines the desired state of the ray cluster
+type RayClusterSpec struct {
+	// HeadGroupSpec is the spec for the head pod
+	HeadGroupSpec HeadGroupSpec `json:"headGroupSpec"`
+	// WorkerGroupSpecs are the specs for the worker pods
+	WorkerGroupSpecs []WorkerGroupSpec `json:"workerGroupSpecs,omitempty"`
+	// RayVersion is used to determine the command for the Kubernetes Job managed by RayJob
+	RayVersion string `json:"rayVersion,omitempty"`
+	// EnableInTreeAutoscaling indicates whether operator should create in tree autoscaling configs
+	EnableInTreeAutoscaling *bool `json:"enableInTreeAutoscaling,omitempty"`
+}
+
+// HeadGroupSpec are the spec for the head pod
+type HeadGroupSpec struct {
+	// ServiceType is Kubernetes service type of the head service.
+	ServiceType corev1.ServiceType `json:"serviceType,omitempty"`
+	// RayStartParams are the params of the start command: node-manager-port, object-store-memory, ...
+	RayStartParams map[string]string `json:"rayStartParams"`
+	// Template is the exact pod template used in K8s depoyments, statefulsets, etc.
+	Template corev1.PodTemplateSpec `json:"template"`
+}
+
+// WorkerGroupSpec are the specs for the worker pods
+type WorkerGroupSpec struct {
+	// GroupName is the name of the worker group
+	GroupName string `json:"groupName"`
+	// Replicas is the number of desired Pods for this worker group.
+	Replicas *int32 `json:"replicas,omitempty"`
+	// MinReplicas denotes the minimum number of desired Pods for this worker group.
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// MaxReplicas denotes the maximum number of desired Pods for this worker group.
+	MaxReplicas *int32 `json:"maxReplicas,omitempty"`
+	// RayStartParams are the params of the start command: address, object-store-memory, ...
+	RayStartParams map[string]string `json:"rayStartParams"`
+	// Template is a pod template for the worker
+	Template corev1.PodTemplateSpec `json:"template"`
+}
+
+// RayJobStatus defines the observed state of RayJob
+type RayJobStatus struct {
+	JobId               string              `json:"jobId,omitempty"`
+	RayClusterName      string              `json:"rayClusterName,omitempty"`
+	DashboardURL        string              `json:"dashboardURL,omitempty"`
+	JobStatus           JobStatus           `json:"jobStatus,omitempty"`
+	JobDeploymentStatus JobDeploymentStatus `json:"jobDeploymentStatus,omitempty"`
+	Message             string              `json:"message,omitempty"`
+	// StartTime is the time when JobDeploymentStatus transitioned from 'New' to 'Initializing'.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// EndTime is the time when JobDeploymentStatus transitioned to 'Complete' status.
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+	// ObservedGeneration is the most recent generation observed for this RayJob.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// RayJob is the Schema for the rayjobs API
+type RayJob struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RayJobSpec   `json:"spec,omitempty"`
+	Status RayJobStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+// RayJobList contains a list of RayJob
+type RayJobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RayJob `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RayJob{}, &RayJobList{})
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	commonv1 "github.com/kubeflow/arena/pkg/operators/tf-operator/apis/common/v1"
+)
+
+// The types are a subset of the kubeflow.org/v1 API of training-operator(https://github.com/kubeflow/training-operator),
+// only the fields which arena needs are kept. The job status and replica specs are shared with tf-operator.
+
+// JobSuspended means the job has been suspended by setting runPolicy.suspend to true.
+const JobSuspended commonv1.JobConditionType = "Suspended"
+
+// RunPolicy encapsulates various runtime policies of the distributed training
+// job, for example how to clean up resources and how long the job can stay
+// active.
+type RunPolicy struct {
+	// CleanPodPolicy defines the policy to kill pods after the job completes.
+	// Default to None.
+	CleanPodPolicy *commonv1.CleanPodPolicy `json:"cleanPodPolicy,omitempty"`
+
+	// TTLSecondsAfterFinished is the TTL to clean up jobs.
+	// Default to infinite.
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+
+	// Specifies the duration in seconds relative to the startTime that the job may be active
+	// before the system tries to terminate it; value must be positive integer.
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+
+	// Optional number of retries before marking this job failed.
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+
+	// Suspend specifies whether the job controller should create Pods or not.
+	// If a job is suspended, the active pods are deleted.
+	Suspend *bool `json:"suspend,omitempty"`
+}
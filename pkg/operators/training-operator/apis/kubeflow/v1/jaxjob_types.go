@@ -0,0 +1,72 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	commonv1 "github.com/kubeflow/arena/pkg/operators/tf-operator/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// JAXJobReplicaTypeWorker is the type for worker replicas,the worker with index 0 is the coordinator.
+	JAXJobReplicaTypeWorker commonv1.ReplicaType = "Worker"
+)
+
+// JAXJobSpec is a desired state description of the JAXJob.
+type JAXJobSpec struct {
+	// RunPolicy encapsulates various runtime policies of the distributed training
+	// job, for example how to clean up resources and how long the job can stay
+	// active.
+	RunPolicy RunPolicy `json:"runPolicy"`
+
+	// JAXReplicaSpecs is a map of ReplicaType to ReplicaSpec that specifies the
+	// replicas to run.
+	JAXReplicaSpecs map[commonv1.ReplicaType]*commonv1.ReplicaSpec `json:"jaxReplicaSpecs"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// JAXJob Represents a JAXJob resource.
+type JAXJob struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Specification of the desired state of the JAXJob.
+	Spec JAXJobSpec `json:"spec,omitempty"`
+
+	// Most recently observed status of the JAXJob.
+	Status commonv1.JobStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+// JAXJobList is a list of JAXJobs.
+type JAXJobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// List of JAXJobs.
+	Items []JAXJob `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&JAXJob{}, &JAXJobList{})
+}
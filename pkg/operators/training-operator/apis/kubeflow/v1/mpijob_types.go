@@ -0,0 +1,79 @@
+/*
+Copyright 2024 The Kubeflow Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	commonv1 "github.com/kubeflow/arena/pkg/operators/tf-operator/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// MPIJobReplicaTypeLauncher is the type for launcher replica.
+	MPIJobReplicaTypeLauncher commonv1.ReplicaType = "Launcher"
+
+	// MPIJobReplicaTypeWorker is the type for worker replicas.
+	MPIJobReplicaTypeWorker commonv1.ReplicaType = "Worker"
+)
+
+// MPIJobSpec is a desired state description of the MPIJob.
+type MPIJobSpec struct {
+	// RunPolicy encapsulates various runtime policies of the distributed training
+	// job, for example how to clean up resources and how long the job can stay
+	// active.
+	RunPolicy RunPolicy `json:"runPolicy"`
+
+	// Specifies the number of slots per worker used in hostfile.
+	// Defaults to 1.
+	SlotsPerWorker *int32 `json:"slotsPerWorker,omitempty"`
+
+	// MPIReplicaSpecs is a map of MPIReplicaType to ReplicaSpec that specifies the
+	// replicas to run.
+	MPIReplicaSpecs map[commonv1.ReplicaType]*commonv1.ReplicaSpec `json:"mpiReplicaSpecs"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// MPIJob Represents a MPIJob resource.
+type MPIJob struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Specification of the desired state of the MPIJob.
+	Spec MPIJobSpec `json:"spec,omitempty"`
+
+	// Most recently observed status of the MPIJob.
+	Status commonv1.JobStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+// MPIJobList is a list of MPIJobs.
+type MPIJobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// List of MPIJobs.
+	Items []MPIJob `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MPIJob{}, &MPIJobList{})
+}
@@ -10,6 +10,7 @@ import (
 
 	"encoding/json"
 	"fmt"
+	"math"
 	"strconv"
 
 	"github.com/kubeflow/arena/pkg/apis/config"
@@ -239,3 +240,23 @@ func getPrometheusService(client *kubernetes.Clientset, label string) *v1.Servic
 	}
 	return services[0].DeepCopy()
 }
+
+// QueryPrometheusValue returns the value of a query whose result is a single sample,
+// the second return value is false if the query has no result,eg: no requests in the time range
+func QueryPrometheusValue(client *kubernetes.Clientset, query string) (float64, bool, error) {
+	metrics, err := QueryPrometheusMetrics(client, query)
+	if err != nil {
+		return 0, false, err
+	}
+	if len(metrics) == 0 {
+		return 0, false, nil
+	}
+	v, err := strconv.ParseFloat(metrics[0].Value, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to parse the value %v of query %v: %v", metrics[0].Value, query, err)
+	}
+	if math.IsNaN(v) {
+		return 0, false, nil
+	}
+	return v, true, nil
+}
@@ -0,0 +1,236 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+// getServingAutoscaling returns the horizontal pod autoscaler of the serving job,
+// the autoscaler has the same name as the deployment of the serving job
+func getServingAutoscaling(job ServingJob) *types.ServingAutoscaling {
+	deploy := job.Deployment()
+	if deploy == nil {
+		return nil
+	}
+	hpa, err := config.GetArenaConfiger().GetClientSet().AutoscalingV2().HorizontalPodAutoscalers(deploy.Namespace).Get(context.TODO(), deploy.Name, metav1.GetOptions{})
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			log.Debugf("failed to get the autoscaler of serving job %v,reason: %v", deploy.Name, err)
+		}
+		return nil
+	}
+	args := getAutoscalingArgs(hpa)
+	autoscaling := &types.ServingAutoscaling{
+		MaxReplicas:     hpa.Spec.MaxReplicas,
+		ScaleMetric:     args.ScaleMetric,
+		ScaleTarget:     formatScaleMetricValue(args.ScaleMetric, int64(args.ScaleTarget)),
+		CurrentValue:    "N/A",
+		CurrentReplicas: hpa.Status.CurrentReplicas,
+		DesiredReplicas: hpa.Status.DesiredReplicas,
+	}
+	if hpa.Spec.MinReplicas != nil {
+		autoscaling.MinReplicas = *hpa.Spec.MinReplicas
+	}
+	if len(hpa.Status.CurrentMetrics) > 0 {
+		m := hpa.Status.CurrentMetrics[0]
+		if m.Resource != nil && m.Resource.Current.AverageUtilization != nil {
+			autoscaling.CurrentValue = formatScaleMetricValue(args.ScaleMetric, int64(*m.Resource.Current.AverageUtilization))
+		}
+		if m.Pods != nil && m.Pods.Current.AverageValue != nil {
+			autoscaling.CurrentValue = m.Pods.Current.AverageValue.String()
+		}
+	}
+	return autoscaling
+}
+
+// buildServingAutoscaler merges the args into the autoscaler of the deployment,
+// the autoscaler is created if it does not exist and --max-replicas is set,
+// nil is returned if no autoscaling args are given
+func buildServingAutoscaler(deploy *appsv1.Deployment, args *types.AutoscalingArgs) (*autoscalingv2.HorizontalPodAutoscaler, error) {
+	if *args == (types.AutoscalingArgs{}) {
+		return nil, nil
+	}
+	hpa, err := config.GetArenaConfiger().GetClientSet().AutoscalingV2().HorizontalPodAutoscalers(deploy.Namespace).Get(context.TODO(), deploy.Name, metav1.GetOptions{})
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return nil, err
+		}
+		if args.MaxReplicas == 0 {
+			return nil, fmt.Errorf("the serving job %v has no autoscaler,please set --max-replicas to create it", deploy.Labels[servingNameLabelKey])
+		}
+		hpa = newServingAutoscaler(deploy)
+	}
+	merged := getAutoscalingArgs(hpa)
+	if args.MinReplicas > 0 {
+		merged.MinReplicas = args.MinReplicas
+	}
+	if args.MaxReplicas > 0 {
+		merged.MaxReplicas = args.MaxReplicas
+	}
+	if args.ScaleMetric != "" && args.ScaleMetric != merged.ScaleMetric {
+		merged.ScaleMetric = args.ScaleMetric
+		merged.ScaleTarget = 0
+	}
+	if args.ScaleTarget > 0 {
+		merged.ScaleTarget = args.ScaleTarget
+	}
+	if merged.MinReplicas == 0 {
+		merged.MinReplicas = 1
+	}
+	if merged.ScaleMetric == "" {
+		merged.ScaleMetric = types.DefaultAutoscalingMetric
+	}
+	if merged.ScaleTarget == 0 && types.IsResourceScaleMetric(merged.ScaleMetric) {
+		merged.ScaleTarget = types.DefaultAutoscalingTarget
+	}
+	if err := types.ValidateAutoscalingArgs(&merged); err != nil {
+		return nil, err
+	}
+	minReplicas := int32(merged.MinReplicas)
+	hpa.Spec.MinReplicas = &minReplicas
+	hpa.Spec.MaxReplicas = int32(merged.MaxReplicas)
+	hpa.Spec.Metrics = []autoscalingv2.MetricSpec{generateScaleMetricSpec(merged.ScaleMetric, merged.ScaleTarget)}
+	return hpa, nil
+}
+
+// saveServingAutoscaler creates or updates the autoscaler built by buildServingAutoscaler
+func saveServingAutoscaler(hpa *autoscalingv2.HorizontalPodAutoscaler) error {
+	if hpa == nil {
+		return nil
+	}
+	client := config.GetArenaConfiger().GetClientSet().AutoscalingV2().HorizontalPodAutoscalers(hpa.Namespace)
+	var err error
+	if hpa.ResourceVersion == "" {
+		_, err = client.Create(context.TODO(), hpa, metav1.CreateOptions{})
+	} else {
+		_, err = client.Update(context.TODO(), hpa, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save the autoscaler %v: %v", hpa.Name, err)
+	}
+	log.Infof("The autoscaler %s has been updated successfully", hpa.Name)
+	return nil
+}
+
+// newServingAutoscaler returns the autoscaler owned by the deployment,
+// so it is deleted with the serving job
+func newServingAutoscaler(deploy *appsv1.Deployment) *autoscalingv2.HorizontalPodAutoscaler {
+	controller := true
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      deploy.Name,
+			Namespace: deploy.Namespace,
+			Labels: map[string]string{
+				"createdBy":            "arena",
+				servingNameLabelKey:    deploy.Labels[servingNameLabelKey],
+				servingVersionLabelKey: deploy.Labels[servingVersionLabelKey],
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: "apps/v1",
+					Kind:       "Deployment",
+					Name:       deploy.Name,
+					UID:        deploy.UID,
+					Controller: &controller,
+				},
+			},
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       deploy.Name,
+			},
+		},
+	}
+}
+
+// getAutoscalingArgs converts the autoscaler spec to args,only the first metric is used
+func getAutoscalingArgs(hpa *autoscalingv2.HorizontalPodAutoscaler) types.AutoscalingArgs {
+	args := types.AutoscalingArgs{
+		MaxReplicas: int(hpa.Spec.MaxReplicas),
+	}
+	if hpa.Spec.MinReplicas != nil {
+		args.MinReplicas = int(*hpa.Spec.MinReplicas)
+	}
+	if len(hpa.Spec.Metrics) == 0 {
+		return args
+	}
+	m := hpa.Spec.Metrics[0]
+	if m.Resource != nil {
+		args.ScaleMetric = string(m.Resource.Name)
+		if m.Resource.Target.AverageUtilization != nil {
+			args.ScaleTarget = int(*m.Resource.Target.AverageUtilization)
+		}
+	}
+	if m.Pods != nil {
+		args.ScaleMetric = m.Pods.Metric.Name
+		if m.Pods.Target.AverageValue != nil {
+			args.ScaleTarget = int(m.Pods.Target.AverageValue.Value())
+		}
+	}
+	return args
+}
+
+// generateScaleMetricSpec scales cpu and memory by the average utilization,
+// and the custom metrics by the average value of the pods
+func generateScaleMetricSpec(metric string, target int) autoscalingv2.MetricSpec {
+	if types.IsResourceScaleMetric(metric) {
+		utilization := int32(target)
+		return autoscalingv2.MetricSpec{
+			Type: autoscalingv2.ResourceMetricSourceType,
+			Resource: &autoscalingv2.ResourceMetricSource{
+				Name: v1.ResourceName(metric),
+				Target: autoscalingv2.MetricTarget{
+					Type:               autoscalingv2.UtilizationMetricType,
+					AverageUtilization: &utilization,
+				},
+			},
+		}
+	}
+	value := resource.NewQuantity(int64(target), resource.DecimalSI)
+	return autoscalingv2.MetricSpec{
+		Type: autoscalingv2.PodsMetricSourceType,
+		Pods: &autoscalingv2.PodsMetricSource{
+			Metric: autoscalingv2.MetricIdentifier{
+				Name: metric,
+			},
+			Target: autoscalingv2.MetricTarget{
+				Type:         autoscalingv2.AverageValueMetricType,
+				AverageValue: value,
+			},
+		},
+	}
+}
+
+func formatScaleMetricValue(metric string, value int64) string {
+	if types.IsResourceScaleMetric(metric) {
+		return fmt.Sprintf("%v%%", value)
+	}
+	return fmt.Sprintf("%v", value)
+}
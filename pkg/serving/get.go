@@ -102,17 +102,18 @@ func validateJobs(jobs []ServingJob, name string) error {
 }
 
 func PrintServingJob(job ServingJob, mv *types.ModelVersion, format types.FormatStyle) {
+	jobInfo := job.Convert2JobInfo()
+	jobInfo.Autoscaling = getServingAutoscaling(job)
 	switch format {
 	case types.JsonFormat:
-		data, _ := json.MarshalIndent(job.Convert2JobInfo(), "", "    ")
+		data, _ := json.MarshalIndent(jobInfo, "", "    ")
 		fmt.Printf("%v", string(data))
 		return
 	case types.YamlFormat:
-		data, _ := yaml.Marshal(job.Convert2JobInfo())
+		data, _ := yaml.Marshal(jobInfo)
 		fmt.Printf("%v", string(data))
 		return
 	}
-	jobInfo := job.Convert2JobInfo()
 	endpointAddress := jobInfo.IPAddress
 	ports := []string{}
 	for _, e := range jobInfo.Endpoints {
@@ -184,6 +185,16 @@ func PrintServingJob(job ServingJob, mv *types.ModelVersion, format types.Format
 	fmt.Fprintf(w, "Age:\t%v\n", jobInfo.Age)
 	fmt.Fprintf(w, "Address:\t%v\n", endpointAddress)
 	fmt.Fprintf(w, "Port:\t%v\n", strings.Join(ports, ","))
+	if job.Type() == types.VLLMServingJob {
+		for _, e := range jobInfo.Endpoints {
+			if e.Name == "OPENAI" && endpointAddress != "N/A" {
+				fmt.Fprintf(w, "OpenAIEndpoint:\thttp://%v:%v/v1\n", endpointAddress, e.Port)
+			}
+		}
+	}
+	if a := jobInfo.Autoscaling; a != nil {
+		fmt.Fprintf(w, "Autoscaling:\tmin %v,max %v,%v %v/%v,replicas %v/%v\n", a.MinReplicas, a.MaxReplicas, a.ScaleMetric, a.CurrentValue, a.ScaleTarget, a.CurrentReplicas, a.DesiredReplicas)
+	}
 	if mv != nil {
 		if mv.Name != "" {
 			fmt.Fprintf(w, "ModelName:\t%v\n", mv.Name)
@@ -0,0 +1,377 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	kservev1beta1 "github.com/kserve/kserve/pkg/apis/serving/v1beta1"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/util"
+	"github.com/kubeflow/arena/pkg/util/kubectl"
+)
+
+const (
+	deploymentRevisionAnnotation = "deployment.kubernetes.io/revision"
+)
+
+var (
+	// modelPathOptions are the options which give the model path in the serving commands
+	modelPathOptions = []string{"--model_base_path", "--model_config_file", "--model-repository", "--model-path", "--model"}
+	// knativeRevisionRegexp matches the revision number of the knative revision name like sklearn-predictor-00002
+	knativeRevisionRegexp = regexp.MustCompile(`-(\d+)$`)
+)
+
+// GetServingJobHistory returns the revisions of the serving job,
+// they are the replicasets of the deployment or the revisions in the kserve inference service status
+func GetServingJobHistory(job ServingJob) ([]types.ServingRevision, error) {
+	if ksjob, ok := job.(*kserveJob); ok {
+		return getKServeRevisions(ksjob.inferenceService)
+	}
+	deploy := job.Deployment()
+	if deploy == nil {
+		return nil, fmt.Errorf("the serving job %v whose type is %v does not support history", job.Name(), job.Type())
+	}
+	replicaSets, err := getDeploymentReplicaSets(deploy)
+	if err != nil {
+		return nil, err
+	}
+	currentRevision := deploy.Annotations[deploymentRevisionAnnotation]
+	revisions := []types.ServingRevision{}
+	for i, rs := range replicaSets {
+		revision := types.ServingRevision{
+			Revision: getReplicaSetRevision(rs),
+			Name:     rs.Name,
+			Age:      util.ShortHumanDuration(time.Since(rs.CreationTimestamp.Time)),
+			Current:  rs.Annotations[deploymentRevisionAnnotation] == currentRevision,
+			Changes:  []string{},
+		}
+		if len(rs.Spec.Template.Spec.Containers) > 0 {
+			revision.Image = rs.Spec.Template.Spec.Containers[0].Image
+			revision.ModelPath = getContainerModelPath(rs.Spec.Template.Spec.Containers[0])
+		}
+		if i > 0 {
+			revision.Changes = diffPodTemplates(&replicaSets[i-1].Spec.Template, &rs.Spec.Template)
+		}
+		revisions = append(revisions, revision)
+	}
+	return revisions, nil
+}
+
+// RollbackServingJob rolls back the serving job to the revision,
+// the revision before the current one is used if the revision is 0
+func RollbackServingJob(job ServingJob, revision int64) error {
+	if ksjob, ok := job.(*kserveJob); ok {
+		return rollbackKServe(ksjob.inferenceService, revision)
+	}
+	if job.Deployment() == nil {
+		return fmt.Errorf("the serving job %v whose type is %v does not support rollback", job.Name(), job.Type())
+	}
+	deploy, err := kubectl.GetDeployment(job.Deployment().Name, job.Deployment().Namespace)
+	if err != nil {
+		return err
+	}
+	replicaSets, err := getDeploymentReplicaSets(deploy)
+	if err != nil {
+		return err
+	}
+	currentRevision, _ := strconv.ParseInt(deploy.Annotations[deploymentRevisionAnnotation], 10, 64)
+	var target *appsv1.ReplicaSet
+	for i := range replicaSets {
+		r := getReplicaSetRevision(replicaSets[i])
+		if (revision == 0 && r < currentRevision) || (revision != 0 && r == revision) {
+			target = &replicaSets[i]
+		}
+	}
+	if target == nil {
+		if revision == 0 {
+			return fmt.Errorf("not found the previous revision of serving job %v", job.Name())
+		}
+		return fmt.Errorf("not found the revision %v of serving job %v,please check it with 'arena serve history %v'", revision, job.Name(), job.Name())
+	}
+	targetRevision := getReplicaSetRevision(*target)
+	if targetRevision == currentRevision {
+		log.Infof("The serving job %s is already at revision %v,skip the rollback", job.Name(), targetRevision)
+		return nil
+	}
+	// the pod template hash is added by the deployment controller
+	template := target.Spec.Template.DeepCopy()
+	delete(template.Labels, appsv1.DefaultDeploymentUniqueLabelKey)
+	deploy.Spec.Template = *template
+	if err := kubectl.UpdateDeployment(deploy); err != nil {
+		return err
+	}
+	log.Infof("The serving job %s has been rolled back to revision %v", job.Name(), targetRevision)
+	return nil
+}
+
+// getDeploymentReplicaSets returns the replicasets controlled by the deployment,they are sorted by the revision
+func getDeploymentReplicaSets(deploy *appsv1.Deployment) ([]appsv1.ReplicaSet, error) {
+	selector, err := metav1.LabelSelectorAsSelector(deploy.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	replicaSetList, err := config.GetArenaConfiger().GetClientSet().AppsV1().ReplicaSets(deploy.Namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	replicaSets := []appsv1.ReplicaSet{}
+	for _, rs := range replicaSetList.Items {
+		if metav1.IsControlledBy(&rs, deploy) {
+			replicaSets = append(replicaSets, rs)
+		}
+	}
+	sort.Slice(replicaSets, func(i, j int) bool {
+		return getReplicaSetRevision(replicaSets[i]) < getReplicaSetRevision(replicaSets[j])
+	})
+	return replicaSets, nil
+}
+
+func getReplicaSetRevision(rs appsv1.ReplicaSet) int64 {
+	revision, err := strconv.ParseInt(rs.Annotations[deploymentRevisionAnnotation], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return revision
+}
+
+func getContainerModelPath(c v1.Container) string {
+	for _, option := range modelPathOptions {
+		if value := getContainerArgValue(c, option); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// diffPodTemplates returns the changed image,model path,resources and envs of the serving container
+func diffPodTemplates(prev, cur *v1.PodTemplateSpec) []string {
+	changes := []string{}
+	if len(prev.Spec.Containers) == 0 || len(cur.Spec.Containers) == 0 {
+		return changes
+	}
+	p, c := prev.Spec.Containers[0], cur.Spec.Containers[0]
+	if p.Image != c.Image {
+		changes = append(changes, fmt.Sprintf("image: %v -> %v", p.Image, c.Image))
+	}
+	if pm, cm := getContainerModelPath(p), getContainerModelPath(c); pm != cm {
+		changes = append(changes, fmt.Sprintf("model: %v -> %v", pm, cm))
+	} else if !apiequality.Semantic.DeepEqual(p.Command, c.Command) || !apiequality.Semantic.DeepEqual(p.Args, c.Args) {
+		changes = append(changes, "command")
+	}
+	for _, name := range []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory, ResourceGPU, ResourceGPUMemory, ResourceGPUCore} {
+		pq, pok := p.Resources.Limits[name]
+		cq, cok := c.Resources.Limits[name]
+		if pok == cok && pq.Cmp(cq) == 0 {
+			continue
+		}
+		before, after := "none", "none"
+		if pok {
+			before = pq.String()
+		}
+		if cok {
+			after = cq.String()
+		}
+		changes = append(changes, fmt.Sprintf("%v: %v -> %v", name, before, after))
+	}
+	prevEnvs := map[string]string{}
+	for _, env := range p.Env {
+		prevEnvs[env.Name] = env.Value
+	}
+	curEnvs := map[string]bool{}
+	for _, env := range c.Env {
+		curEnvs[env.Name] = true
+		value, ok := prevEnvs[env.Name]
+		if !ok {
+			changes = append(changes, fmt.Sprintf("env %v: added", env.Name))
+		} else if value != env.Value {
+			changes = append(changes, fmt.Sprintf("env %v: %v -> %v", env.Name, value, env.Value))
+		}
+	}
+	for _, env := range p.Env {
+		if !curEnvs[env.Name] {
+			changes = append(changes, fmt.Sprintf("env %v: removed", env.Name))
+		}
+	}
+	if len(changes) == 0 {
+		prevTemplate, curTemplate := prev.DeepCopy(), cur.DeepCopy()
+		delete(prevTemplate.Labels, appsv1.DefaultDeploymentUniqueLabelKey)
+		delete(curTemplate.Labels, appsv1.DefaultDeploymentUniqueLabelKey)
+		if !apiequality.Semantic.DeepEqual(prevTemplate, curTemplate) {
+			changes = append(changes, "others")
+		}
+	}
+	return changes
+}
+
+// getKServeRevisions returns the revisions recorded in the predictor status of the inference service
+func getKServeRevisions(isvc *kservev1beta1.InferenceService) ([]types.ServingRevision, error) {
+	status := isvc.Status.Components["predictor"]
+	traffics := map[string]int64{}
+	for _, t := range status.Traffic {
+		if t.Percent != nil {
+			traffics[t.RevisionName] += *t.Percent
+		}
+	}
+	revisions := map[string]*types.ServingRevision{}
+	for _, item := range []struct {
+		name   string
+		status string
+	}{
+		{status.PreviousRolledoutRevision, "previous rolled out"},
+		{status.LatestRolledoutRevision, "latest rolled out"},
+		{status.LatestReadyRevision, "latest ready"},
+		{status.LatestCreatedRevision, "latest created"},
+	} {
+		if item.name == "" {
+			continue
+		}
+		revision, ok := revisions[item.name]
+		if !ok {
+			revision = &types.ServingRevision{
+				Revision: getKnativeRevisionNumber(item.name),
+				Name:     item.name,
+				Age:      "N/A",
+				Current:  item.name == status.LatestCreatedRevision,
+				Changes:  []string{},
+			}
+			if percent, ok := traffics[item.name]; ok {
+				revision.TrafficPercent = &percent
+			}
+			revisions[item.name] = revision
+		}
+		if revision.Status != "" {
+			revision.Status += ","
+		}
+		revision.Status += item.status
+	}
+	if len(revisions) == 0 {
+		return nil, fmt.Errorf("not found the revisions in the status of inference service %v", isvc.Name)
+	}
+	result := []types.ServingRevision{}
+	for _, r := range revisions {
+		result = append(result, *r)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Revision < result[j].Revision
+	})
+	return result, nil
+}
+
+// rollbackKServe routes all traffic to the previous rolled out revision by setting the canary traffic percent to 0,
+// or promotes the latest ready revision by removing the canary traffic percent
+func rollbackKServe(isvc *kservev1beta1.InferenceService, revision int64) error {
+	status := isvc.Status.Components["predictor"]
+	previous := status.PreviousRolledoutRevision
+	latest := status.LatestReadyRevision
+	inferenceService, err := kubectl.GetInferenceService(isvc.Name, isvc.Namespace)
+	if err != nil {
+		return err
+	}
+	switch {
+	case previous != "" && (revision == 0 || revision == getKnativeRevisionNumber(previous)):
+		percent := int64(0)
+		inferenceService.Spec.Predictor.CanaryTrafficPercent = &percent
+		if err := kubectl.UpdateInferenceService(inferenceService); err != nil {
+			return err
+		}
+		log.Infof("The serving job %s has been rolled back to revision %v,all traffic is routed to %v", isvc.Name, getKnativeRevisionNumber(previous), previous)
+		return nil
+	case latest != "" && revision == getKnativeRevisionNumber(latest):
+		inferenceService.Spec.Predictor.CanaryTrafficPercent = nil
+		if err := kubectl.UpdateInferenceService(inferenceService); err != nil {
+			return err
+		}
+		log.Infof("The serving job %s has been rolled forward to revision %v,all traffic is routed to %v", isvc.Name, revision, latest)
+		return nil
+	case previous == "":
+		return fmt.Errorf("not found the previous rolled out revision of serving job %v", isvc.Name)
+	}
+	return fmt.Errorf("the serving job %v can only be rolled back to the previous rolled out revision %v or the latest ready revision %v",
+		isvc.Name, getKnativeRevisionNumber(previous), getKnativeRevisionNumber(latest))
+}
+
+func getKnativeRevisionNumber(name string) int64 {
+	match := knativeRevisionRegexp.FindStringSubmatch(name)
+	if len(match) != 2 {
+		return 0
+	}
+	revision, _ := strconv.ParseInt(match[1], 10, 64)
+	return revision
+}
+
+// PrintServingJobHistory prints the revisions of the serving job
+func PrintServingJobHistory(revisions []types.ServingRevision, format types.FormatStyle) {
+	switch format {
+	case types.JsonFormat:
+		data, _ := json.MarshalIndent(revisions, "", "    ")
+		fmt.Printf("%v\n", string(data))
+		return
+	case types.YamlFormat:
+		data, _ := yaml.Marshal(revisions)
+		fmt.Printf("%v", string(data))
+		return
+	}
+	kserve := len(revisions) > 0 && revisions[0].Status != ""
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	if kserve {
+		fmt.Fprintf(w, "REVISION\tNAME\tTRAFFIC\tSTATUS\n")
+	} else {
+		fmt.Fprintf(w, "REVISION\tAGE\tIMAGE\tMODEL\tCHANGES\n")
+	}
+	for _, r := range revisions {
+		revision := fmt.Sprintf("%v", r.Revision)
+		if r.Current {
+			revision = fmt.Sprintf("%v (current)", r.Revision)
+		}
+		if kserve {
+			traffic := "0%"
+			if r.TrafficPercent != nil {
+				traffic = fmt.Sprintf("%v%%", *r.TrafficPercent)
+			}
+			fmt.Fprintf(w, "%v\t%v\t%v\t%v\n", revision, r.Name, traffic, r.Status)
+			continue
+		}
+		modelPath := r.ModelPath
+		if modelPath == "" {
+			modelPath = "N/A"
+		}
+		changes := strings.Join(r.Changes, "; ")
+		if changes == "" {
+			changes = "N/A"
+		}
+		fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\n", revision, r.Age, r.Image, modelPath, changes)
+	}
+	w.Flush()
+}
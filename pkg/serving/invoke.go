@@ -0,0 +1,291 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/kserve/kserve/pkg/constants"
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/rest"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/k8saccesser"
+)
+
+// invokeTarget is the service port and the request path of a serving job
+type invokeTarget struct {
+	service  *v1.Service
+	port     int32
+	protocol types.InvokeProtocol
+	path     string
+	model    string
+}
+
+// InvokeServingJob sends the request to the serving job through the service proxy of the api server,
+// so the serving job can be tested without exposing it out of the cluster
+func InvokeServingJob(job ServingJob, args *types.ServingInvokeArgs) (*types.ServingInvokeResult, error) {
+	target, err := getInvokeTarget(job, args)
+	if err != nil {
+		return nil, err
+	}
+	body := args.Data
+	if target.protocol == types.OpenAIInvokeProtocol {
+		body = setOpenAIRequestModel(body, target.model)
+	}
+	method := args.Method
+	if method == "" {
+		method = http.MethodGet
+		if len(body) != 0 {
+			method = http.MethodPost
+		}
+	}
+	restyClient := newServiceProxyClient(target.service, target.port)
+	if args.Timeout > 0 {
+		restyClient.SetTimeout(args.Timeout)
+	}
+	request := restyClient.R()
+	if len(body) != 0 {
+		request.SetBody(body)
+	}
+	log.Debugf("send %v request to %v%v", method, restyClient.BaseURL, target.path)
+	resp, err := request.Execute(method, target.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke serving job %v: %v", job.Name(), err)
+	}
+	return &types.ServingInvokeResult{
+		Protocol:   target.protocol,
+		Method:     method,
+		URL:        resp.Request.URL,
+		StatusCode: resp.StatusCode(),
+		Status:     resp.Status(),
+		Latency:    resp.Time(),
+		Body:       resp.Body(),
+	}, nil
+}
+
+// newServiceProxyClient returns the client which sends requests to the service port through the api server proxy
+func newServiceProxyClient(service *v1.Service, port int32) *resty.Client {
+	restClient := config.GetArenaConfiger().GetClientSet().CoreV1().RESTClient().(*rest.RESTClient)
+	baseUrl := restClient.Get().
+		Resource("services").
+		Namespace(service.Namespace).
+		Name(fmt.Sprintf("%s:%d", service.Name, port)).
+		SubResource("proxy").
+		URL().
+		String()
+	return resty.New().
+		SetTransport(restClient.Client.Transport).
+		SetBaseURL(baseUrl).
+		SetHeader("Content-Type", "application/json").
+		SetHeader("Accept", "application/json").
+		SetDisableWarn(true)
+}
+
+// newPodProxyClient returns the client sending the requests to the port of the pod through the pod proxy of the api server
+func newPodProxyClient(pod *v1.Pod, port int32) *resty.Client {
+	restClient := config.GetArenaConfiger().GetClientSet().CoreV1().RESTClient().(*rest.RESTClient)
+	baseUrl := restClient.Get().
+		Resource("pods").
+		Namespace(pod.Namespace).
+		Name(fmt.Sprintf("%s:%d", pod.Name, port)).
+		SubResource("proxy").
+		URL().
+		String()
+	return resty.New().
+		SetTransport(restClient.Client.Transport).
+		SetBaseURL(baseUrl).
+		SetHeader("Content-Type", "application/json").
+		SetHeader("Accept", "application/json").
+		SetDisableWarn(true)
+}
+
+// getInvokeTarget picks the service port and the request path by the inference protocol of the serving job
+func getInvokeTarget(job ServingJob, args *types.ServingInvokeArgs) (*invokeTarget, error) {
+	var target *invokeTarget
+	var err error
+	if ksjob, ok := job.(*kserveJob); ok {
+		target, err = getKServeInvokeTarget(ksjob)
+	} else {
+		target, err = getServingJobInvokeTarget(job)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if args.Protocol != "" {
+		target.protocol = args.Protocol
+	}
+	if args.Path != "" {
+		target.path = args.Path
+		return target, nil
+	}
+	model := args.Model
+	switch target.protocol {
+	case types.TFServingInvokeProtocol:
+		if model == "" {
+			model = getDeploymentArgValue(job, "--model_name")
+		}
+		if model == "" {
+			return nil, fmt.Errorf("not found the model name of serving job %v,please set it by --model", job.Name())
+		}
+		target.path = fmt.Sprintf("/v1/models/%v:predict", model)
+	case types.V1InvokeProtocol:
+		if model == "" {
+			model = job.Name()
+		}
+		target.path = fmt.Sprintf("/v1/models/%v:predict", model)
+	case types.V2InvokeProtocol:
+		if model == "" && job.Type() == types.KServeJob {
+			model = job.Name()
+		}
+		if model == "" {
+			return nil, fmt.Errorf("the serving job %v may serve multiple models,please set the model name by --model", job.Name())
+		}
+		target.path = fmt.Sprintf("/v2/models/%v/infer", model)
+	case types.OpenAIInvokeProtocol:
+		if model == "" {
+			model = getDeploymentArgValue(job, "--served-model-name")
+		}
+		target.path = "/v1/chat/completions"
+	case types.RawInvokeProtocol:
+		target.path = "/"
+	default:
+		return nil, fmt.Errorf("unknown protocol %v,only support: [tfserving|v1|v2|openai|raw]", target.protocol)
+	}
+	target.model = model
+	return target, nil
+}
+
+func getServingJobInvokeTarget(job ServingJob) (*invokeTarget, error) {
+	protocol := types.RawInvokeProtocol
+	switch job.Type() {
+	case types.TFServingJob:
+		protocol = types.TFServingInvokeProtocol
+	case types.TritonServingJob, types.TRTServingJob:
+		protocol = types.V2InvokeProtocol
+	case types.VLLMServingJob:
+		protocol = types.OpenAIInvokeProtocol
+	}
+	for _, svc := range job.Services() {
+		for _, p := range svc.Spec.Ports {
+			if p.Name == restfulServingPortName {
+				return &invokeTarget{service: svc, port: p.Port, protocol: protocol}, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("not found the service with port %v of serving job %v", restfulServingPortName, job.Name())
+}
+
+// getKServeInvokeTarget picks the service of the latest predictor revision in serverless mode,
+// or the predictor service in raw deployment mode
+func getKServeInvokeTarget(job *kserveJob) (*invokeTarget, error) {
+	isvc := job.inferenceService
+	protocol := types.V1InvokeProtocol
+	if predictor := isvc.Spec.Predictor.GetPredictorImplementation(); predictor != nil {
+		if (*predictor).GetProtocol() == constants.ProtocolV2 {
+			protocol = types.V2InvokeProtocol
+		}
+	}
+	candidates := []string{}
+	if revision := isvc.Status.Components["predictor"].LatestReadyRevision; revision != "" {
+		candidates = append(candidates, revision)
+	}
+	candidates = append(candidates, isvc.Name+"-predictor", isvc.Name+"-predictor-default")
+	for _, name := range candidates {
+		svc, err := k8saccesser.GetK8sResourceAccesser().GetService(isvc.Namespace, name)
+		if err != nil || svc.Spec.Type == v1.ServiceTypeExternalName || len(svc.Spec.Ports) == 0 {
+			continue
+		}
+		port := svc.Spec.Ports[0].Port
+		for _, p := range svc.Spec.Ports {
+			if p.Name == "http" || p.Name == "http1" {
+				port = p.Port
+				break
+			}
+		}
+		return &invokeTarget{service: svc, port: port, protocol: protocol}, nil
+	}
+	return nil, fmt.Errorf("not found the predictor service of kserve job %v", job.Name())
+}
+
+// getDeploymentArgValue returns the value of the option in the command of the serving job containers
+func getDeploymentArgValue(job ServingJob, option string) string {
+	deployment := job.Deployment()
+	if deployment == nil {
+		return ""
+	}
+	for _, c := range deployment.Spec.Template.Spec.Containers {
+		if value := getContainerArgValue(c, option); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// getContainerArgValue returns the value of the option like --option=value or --option value in the container command
+func getContainerArgValue(c v1.Container, option string) string {
+	re := regexp.MustCompile(regexp.QuoteMeta(option) + `[= ]([^\s"']+)`)
+	command := strings.Join(c.Command, " ") + " " + strings.Join(c.Args, " ")
+	if match := re.FindStringSubmatch(command); len(match) == 2 {
+		return match[1]
+	}
+	return ""
+}
+
+// setOpenAIRequestModel sets the model of the OpenAI request if it is not given in the request body
+func setOpenAIRequestModel(body []byte, model string) []byte {
+	if model == "" || len(body) == 0 {
+		return body
+	}
+	request := map[string]interface{}{}
+	if err := json.Unmarshal(body, &request); err != nil {
+		return body
+	}
+	if _, ok := request["model"]; ok {
+		return body
+	}
+	request["model"] = model
+	data, err := json.Marshal(request)
+	if err != nil {
+		return body
+	}
+	return data
+}
+
+// PrintServingInvokeResult prints the latency and the response of the request
+func PrintServingInvokeResult(result *types.ServingInvokeResult) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Protocol:\t%v\n", result.Protocol)
+	fmt.Fprintf(w, "Request:\t%v %v\n", result.Method, result.URL)
+	fmt.Fprintf(w, "Status:\t%v\n", result.Status)
+	fmt.Fprintf(w, "Latency:\t%v\n", result.Latency)
+	w.Flush()
+	body := result.Body
+	var out bytes.Buffer
+	if err := json.Indent(&out, body, "", "  "); err == nil {
+		body = out.Bytes()
+	}
+	fmt.Printf("\n%v\n", string(body))
+}
@@ -185,18 +185,18 @@ func addTrafficWeight(allJobInfos []types.ServingJobInfo) map[string]types.Servi
 	if len(servingJobsGroup) == len(allJobInfos) {
 		return servingJobMap
 	}
-	istioClient, err := initIstioClient()
+	router, err := GetTrafficRouter()
 	if err != nil {
-		log.Debugf("failed to get istio client when querying traffic weight,reason: %v", err)
+		log.Debugf("failed to get traffic router when querying traffic weight,reason: %v", err)
 		return servingJobMap
 	}
 	for key, group := range servingJobsGroup {
 		if len(group.items) == 1 {
 			continue
 		}
-		weights, err := getVirtualServiceWeight(istioClient, group.namespace, group.jobName)
+		weights, err := router.GetTrafficWeights(group.namespace, group.jobName)
 		if err != nil {
-			log.Debugf("failed to get virtual service weight,reason: %v", err)
+			log.Debugf("failed to get traffic weight,reason: %v", err)
 			continue
 		}
 		// if the weight is 0,fix it with 100
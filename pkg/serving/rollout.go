@@ -0,0 +1,209 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/prometheus"
+	"github.com/kubeflow/arena/pkg/util/kubeclient"
+)
+
+const (
+	rolloutStatusKey = "status"
+	// the rate of prometheus needs at least two samples,so the query range is not less than 1 minute
+	minRolloutQueryRange = time.Minute
+)
+
+// RunServingRollout shifts the traffic from a serving version to another step by step,
+// the canary version is analyzed by the prometheus metrics after each step,
+// and all traffic is routed back to the stable version if the metrics breach the thresholds.
+func RunServingRollout(args *types.ServingRolloutArgs) error {
+	status, err := getServingRolloutStatus(args.Namespace, args.ServingName)
+	if err != nil {
+		return err
+	}
+	if args.Resume {
+		if status == nil {
+			return fmt.Errorf("not found the rollout of serving job %v,nothing to resume", args.ServingName)
+		}
+		if status.Phase != types.RolloutProgressing {
+			return fmt.Errorf("the rollout of serving job %v is %v,it can not be resumed", args.ServingName, status.Phase)
+		}
+		log.Infof("resume the rollout of serving job %v from version %v to %v at %v%%", status.ServingName, status.FromVersion, status.ToVersion, status.Steps[status.Step])
+	} else {
+		if status != nil && status.Phase == types.RolloutProgressing {
+			return fmt.Errorf("the rollout of serving job %v from version %v to %v is in progress,please use --resume to continue it", args.ServingName, status.FromVersion, status.ToVersion)
+		}
+		status = &types.ServingRolloutStatus{
+			ServingRolloutArgs: *args,
+			Phase:              types.RolloutProgressing,
+		}
+	}
+	if _, err := SearchServingJob(status.Namespace, status.ServingName, status.FromVersion, types.AllServingJob); err != nil {
+		return err
+	}
+	canary, err := SearchServingJob(status.Namespace, status.ServingName, status.ToVersion, types.AllServingJob)
+	if err != nil {
+		return err
+	}
+	if canary.Deployment() == nil {
+		return fmt.Errorf("the serving job %v whose type is %v does not support rollout", status.ServingName, canary.Type())
+	}
+	router, err := GetTrafficRouter()
+	if err != nil {
+		return err
+	}
+	if !status.SkipAnalysis && router.Type() != types.IstioTrafficRouter {
+		return fmt.Errorf("the analysis of rollout only supports the %v traffic router,but the traffic router is %v,please use --skip-analysis to roll out without the analysis", types.IstioTrafficRouter, router.Type())
+	}
+	for ; status.Step < len(status.Steps); status.Step++ {
+		weight := status.Steps[status.Step]
+		if err := router.SplitTraffic(status.Namespace, status.ServingName, rolloutVersionWeights(status, weight)); err != nil {
+			return err
+		}
+		status.Weight = weight
+		status.Message = fmt.Sprintf("route %v%% traffic to version %v", weight, status.ToVersion)
+		if err := saveServingRolloutStatus(status); err != nil {
+			return err
+		}
+		if status.SkipAnalysis {
+			log.Infof("step %v/%v: %v,wait for %v", status.Step+1, len(status.Steps), status.Message, status.Interval)
+			time.Sleep(status.Interval)
+			continue
+		}
+		log.Infof("step %v/%v: %v,analyze it after %v", status.Step+1, len(status.Steps), status.Message, status.Interval)
+		time.Sleep(status.Interval)
+		message, passed := analyzeServingRollout(canary, status)
+		status.Message = message
+		if !passed {
+			log.Warnf("version %v is unhealthy: %v,roll back to version %v", status.ToVersion, message, status.FromVersion)
+			if err := router.SplitTraffic(status.Namespace, status.ServingName, rolloutVersionWeights(status, 0)); err != nil {
+				return err
+			}
+			status.Phase = types.RolloutRolledBack
+			status.Weight = 0
+			if err := saveServingRolloutStatus(status); err != nil {
+				return err
+			}
+			return fmt.Errorf("the rollout of serving job %v is rolled back to version %v: %v", status.ServingName, status.FromVersion, message)
+		}
+		log.Infof("version %v is healthy: %v", status.ToVersion, message)
+	}
+	status.Phase = types.RolloutSucceeded
+	if err := saveServingRolloutStatus(status); err != nil {
+		return err
+	}
+	log.Infof("Succeed to roll out serving job %v from version %v to %v", status.ServingName, status.FromVersion, status.ToVersion)
+	return nil
+}
+
+func rolloutVersionWeights(status *types.ServingRolloutStatus, weight int) []types.ServingVersionWeight {
+	return []types.ServingVersionWeight{
+		{Version: status.FromVersion, Weight: 100 - weight},
+		{Version: status.ToVersion, Weight: weight},
+	}
+}
+
+// analyzeServingRollout checks the error rate and the latency of the canary version,
+// the analysis is passed if there is no request in the interval
+func analyzeServingRollout(canary ServingJob, status *types.ServingRolloutStatus) (string, bool) {
+	client := config.GetArenaConfiger().GetClientSet()
+	queryRange := status.Interval
+	if queryRange < minRolloutQueryRange {
+		queryRange = minRolloutQueryRange
+	}
+	window := fmt.Sprintf("%vs", int64(queryRange.Seconds()))
+	deployment := canary.Deployment().Name
+	query := fmt.Sprintf(types.SERVING_REQUEST_RATE_QUERY_TMP, status.Namespace, deployment, window)
+	requestRate, found, err := prometheus.QueryPrometheusValue(client, query)
+	if err != nil {
+		return fmt.Sprintf("failed to query the requests: %v", err), false
+	}
+	if !found || requestRate == 0 {
+		log.Warnf("not found the requests of version %v in the last %v,skip the analysis", status.ToVersion, window)
+		return "no requests", true
+	}
+	query = fmt.Sprintf(types.SERVING_ERROR_RATE_QUERY_TMP, status.Namespace, deployment, window)
+	errorRate, found, err := prometheus.QueryPrometheusValue(client, query)
+	if err != nil {
+		return fmt.Sprintf("failed to query the error rate: %v", err), false
+	}
+	if !found {
+		return "not found the error rate", false
+	}
+	message := fmt.Sprintf("error rate %.2f%%", errorRate*100)
+	if errorRate > status.MaxErrorRate {
+		return fmt.Sprintf("%v is greater than %.2f%%", message, status.MaxErrorRate*100), false
+	}
+	if status.MaxLatency <= 0 {
+		return message, true
+	}
+	query = fmt.Sprintf(types.SERVING_LATENCY_QUERY_TMP, status.Namespace, deployment, window)
+	latency, found, err := prometheus.QueryPrometheusValue(client, query)
+	if err != nil {
+		return fmt.Sprintf("failed to query the latency: %v", err), false
+	}
+	if !found {
+		return fmt.Sprintf("%v,not found the p99 latency", message), false
+	}
+	p99 := time.Duration(latency * float64(time.Millisecond))
+	message = fmt.Sprintf("%v,p99 latency %v", message, p99)
+	if p99 > status.MaxLatency {
+		return fmt.Sprintf("%v is greater than %v", message, status.MaxLatency), false
+	}
+	return message, true
+}
+
+func getServingRolloutStatus(namespace, servingName string) (*types.ServingRolloutStatus, error) {
+	configmap, err := kubeclient.GetConfigMap(namespace, servingRolloutConfigMapName(servingName))
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	status := &types.ServingRolloutStatus{}
+	if err := json.Unmarshal([]byte(configmap.Data[rolloutStatusKey]), status); err != nil {
+		return nil, fmt.Errorf("failed to parse the rollout status of serving job %v: %v", servingName, err)
+	}
+	return status, nil
+}
+
+func saveServingRolloutStatus(status *types.ServingRolloutStatus) error {
+	status.LastUpdateTime = time.Now()
+	data, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+	labels := map[string]string{
+		"createdBy":         "arena",
+		servingNameLabelKey: status.ServingName,
+	}
+	return kubeclient.SaveConfigMapData(status.Namespace, servingRolloutConfigMapName(status.ServingName), labels, map[string]string{
+		rolloutStatusKey: string(data),
+	})
+}
+
+func servingRolloutConfigMapName(servingName string) string {
+	return fmt.Sprintf("%v-serving-rollout", servingName)
+}
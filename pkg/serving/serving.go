@@ -50,6 +50,7 @@ func GetAllProcesser() map[types.ServingJobType]Processer {
 			NewTensorrtServingProcesser,
 			NewSeldonServingProcesser,
 			NewTritonServingProcesser,
+			NewVLLMServingProcesser,
 		}
 		var wg sync.WaitGroup
 		for _, initFunc := range processerInits {
@@ -193,6 +194,10 @@ func (s *servingJob) Endpoints() []types.Endpoint {
 			if strings.Contains(grpcServingPortName, p.Name) {
 				name = "grpc"
 			}
+			// the restful port of vllm serves the OpenAI compatible api
+			if name == "restful" && s.servingType == types.VLLMServingJob {
+				name = "openai"
+			}
 			endpoint := types.Endpoint{
 				Name:     strings.ToUpper(name),
 				NodePort: int(p.NodePort),
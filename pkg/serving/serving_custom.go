@@ -44,11 +44,16 @@ func SubmitCustomServingJob(namespace string, args *types.CustomServingArgs) (er
 	}
 	// the master is also considered as a worker
 	customChart := util.GetChartsFolder() + "/custom-serving"
-	err = workflow.SubmitJob(nameWithVersion, string(types.CustomServingJob), namespace, args, customChart, args.HelmOptions...)
+	err = workflow.SubmitJob(nameWithVersion, string(types.CustomServingJob), namespace, args, customChart, args.DryRun, args.HelmOptions...)
 	if err != nil {
 		return err
 	}
+	// nothing is created when dry running
+	if args.DryRun != types.DryRunNone {
+		return nil
+	}
 	log.Infof("The Job %s has been submitted successfully", args.Name)
 	log.Infof("You can run `arena serve get %s --type %s -n %s` to check the job status", args.Name, args.Type, args.Namespace)
+	exposeServingJob(&args.CommonServingArgs)
 	return nil
 }
@@ -44,10 +44,14 @@ func SubmitKFServingJob(namespace string, args *types.KFServingArgs) (err error)
 	}
 	// the master is also considered as a worker
 	chart := util.GetChartsFolder() + "/kfserving"
-	err = workflow.SubmitJob(nameWithVersion, string(types.KFServingJob), namespace, args, chart, args.HelmOptions...)
+	err = workflow.SubmitJob(nameWithVersion, string(types.KFServingJob), namespace, args, chart, args.DryRun, args.HelmOptions...)
 	if err != nil {
 		return err
 	}
+	// nothing is created when dry running
+	if args.DryRun != types.DryRunNone {
+		return nil
+	}
 	log.Infof("The Job %s has been submitted successfully", args.Name)
 	log.Infof("You can run `arena serve get %s --type %s -n %s` to check the job status", args.Name, args.Type, args.Namespace)
 	return nil
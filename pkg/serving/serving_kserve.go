@@ -63,10 +63,14 @@ func SubmitKServeJob(namespace string, args *types.KServeArgs) (err error) {
 	}
 	// the master is also considered as a worker
 	chart := util.GetChartsFolder() + "/kserve"
-	err = workflow.SubmitJob(args.Name, string(types.KServeJob), namespace, args, chart, args.HelmOptions...)
+	err = workflow.SubmitJob(args.Name, string(types.KServeJob), namespace, args, chart, args.DryRun, args.HelmOptions...)
 	if err != nil {
 		return err
 	}
+	// nothing is created when dry running
+	if args.DryRun != types.DryRunNone {
+		return nil
+	}
 	log.Infof("The Job %s has been submitted successfully", args.Name)
 	log.Infof("You can run `arena serve get %s --type %s -n %s` to check the job status", args.Name, args.Type, args.Namespace)
 	return nil
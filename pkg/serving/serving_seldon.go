@@ -245,10 +245,14 @@ func SubmitSeldonServingJob(namespace string, args *types.SeldonServingArgs) (er
 	log.Infof("seldon chart path: %s", chart)
 	temp, _ := json.Marshal(args)
 	log.Infof("seldon args: %s", string(temp))
-	err = workflow.SubmitJob(nameWithVersion, string(types.SeldonServingJob), namespace, args, chart, args.HelmOptions...)
+	err = workflow.SubmitJob(nameWithVersion, string(types.SeldonServingJob), namespace, args, chart, args.DryRun, args.HelmOptions...)
 	if err != nil {
 		return err
 	}
+	// nothing is created when dry running
+	if args.DryRun != types.DryRunNone {
+		return nil
+	}
 	log.Infof("The Job %s has been submitted successfully", args.Name)
 	log.Infof("You can run `arena serve get %s --type %s -n %s` to check the job status", args.Name, args.Type, args.Namespace)
 	return nil
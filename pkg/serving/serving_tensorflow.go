@@ -44,11 +44,16 @@ func SubmitTensorflowServingJob(namespace string, args *types.TensorFlowServingA
 	}
 	// the master is also considered as a worker
 	chart := util.GetChartsFolder() + "/tfserving"
-	err = workflow.SubmitJob(nameWithVersion, string(types.TFServingJob), namespace, args, chart, args.HelmOptions...)
+	err = workflow.SubmitJob(nameWithVersion, string(types.TFServingJob), namespace, args, chart, args.DryRun, args.HelmOptions...)
 	if err != nil {
 		return err
 	}
+	// nothing is created when dry running
+	if args.DryRun != types.DryRunNone {
+		return nil
+	}
 	log.Infof("The Job %s has been submitted successfully", args.Name)
 	log.Infof("You can run `arena serve get %s --type %s -n %s` to check the job status", args.Name, args.Type, args.Namespace)
+	exposeServingJob(&args.CommonServingArgs)
 	return nil
 }
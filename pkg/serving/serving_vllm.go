@@ -0,0 +1,58 @@
+package serving
+
+import (
+	"fmt"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/util"
+	"github.com/kubeflow/arena/pkg/workflow"
+	log "github.com/sirupsen/logrus"
+)
+
+// VLLMServingProcesser use the default processer
+type VLLMServingProcesser struct {
+	*processer
+}
+
+func NewVLLMServingProcesser() Processer {
+	p := &processer{
+		processerType:   types.VLLMServingJob,
+		client:          config.GetArenaConfiger().GetClientSet(),
+		enable:          true,
+		useIstioGateway: false,
+	}
+	return &VLLMServingProcesser{
+		processer: p,
+	}
+}
+
+func SubmitVLLMServingJob(namespace string, args *types.VLLMServingArgs) (err error) {
+	nameWithVersion := fmt.Sprintf("%v-%v", args.Name, args.Version)
+	args.Namespace = namespace
+	processers := GetAllProcesser()
+	processer, ok := processers[args.Type]
+	if !ok {
+		return fmt.Errorf("not found processer whose type is %v", args.Type)
+	}
+	jobs, err := processer.GetServingJobs(args.Namespace, args.Name, args.Version)
+	if err != nil {
+		return err
+	}
+	if err := ValidateJobsBeforeSubmiting(jobs, args.Name); err != nil {
+		return err
+	}
+	chart := util.GetChartsFolder() + "/vllm-serving"
+	err = workflow.SubmitJob(nameWithVersion, string(types.VLLMServingJob), namespace, args, chart, args.DryRun, args.HelmOptions...)
+	if err != nil {
+		return err
+	}
+	// nothing is created when dry running
+	if args.DryRun != types.DryRunNone {
+		return nil
+	}
+	log.Infof("The Job %s has been submitted successfully", args.Name)
+	log.Infof("You can run `arena serve get %s --type %s -n %s` to check the job status", args.Name, args.Type, args.Namespace)
+	exposeServingJob(&args.CommonServingArgs)
+	return nil
+}
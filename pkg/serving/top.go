@@ -0,0 +1,229 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/prometheus"
+)
+
+const (
+	// servingMetricsWindow is the rate window of the serving metrics
+	servingMetricsWindow = "1m"
+	// servingGPUUtilizationQueryConfigKey is the promql template of the gpu utilization in the arena configuration file,
+	// it is formatted with the namespace(%[1]s) and the regex of the pod names(%[2]s) of the serving job
+	servingGPUUtilizationQueryConfigKey = "serving_gpu_utilization_query"
+)
+
+// TopServingJobs displays the live metrics of the serving jobs,
+// it refreshes the metrics every 2 seconds if notStop is true
+func TopServingJobs(args []string, namespace string, allNamespaces bool, servingType types.ServingJobType, version string, notStop bool, format types.FormatStyle) error {
+	if !notStop {
+		return topServingJobs(args, namespace, allNamespaces, servingType, version, format)
+	}
+	for {
+		err := topServingJobs(args, namespace, allNamespaces, servingType, version, format)
+		if err != nil {
+			log.Errorf("%v", err)
+		}
+		t := time.Now()
+
+		line := "------------------------------------------- %v ----------------------------------------------------"
+		fmt.Printf(line+"\n", t.Format("2006-01-02 15:04:05"))
+		time.Sleep(2 * time.Second)
+	}
+}
+
+func topServingJobs(args []string, namespace string, allNamespaces bool, servingType types.ServingJobType, version string, format types.FormatStyle) error {
+	if format == types.UnknownFormat {
+		return fmt.Errorf("unknown output format,only support:[wide|json|yaml]")
+	}
+	jobs := []ServingJob{}
+	if len(args) > 0 {
+		job, err := SearchServingJob(namespace, args[0], version, servingType)
+		if err != nil {
+			return err
+		}
+		jobs = append(jobs, job)
+	} else {
+		allJobs, err := ListServingJobs(namespace, allNamespaces, servingType)
+		if err != nil {
+			return err
+		}
+		for _, job := range allJobs {
+			if version != "" && job.Version() != version {
+				continue
+			}
+			jobs = append(jobs, job)
+		}
+	}
+	metrics := GetServingJobsMetrics(jobs)
+	return printServingJobsMetrics(metrics, allNamespaces, format)
+}
+
+// GetServingJobsMetrics queries the live metrics of the serving jobs from prometheus
+func GetServingJobsMetrics(jobs []ServingJob) []types.ServingJobMetrics {
+	client := config.GetArenaConfiger().GetClientSet()
+	metrics := []types.ServingJobMetrics{}
+	for _, job := range jobs {
+		jobInfo := job.Convert2JobInfo()
+		m := types.ServingJobMetrics{
+			Name:      jobInfo.Name,
+			Namespace: jobInfo.Namespace,
+			Type:      jobInfo.Type,
+			Version:   jobInfo.Version,
+			Desired:   jobInfo.Desired,
+			Available: jobInfo.Available,
+		}
+		queries, selector := servingMetricQueriesFor(job)
+		if selector != "" {
+			m.QPS = queryServingMetric(client, job, queries.QPS, selector)
+			m.ErrorRate = queryServingMetric(client, job, queries.ErrorRate, selector)
+			m.P50Latency = queryServingMetric(client, job, queries.P50Latency, selector)
+			m.P99Latency = queryServingMetric(client, job, queries.P99Latency, selector)
+		}
+		if podNames := servingJobPodNames(job); podNames != "" {
+			m.GPUUtilization = queryServingGPUUtilization(client, job, podNames)
+		}
+		metrics = append(metrics, m)
+	}
+	return metrics
+}
+
+// servingMetricQueriesFor returns the metric queries and the label matchers of the serving job,
+// the label matchers are empty if the serving job has no metrics to query
+func servingMetricQueriesFor(job ServingJob) (types.ServingMetricQueries, string) {
+	switch job.Type() {
+	case types.TFServingJob, types.TritonServingJob:
+		queries := types.TFServingMetricQueries
+		if job.Type() == types.TritonServingJob {
+			queries = types.TritonServingMetricQueries
+		}
+		podNames := servingJobPodNames(job)
+		if podNames == "" {
+			return queries, ""
+		}
+		return queries, fmt.Sprintf(`namespace="%v",pod=~"%v"`, job.Namespace(), podNames)
+	case types.KServeJob:
+		return types.KServeMetricQueries, fmt.Sprintf(`namespace_name="%v",configuration_name=~"%v-predictor.*"`, job.Namespace(), job.Name())
+	}
+	if job.Deployment() == nil {
+		return types.IstioServingMetricQueries, ""
+	}
+	return types.IstioServingMetricQueries, fmt.Sprintf(`destination_workload_namespace="%v",destination_workload="%v"`, job.Namespace(), job.Deployment().Name)
+}
+
+// queryServingGPUUtilization queries the gpu utilization of the serving job pods by the template
+// in the arena configuration file,or the templates of the known gpu exporters
+func queryServingGPUUtilization(client *kubernetes.Clientset, job ServingJob, podNames string) *float64 {
+	templates := types.SERVING_GPU_UTILIZATION_QUERY_TMPS
+	if template := config.GetArenaConfiger().GetConfigsFromConfigFile()[servingGPUUtilizationQueryConfigKey]; template != "" {
+		templates = []string{template}
+	}
+	for _, template := range templates {
+		query := fmt.Sprintf(template, job.Namespace(), podNames)
+		if value := queryServingMetric(client, job, query, ""); value != nil {
+			return value
+		}
+	}
+	return nil
+}
+
+func queryServingMetric(client *kubernetes.Clientset, job ServingJob, query string, selector string) *float64 {
+	if selector != "" {
+		query = fmt.Sprintf(query, selector, servingMetricsWindow)
+	}
+	value, found, err := prometheus.QueryPrometheusValue(client, query)
+	if err != nil {
+		log.Debugf("failed to query metrics of serving job %v: %v", job.Name(), err)
+		return nil
+	}
+	if !found {
+		return nil
+	}
+	return &value
+}
+
+func servingJobPodNames(job ServingJob) string {
+	podNames := []string{}
+	for _, pod := range job.Pods() {
+		podNames = append(podNames, pod.Name)
+	}
+	return strings.Join(podNames, "|")
+}
+
+func printServingJobsMetrics(metrics []types.ServingJobMetrics, allNamespaces bool, format types.FormatStyle) error {
+	switch format {
+	case types.JsonFormat:
+		data, err := json.MarshalIndent(metrics, "", "    ")
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%v\n", string(data))
+		return nil
+	case types.YamlFormat:
+		data, err := yaml.Marshal(metrics)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%v", string(data))
+		return nil
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	header := []string{}
+	if allNamespaces {
+		header = append(header, "NAMESPACE")
+	}
+	header = append(header, "NAME", "TYPE", "VERSION", "REPLICAS(Available/Desired)", "QPS", "P50_LATENCY", "P99_LATENCY", "ERROR_RATE", "GPU(DutyCycle)")
+	PrintLine(w, header...)
+	for _, m := range metrics {
+		line := []string{}
+		if allNamespaces {
+			line = append(line, m.Namespace)
+		}
+		line = append(line,
+			m.Name,
+			m.Type,
+			m.Version,
+			fmt.Sprintf("%v/%v", m.Available, m.Desired),
+			formatServingMetric(m.QPS, "%.2f", 1),
+			formatServingMetric(m.P50Latency, "%.2fms", 1),
+			formatServingMetric(m.P99Latency, "%.2fms", 1),
+			formatServingMetric(m.ErrorRate, "%.2f%%", 100),
+			formatServingMetric(m.GPUUtilization, "%.1f%%", 1),
+		)
+		PrintLine(w, line...)
+	}
+	return w.Flush()
+}
+
+func formatServingMetric(value *float64, format string, scale float64) string {
+	if value == nil {
+		return "N/A"
+	}
+	return fmt.Sprintf(format, *value*scale)
+}
@@ -0,0 +1,113 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+const (
+	// trafficRouterConfigKey selects the traffic router in the arena configuration file,
+	// it is detected from the installed CRDs if not set
+	trafficRouterConfigKey = "traffic_router"
+	// trafficRouterGatewayConfigKey is the gateway which the HTTPRoutes are attached to,
+	// the format is NAMESPACE/NAME or NAME
+	trafficRouterGatewayConfigKey = "traffic_router_gateway"
+	// trafficRouterGatewayPortConfigKey is the http port of the istio ingress gateway which the serving jobs are exposed on
+	trafficRouterGatewayPortConfigKey = "traffic_router_gateway_port"
+	defaultIstioGatewayPort           = 80
+	// trafficRouterGatewayDomainConfigKey is the domain of the hosts which the serving jobs are exposed by the istio gateway,
+	// the host of a serving job is <serving name>.<namespace>.<domain>
+	trafficRouterGatewayDomainConfigKey = "traffic_router_gateway_domain"
+	defaultIstioGatewayDomain           = "example.com"
+
+	istioVirtualServiceCRDName = "virtualservices.networking.istio.io"
+	gatewayAPIHTTPRouteCRDName = "httproutes.gateway.networking.k8s.io"
+)
+
+// TrafficRouter routes the traffic of a serving job to its versions
+type TrafficRouter interface {
+	// Type returns the type of the traffic router
+	Type() types.TrafficRouterType
+	// SplitTraffic routes the traffic of the serving job to the versions by the weights
+	SplitTraffic(namespace, servingName string, versionWeights []types.ServingVersionWeight) error
+	// GetTrafficWeights returns the traffic weights of the serving job versions
+	GetTrafficWeights(namespace, servingName string) (map[string]int32, error)
+	// ExposeService routes the external traffic to the serving job version
+	ExposeService(namespace, servingName, version string) error
+}
+
+// GetTrafficRouter returns the traffic router configured in the arena configuration file,
+// or the one whose CRDs are installed in the cluster,istio is preferred if both are installed
+func GetTrafficRouter() (TrafficRouter, error) {
+	configs := config.GetArenaConfiger().GetConfigsFromConfigFile()
+	routerType := types.TrafficRouterType(configs[trafficRouterConfigKey])
+	if routerType == "" {
+		routerType = detectTrafficRouterType()
+	}
+	log.Debugf("use the traffic router %v", routerType)
+	switch routerType {
+	case types.IstioTrafficRouter:
+		return newIstioTrafficRouter(configs[trafficRouterGatewayPortConfigKey], configs[trafficRouterGatewayDomainConfigKey])
+	case types.GatewayAPITrafficRouter:
+		return newGatewayAPITrafficRouter(configs[trafficRouterGatewayConfigKey])
+	case "":
+		return nil, fmt.Errorf("not found istio or gateway api in the cluster,please install one of them to route the traffic")
+	}
+	return nil, fmt.Errorf("unknown traffic router %v,only support: [%v|%v]", routerType, types.IstioTrafficRouter, types.GatewayAPITrafficRouter)
+}
+
+func detectTrafficRouterType() types.TrafficRouterType {
+	crds := config.GetArenaConfiger().GetAPIExtensionClientSet().ApiextensionsV1().CustomResourceDefinitions()
+	if _, err := crds.Get(context.TODO(), istioVirtualServiceCRDName, metav1.GetOptions{}); err == nil {
+		return types.IstioTrafficRouter
+	}
+	if _, err := crds.Get(context.TODO(), gatewayAPIHTTPRouteCRDName, metav1.GetOptions{}); err == nil {
+		return types.GatewayAPITrafficRouter
+	}
+	return ""
+}
+
+// exposeServingJob routes the external traffic to the serving job if --expose-service is set,
+// the serving job has been created,so the failure is only warned
+func exposeServingJob(args *types.CommonServingArgs) {
+	if !args.ExposeService || args.DryRun != types.DryRunNone {
+		return
+	}
+	router, err := GetTrafficRouter()
+	if err == nil {
+		err = router.ExposeService(args.Namespace, args.Name, args.Version)
+	}
+	if err != nil {
+		log.Warnf("failed to expose the serving job %v: %v", args.Name, err)
+	}
+}
+
+// parseGatewayRef parses the gateway like NAMESPACE/NAME or NAME
+func parseGatewayRef(gateway string) types.HTTPRouteParentRef {
+	items := strings.SplitN(gateway, "/", 2)
+	if len(items) == 2 {
+		return types.HTTPRouteParentRef{Namespace: items[0], Name: items[1]}
+	}
+	return types.HTTPRouteParentRef{Name: gateway}
+}
@@ -0,0 +1,255 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/client-go/rest"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/k8saccesser"
+)
+
+const (
+	gatewayAPIGroup = "gateway.networking.k8s.io"
+)
+
+// gatewayAPITrafficRouter splits the traffic by the weights of the HTTPRoute backends,
+// each backend is the service of a serving job version
+type gatewayAPITrafficRouter struct {
+	client     *rest.RESTClient
+	apiVersion string
+	gateway    string
+}
+
+func newGatewayAPITrafficRouter(gateway string) (TrafficRouter, error) {
+	version, err := getHTTPRouteServedVersion()
+	if err != nil {
+		return nil, err
+	}
+	client, err := initGatewayAPIClient(version)
+	if err != nil {
+		return nil, err
+	}
+	return &gatewayAPITrafficRouter{
+		client:     client,
+		apiVersion: fmt.Sprintf("%v/%v", gatewayAPIGroup, version),
+		gateway:    gateway,
+	}, nil
+}
+
+func (r *gatewayAPITrafficRouter) Type() types.TrafficRouterType {
+	return types.GatewayAPITrafficRouter
+}
+
+// SplitTraffic updates the backends of the HTTPRoute whose name is the serving name,
+// the HTTPRoute is created and attached to the configured gateway if it does not exist
+func (r *gatewayAPITrafficRouter) SplitTraffic(namespace, servingName string, versionWeights []types.ServingVersionWeight) error {
+	backendRefs := []types.HTTPRouteBackendRef{}
+	for _, vw := range versionWeights {
+		backendRef, err := getServingVersionBackendRef(namespace, servingName, vw.Version)
+		if err != nil {
+			return err
+		}
+		backendRef.Weight = int32(vw.Weight)
+		backendRefs = append(backendRefs, backendRef)
+	}
+	route, err := r.getHTTPRoute(namespace, servingName)
+	if err != nil {
+		return err
+	}
+	if route == nil {
+		return r.createHTTPRoute(namespace, servingName, backendRefs)
+	}
+	route.Spec.Rules = generateHTTPRouteRules(backendRefs)
+	return r.updateHTTPRoute(route)
+}
+
+func (r *gatewayAPITrafficRouter) GetTrafficWeights(namespace, servingName string) (map[string]int32, error) {
+	weights := map[string]int32{}
+	route, err := r.getHTTPRoute(namespace, servingName)
+	if err != nil || route == nil {
+		return weights, err
+	}
+	for _, rule := range route.Spec.Rules {
+		for _, backendRef := range rule.BackendRefs {
+			svc, err := k8saccesser.GetK8sResourceAccesser().GetService(namespace, backendRef.Name)
+			if err != nil {
+				log.Debugf("failed to get the backend service %v of HTTPRoute %v,reason: %v", backendRef.Name, servingName, err)
+				continue
+			}
+			weights[svc.Labels[servingVersionLabelKey]] = backendRef.Weight
+		}
+	}
+	return weights, nil
+}
+
+// ExposeService creates the HTTPRoute which routes all traffic to the version,
+// the existing HTTPRoute is not changed,the traffic should be shifted by 'arena serve traffic-split'
+func (r *gatewayAPITrafficRouter) ExposeService(namespace, servingName, version string) error {
+	route, err := r.getHTTPRoute(namespace, servingName)
+	if err != nil {
+		return err
+	}
+	if route != nil {
+		log.Infof("the HTTPRoute %v exists,please use 'arena serve traffic-split' to route the traffic to version %v", servingName, version)
+		return nil
+	}
+	backendRef, err := getServingVersionBackendRef(namespace, servingName, version)
+	if err != nil {
+		return err
+	}
+	backendRef.Weight = 100
+	if err := r.createHTTPRoute(namespace, servingName, []types.HTTPRouteBackendRef{backendRef}); err != nil {
+		return err
+	}
+	log.Infof("the serving job %v is exposed by the HTTPRoute %v attached to gateway %v", servingName, servingName, r.gateway)
+	return nil
+}
+
+func (r *gatewayAPITrafficRouter) getHTTPRoute(namespace, name string) (*types.HTTPRouteCRD, error) {
+	data, err := r.client.Get().Namespace(namespace).Resource("httproutes").Name(name).Do(context.TODO()).Raw()
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	route := &types.HTTPRouteCRD{}
+	if err := json.Unmarshal(data, route); err != nil {
+		return nil, err
+	}
+	return route, nil
+}
+
+func (r *gatewayAPITrafficRouter) createHTTPRoute(namespace, name string, backendRefs []types.HTTPRouteBackendRef) error {
+	if r.gateway == "" {
+		return fmt.Errorf("not found the gateway to attach the HTTPRoute %v,please set %v in the arena configuration file", name, trafficRouterGatewayConfigKey)
+	}
+	route := types.HTTPRouteCRD{
+		Kind:       "HTTPRoute",
+		APIVersion: r.apiVersion,
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels: map[string]string{
+				"createdBy":         "arena",
+				servingNameLabelKey: name,
+			},
+		},
+		Spec: types.HTTPRouteSpec{
+			ParentRefs: []types.HTTPRouteParentRef{parseGatewayRef(r.gateway)},
+			Rules:      generateHTTPRouteRules(backendRefs),
+		},
+	}
+	data, err := json.Marshal(route)
+	if err != nil {
+		return err
+	}
+	log.Debugf("create HTTPRoute: %s", data)
+	_, err = r.client.Post().Namespace(namespace).Resource("httproutes").Body(data).Do(context.TODO()).Raw()
+	return err
+}
+
+func (r *gatewayAPITrafficRouter) updateHTTPRoute(route *types.HTTPRouteCRD) error {
+	data, err := json.Marshal(route)
+	if err != nil {
+		return err
+	}
+	log.Debugf("update HTTPRoute: %s", data)
+	_, err = r.client.Put().Namespace(route.Namespace).Resource("httproutes").Name(route.Name).Body(data).Do(context.TODO()).Raw()
+	return err
+}
+
+func generateHTTPRouteRules(backendRefs []types.HTTPRouteBackendRef) []types.HTTPRouteRule {
+	return []types.HTTPRouteRule{
+		{
+			Matches: []types.HTTPRouteMatch{
+				{
+					Path: &types.HTTPRoutePathMatch{
+						Type:  "PathPrefix",
+						Value: "/",
+					},
+				},
+			},
+			BackendRefs: backendRefs,
+		},
+	}
+}
+
+// getServingVersionBackendRef returns the restful port of the serving job version as the HTTPRoute backend
+func getServingVersionBackendRef(namespace, servingName, version string) (types.HTTPRouteBackendRef, error) {
+	job, err := SearchServingJob(namespace, servingName, version, types.AllServingJob)
+	if err != nil {
+		return types.HTTPRouteBackendRef{}, err
+	}
+	for _, svc := range job.Services() {
+		for _, p := range svc.Spec.Ports {
+			if p.Name == restfulServingPortName {
+				return types.HTTPRouteBackendRef{Name: svc.Name, Port: p.Port}, nil
+			}
+		}
+	}
+	return types.HTTPRouteBackendRef{}, fmt.Errorf("not found the service with port %v of serving job %v version %v", restfulServingPortName, servingName, version)
+}
+
+// getHTTPRouteServedVersion returns v1 if it is served by the HTTPRoute CRD,otherwise returns the first served version
+func getHTTPRouteServedVersion() (string, error) {
+	crd, err := config.GetArenaConfiger().GetAPIExtensionClientSet().ApiextensionsV1().CustomResourceDefinitions().Get(context.TODO(), gatewayAPIHTTPRouteCRDName, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get the CRD %v: %v", gatewayAPIHTTPRouteCRDName, err)
+	}
+	served := ""
+	for _, v := range crd.Spec.Versions {
+		if !v.Served {
+			continue
+		}
+		if v.Name == "v1" {
+			return v.Name, nil
+		}
+		if served == "" {
+			served = v.Name
+		}
+	}
+	if served == "" {
+		return "", fmt.Errorf("no version of CRD %v is served", gatewayAPIHTTPRouteCRDName)
+	}
+	return served, nil
+}
+
+func initGatewayAPIClient(version string) (*rest.RESTClient, error) {
+	restConfig := rest.CopyConfig(config.GetArenaConfiger().GetRestConfig())
+	gatewayAPIGroupVersion := schema.GroupVersion{
+		Group:   gatewayAPIGroup,
+		Version: version,
+	}
+	restConfig.GroupVersion = &gatewayAPIGroupVersion
+	restConfig.APIPath = "/apis"
+	restConfig.ContentType = runtime.ContentTypeJSON
+	scheme := runtime.NewScheme()
+	metav1.AddToGroupVersion(scheme, gatewayAPIGroupVersion)
+	restConfig.NegotiatedSerializer = serializer.NewCodecFactory(scheme)
+	return rest.RESTClientFor(restConfig)
+}
@@ -17,10 +17,13 @@ package serving
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"strconv"
 	"strings"
 
 	log "github.com/sirupsen/logrus"
 	istiov1alpha3 "istio.io/api/networking/v1alpha3"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -32,15 +35,165 @@ import (
 )
 
 func RunTrafficRouterSplit(namespace string, args *types.TrafficRouterSplitArgs) (err error) {
+	router, err := GetTrafficRouter()
+	if err != nil {
+		return err
+	}
+	if err := router.SplitTraffic(namespace, args.ServingName, args.VersionWeights); err != nil {
+		return err
+	}
+	log.Infof("Succeed to split the traffic for serving job %v", args.ServingName)
+	return nil
+}
+
+// istioTrafficRouter splits the traffic by the istio destination rule and virtual service
+type istioTrafficRouter struct {
+	client *rest.RESTClient
+	// gatewayPort is the port of the gateway server created by ExposeService
+	gatewayPort uint32
+	// gatewayDomain is the domain of the hosts of the serving jobs exposed by ExposeService
+	gatewayDomain string
+}
+
+func newIstioTrafficRouter(gatewayPort, gatewayDomain string) (TrafficRouter, error) {
+	port := uint64(defaultIstioGatewayPort)
+	if gatewayPort != "" {
+		var err error
+		port, err = strconv.ParseUint(gatewayPort, 10, 16)
+		if err != nil || port == 0 {
+			return nil, fmt.Errorf("invalid %v %v,it should be a port number", trafficRouterGatewayPortConfigKey, gatewayPort)
+		}
+	}
+	gatewayDomain = strings.Trim(gatewayDomain, ".")
+	if gatewayDomain == "" {
+		gatewayDomain = defaultIstioGatewayDomain
+	}
 	istioClient, err := initIstioClient()
 	if err != nil {
+		return nil, err
+	}
+	return &istioTrafficRouter{client: istioClient, gatewayPort: uint32(port), gatewayDomain: gatewayDomain}, nil
+}
+
+func (r *istioTrafficRouter) Type() types.TrafficRouterType {
+	return types.IstioTrafficRouter
+}
+
+func (r *istioTrafficRouter) GetTrafficWeights(namespace, servingName string) (map[string]int32, error) {
+	return getVirtualServiceWeight(r.client, namespace, servingName)
+}
+
+// ExposeService creates the istio gateway and the virtual service which routes all traffic to the service of the version,
+// the existing virtual service is not changed,like the one created by the tensorflow serving chart with --enable-istio.
+// Both of them only match the host of the serving job,so the serving jobs exposed on the same port do not overlap
+func (r *istioTrafficRouter) ExposeService(namespace, servingName, version string) error {
+	_, err := r.client.Get().Namespace(namespace).Resource("virtualservices").Name(servingName).Do(context.TODO()).Raw()
+	if err == nil {
+		log.Infof("the virtual service %v exists,please use 'arena serve traffic-split' to route the traffic to version %v", servingName, version)
+		return nil
+	}
+	if !k8serrors.IsNotFound(err) {
 		return err
 	}
+	backendRef, err := getServingVersionBackendRef(namespace, servingName, version)
+	if err != nil {
+		return err
+	}
+	gatewayName := servingName + "-gateway"
+	host := r.servingHost(namespace, servingName)
+	if err := r.createGateway(namespace, gatewayName, host); err != nil {
+		return err
+	}
+	// the spec is marshaled by the istio types,the MarshalJSON of the embedded istio types in
+	// types.VirtualServiceCRD drops the http routes
+	virtualService := map[string]interface{}{
+		"kind":       "VirtualService",
+		"apiVersion": "networking.istio.io/v1alpha3",
+		"metadata": metav1.ObjectMeta{
+			Name:      servingName,
+			Namespace: namespace,
+			Labels: map[string]string{
+				"createdBy":         "arena",
+				servingNameLabelKey: servingName,
+			},
+		},
+		"spec": &istiov1alpha3.VirtualService{
+			Gateways: []string{gatewayName},
+			Hosts:    []string{host},
+			Http: []*istiov1alpha3.HTTPRoute{
+				{
+					Route: []*istiov1alpha3.HTTPRouteDestination{
+						{
+							Destination: &istiov1alpha3.Destination{
+								Host: backendRef.Name,
+								Port: &istiov1alpha3.PortSelector{Number: uint32(backendRef.Port)},
+							},
+							Weight: 100,
+						},
+					},
+				},
+			},
+		},
+	}
+	data, err := json.Marshal(virtualService)
+	if err != nil {
+		return err
+	}
+	log.Debugf("create virtualservice: %s", data)
+	if _, err := r.client.Post().Namespace(namespace).Resource("virtualservices").Body(data).Do(context.TODO()).Raw(); err != nil {
+		return err
+	}
+	log.Infof("the serving job %v is exposed by the istio gateway %v with host %v", servingName, gatewayName, host)
+	return nil
+}
+
+// servingHost returns the host of the serving job exposed by the istio gateway,like <serving name>.<namespace>.<domain>
+func (r *istioTrafficRouter) servingHost(namespace, servingName string) string {
+	return fmt.Sprintf("%v.%v.%v", servingName, namespace, r.gatewayDomain)
+}
+
+// createGateway creates the gateway of the host on the configured http port of the default istio ingress gateway if it does not exist
+func (r *istioTrafficRouter) createGateway(namespace, name, host string) error {
+	_, err := r.client.Get().Namespace(namespace).Resource("gateways").Name(name).Do(context.TODO()).Raw()
+	if err == nil || !k8serrors.IsNotFound(err) {
+		return err
+	}
+	gateway := types.GatewayCRD{
+		Kind:       "Gateway",
+		APIVersion: "networking.istio.io/v1alpha3",
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels: map[string]string{
+				"createdBy": "arena",
+			},
+		},
+		Spec: istiov1alpha3.Gateway{
+			Selector: map[string]string{"istio": "ingressgateway"},
+			Servers: []*istiov1alpha3.Server{
+				{
+					Port:  &istiov1alpha3.Port{Number: r.gatewayPort, Name: "http", Protocol: "HTTP"},
+					Hosts: []string{host},
+				},
+			},
+		},
+	}
+	data, err := json.Marshal(gateway)
+	if err != nil {
+		return err
+	}
+	log.Debugf("create gateway: %s", data)
+	_, err = r.client.Post().Namespace(namespace).Resource("gateways").Body(data).Do(context.TODO()).Raw()
+	return err
+}
+
+// SplitTraffic creates or updates the destination rule and the virtual service by the version weights
+func (r *istioTrafficRouter) SplitTraffic(namespace string, servingName string, versionWeights []types.ServingVersionWeight) error {
+	istioClient := r.client
 	preprocessObject := types.PreprocesObject{
-		ServiceName:     args.ServingName,
+		ServiceName:     servingName,
 		Namespace:       namespace,
-		DestinationRule: generateDestinationRule(namespace, args.ServingName, args.VersionWeights),
-		VirtualService:  generateVirtualService(namespace, args.ServingName, args.VersionWeights),
+		DestinationRule: generateDestinationRule(namespace, servingName, versionWeights),
 	}
 	log.Debugf("serviceName: %s", preprocessObject.ServiceName)
 	jsonDestinationRule, err := json.Marshal(preprocessObject.DestinationRule)
@@ -48,11 +201,6 @@ func RunTrafficRouterSplit(namespace string, args *types.TrafficRouterSplitArgs)
 		return err
 	}
 	log.Debugf("destination rule: %s", jsonDestinationRule)
-	jsonVirtualService, err := json.Marshal(preprocessObject.VirtualService)
-	if err != nil {
-		return err
-	}
-	log.Debugf("virtual service: %s", jsonVirtualService)
 	virtualServiceName := preprocessObject.ServiceName
 	log.Debugf("virtualServiceName:%s", virtualServiceName)
 	destinationRuleName := preprocessObject.ServiceName
@@ -61,12 +209,7 @@ func RunTrafficRouterSplit(namespace string, args *types.TrafficRouterSplitArgs)
 	if err != nil {
 		return err
 	}
-	err = createOrUpdateVirtualService(namespace, istioClient, preprocessObject, virtualServiceName)
-	if err != nil {
-		return err
-	}
-	log.Infof("Succeed to split the traffic for serving job %v", args.ServingName)
-	return nil
+	return createOrUpdateVirtualService(namespace, istioClient, virtualServiceName, versionWeights)
 }
 
 func generateDestinationRule(namespace string, serviceName string, versionWeights []types.ServingVersionWeight) types.DestinationRuleCRD {
@@ -94,55 +237,78 @@ func generateDestinationRule(namespace string, serviceName string, versionWeight
 	return destinationRule
 }
 
-func generateVirtualService(namespace string, serviceName string, versionWeights []types.ServingVersionWeight) types.VirtualServiceCRD {
-	routes := []*types.DestinationWeight{}
+// virtualServiceObject is the virtual service read from the api server,only the fields used by arena are decoded.
+// The istio types are not used since their UnmarshalJSON rejects the fields unknown to the vendored istio api
+type virtualServiceObject struct {
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              struct {
+		Hosts    []string `json:"hosts,omitempty"`
+		Gateways []string `json:"gateways,omitempty"`
+		Http     []struct {
+			Route []struct {
+				Destination struct {
+					Subset string `json:"subset,omitempty"`
+				} `json:"destination"`
+				Weight int32 `json:"weight,omitempty"`
+			} `json:"route,omitempty"`
+		} `json:"http,omitempty"`
+	} `json:"spec,omitempty"`
+}
+
+// generateVirtualService returns the virtual service which splits the traffic to the subsets of the versions.
+// Like ExposeService,the spec is marshaled by the istio types,the MarshalJSON of the embedded istio types in
+// types.VirtualServiceCRD drops the http routes
+func generateVirtualService(metadata metav1.ObjectMeta, hosts, gateways []string, serviceName string, versionWeights []types.ServingVersionWeight) map[string]interface{} {
+	routes := []*istiov1alpha3.HTTPRouteDestination{}
 	for _, vw := range versionWeights {
-		routes = append(routes, &types.DestinationWeight{
-			Destination: &types.Destination{
-				Destination: &istiov1alpha3.Destination{
-					Subset: "subset-" + vw.Version,
-					Host:   serviceName,
-				},
+		routes = append(routes, &istiov1alpha3.HTTPRouteDestination{
+			Destination: &istiov1alpha3.Destination{
+				Subset: "subset-" + vw.Version,
+				Host:   serviceName,
 			},
 			Weight: int32(vw.Weight),
 		})
 	}
-	httpMatchRequests := []*types.HTTPMatchRequest{
-		&types.HTTPMatchRequest{
-			Uri: &types.StringMatchPrefix{
-				Prefix: "/",
-			},
-		},
-	}
-	virtualService := types.VirtualServiceCRD{
-		Kind:       "VirtualService",
-		APIVersion: "networking.istio.io/v1alpha3",
-		ObjectMeta: metav1.ObjectMeta{
-			Name:        serviceName,
-			Namespace:   namespace,
-			Labels:      nil,
-			Annotations: nil,
-		},
-		Spec: types.VirtualService{
-			VirtualService: &istiov1alpha3.VirtualService{
-				Gateways: []string{serviceName + "-gateway"},
-				Hosts:    []string{"*"},
-			},
-			Http: []*types.HTTPRoute{
+	return map[string]interface{}{
+		"kind":       "VirtualService",
+		"apiVersion": "networking.istio.io/v1alpha3",
+		"metadata":   metadata,
+		"spec": &istiov1alpha3.VirtualService{
+			Gateways: gateways,
+			Hosts:    hosts,
+			Http: []*istiov1alpha3.HTTPRoute{
 				{
-					HTTPRoute: &istiov1alpha3.HTTPRoute{
-						Rewrite: &istiov1alpha3.HTTPRewrite{
-							Uri:       "/",
-							Authority: "",
+					Match: []*istiov1alpha3.HTTPMatchRequest{
+						{
+							Uri: &istiov1alpha3.StringMatch{
+								MatchType: &istiov1alpha3.StringMatch_Prefix{Prefix: "/"},
+							},
 						},
 					},
-					Match: httpMatchRequests,
+					Rewrite: &istiov1alpha3.HTTPRewrite{
+						Uri: "/",
+					},
 					Route: routes,
 				},
 			},
 		},
 	}
-	return virtualService
+}
+
+// updateVirtualService returns the virtual service which replaces the existing one to split the traffic by the version weights,
+// the metadata,the hosts and the gateways of the existing virtual service are kept,like the host of the serving job created by --expose-service
+func updateVirtualService(existing []byte, serviceName string, versionWeights []types.ServingVersionWeight) ([]byte, error) {
+	var original virtualServiceObject
+	if err := json.Unmarshal(existing, &original); err != nil {
+		return nil, err
+	}
+	hosts := []string{"*"}
+	gateways := []string{serviceName + "-gateway"}
+	if len(original.Spec.Hosts) != 0 {
+		hosts = original.Spec.Hosts
+		gateways = original.Spec.Gateways
+	}
+	return json.Marshal(generateVirtualService(original.ObjectMeta, hosts, gateways, serviceName, versionWeights))
 }
 
 func createOrUpdateDestinationRule(istioClient *rest.RESTClient, preprocessObject types.PreprocesObject, destinationRuleName string) (err error) {
@@ -190,15 +356,19 @@ func createOrUpdateDestinationRule(istioClient *rest.RESTClient, preprocessObjec
 	return nil
 }
 
-func createOrUpdateVirtualService(namespace string, istioClient *rest.RESTClient, preprocessObject types.PreprocesObject, virtualServiceName string) (err error) {
-	request := istioClient.Get().Namespace(preprocessObject.Namespace).Resource("virtualservices").Name(virtualServiceName)
+func createOrUpdateVirtualService(namespace string, istioClient *rest.RESTClient, virtualServiceName string, versionWeights []types.ServingVersionWeight) (err error) {
+	request := istioClient.Get().Namespace(namespace).Resource("virtualservices").Name(virtualServiceName)
 	request.SetHeader("Accept", "application/json")
 	request.SetHeader("Content-Type", "application/json")
 	log.Debugf("request URL: %s", request.URL())
 	result2, err := request.Do(context.TODO()).Raw()
 	if err != nil {
 		log.Debugf("will create new virtualservice \"%s\"", virtualServiceName)
-		convertedjson, err := json.Marshal(preprocessObject.VirtualService)
+		metadata := metav1.ObjectMeta{
+			Name:      virtualServiceName,
+			Namespace: namespace,
+		}
+		convertedjson, err := json.Marshal(generateVirtualService(metadata, []string{"*"}, []string{virtualServiceName + "-gateway"}, virtualServiceName, versionWeights))
 		if err != nil {
 			return err
 		}
@@ -211,13 +381,7 @@ func createOrUpdateVirtualService(namespace string, istioClient *rest.RESTClient
 		return nil
 	}
 	log.Debugf("original virtualservice: %s", result2)
-	var originalVirtualService types.VirtualServiceCRD
-	err = json.Unmarshal(result2, &originalVirtualService)
-	if err != nil {
-		return err
-	}
-	originalVirtualService.Spec = preprocessObject.VirtualService.Spec
-	updatedjson, err := json.Marshal(originalVirtualService)
+	updatedjson, err := updateVirtualService(result2, virtualServiceName, versionWeights)
 	if err != nil {
 		return err
 	}
@@ -232,7 +396,7 @@ func createOrUpdateVirtualService(namespace string, istioClient *rest.RESTClient
 }
 
 func initIstioClient() (*rest.RESTClient, error) {
-	restConfig := config.GetArenaConfiger().GetRestConfig()
+	restConfig := rest.CopyConfig(config.GetArenaConfiger().GetRestConfig())
 	istioAPIGroupVersion := schema.GroupVersion{
 		Group:   "networking.istio.io",
 		Version: "v1alpha3",
@@ -269,7 +433,7 @@ func getVirtualServiceWeight(istioClient *rest.RESTClient, namespace string, vir
 		return weights, nil
 	}
 	log.Debugf("original virtualservice: %s", object)
-	var virtualService types.VirtualServiceCRD
+	var virtualService virtualServiceObject
 	err = json.Unmarshal(object, &virtualService)
 	if err != nil {
 		return nil, err
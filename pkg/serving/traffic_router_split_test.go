@@ -0,0 +1,96 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+func TestUpdateVirtualService(t *testing.T) {
+	versionWeights := []types.ServingVersionWeight{
+		{Version: "v1", Weight: 80},
+		{Version: "v2", Weight: 20},
+	}
+	tests := []struct {
+		name         string
+		existing     string
+		wantHosts    []string
+		wantGateways []string
+	}{
+		{
+			name:         "virtual service created by traffic-split",
+			existing:     `{"kind":"VirtualService","metadata":{"name":"fast-rcnn","namespace":"default","resourceVersion":"12"},"spec":{"hosts":["*"],"gateways":["fast-rcnn-gateway"],"http":[{"route":[{"destination":{"host":"fast-rcnn","subset":"subset-v1"},"weight":100}]}]}}`,
+			wantHosts:    []string{"*"},
+			wantGateways: []string{"fast-rcnn-gateway"},
+		},
+		{
+			name:         "virtual service created by expose-service",
+			existing:     `{"kind":"VirtualService","metadata":{"name":"fast-rcnn","namespace":"default","resourceVersion":"12"},"spec":{"hosts":["fast-rcnn.default.example.com"],"gateways":["fast-rcnn-gateway"],"http":[{"route":[{"destination":{"host":"fast-rcnn-v1","port":{"number":8500}},"weight":100}]}],"exportTo":["*"]}}`,
+			wantHosts:    []string{"fast-rcnn.default.example.com"},
+			wantGateways: []string{"fast-rcnn-gateway"},
+		},
+		{
+			name:         "virtual service without hosts",
+			existing:     `{"kind":"VirtualService","metadata":{"name":"fast-rcnn","namespace":"default","resourceVersion":"12"},"spec":{}}`,
+			wantHosts:    []string{"*"},
+			wantGateways: []string{"fast-rcnn-gateway"},
+		},
+	}
+	for _, test := range tests {
+		data, err := updateVirtualService([]byte(test.existing), "fast-rcnn", versionWeights)
+		if err != nil {
+			t.Fatalf("%v: unexpected error: %v", test.name, err)
+		}
+		var body struct {
+			Metadata struct {
+				ResourceVersion string `json:"resourceVersion"`
+			} `json:"metadata"`
+			Spec map[string]json.RawMessage `json:"spec"`
+		}
+		if err := json.Unmarshal(data, &body); err != nil {
+			t.Fatalf("%v: failed to unmarshal %s: %v", test.name, data, err)
+		}
+		if body.Metadata.ResourceVersion != "12" {
+			t.Errorf("%v: the resource version is %q, want %q", test.name, body.Metadata.ResourceVersion, "12")
+		}
+		if _, ok := body.Spec["http"]; !ok {
+			t.Fatalf("%v: no http routes in %s", test.name, data)
+		}
+		var updated virtualServiceObject
+		if err := json.Unmarshal(data, &updated); err != nil {
+			t.Fatalf("%v: failed to unmarshal %s: %v", test.name, data, err)
+		}
+		if !reflect.DeepEqual(updated.Spec.Hosts, test.wantHosts) {
+			t.Errorf("%v: the hosts are %v, want %v", test.name, updated.Spec.Hosts, test.wantHosts)
+		}
+		if !reflect.DeepEqual(updated.Spec.Gateways, test.wantGateways) {
+			t.Errorf("%v: the gateways are %v, want %v", test.name, updated.Spec.Gateways, test.wantGateways)
+		}
+		weights := map[string]int32{}
+		for _, h := range updated.Spec.Http {
+			for _, route := range h.Route {
+				weights[route.Destination.Subset] = route.Weight
+			}
+		}
+		want := map[string]int32{"subset-v1": 80, "subset-v2": 20}
+		if !reflect.DeepEqual(weights, want) {
+			t.Errorf("%v: the weights are %v, want %v", test.name, weights, want)
+		}
+	}
+}
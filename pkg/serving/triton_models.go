@@ -0,0 +1,214 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serving
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/go-resty/resty/v2"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/kubectl/pkg/util/podutils"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+)
+
+// tritonError is the error response of the triton inference server
+type tritonError struct {
+	Error string `json:"error"`
+}
+
+// ListTritonModels returns the models in the model repository of the triton serving job and their states,
+// each ready pod of the job is queried,the models are grouped by their states and the replicas reporting them
+func ListTritonModels(job ServingJob) ([]types.TritonModel, error) {
+	clients, err := newTritonPodClients(job)
+	if err != nil {
+		return nil, err
+	}
+	models := []types.TritonModel{}
+	replicas := map[types.TritonModel]int{}
+	for _, podName := range sortedTritonPodNames(clients) {
+		resp, err := clients[podName].R().SetBody("{}").Post("/v2/repository/index")
+		if err := checkTritonResponse(resp, err); err != nil {
+			return nil, fmt.Errorf("failed to list the models of serving job %v on pod %v: %v", job.Name(), podName, err)
+		}
+		podModels := []types.TritonModel{}
+		if err := json.Unmarshal(resp.Body(), &podModels); err != nil {
+			return nil, fmt.Errorf("failed to parse the model repository index of serving job %v on pod %v: %v", job.Name(), podName, err)
+		}
+		for _, m := range podModels {
+			if replicas[m] == 0 {
+				models = append(models, m)
+			}
+			replicas[m]++
+		}
+	}
+	for i := range models {
+		count := replicas[models[i]]
+		models[i].Replicas = fmt.Sprintf("%v/%v", count, len(clients))
+	}
+	return models, nil
+}
+
+// LoadTritonModel loads or reloads the model from the model repository on every ready pod,
+// the triton server should be started with --model-control-mode=explicit
+func LoadTritonModel(job ServingJob, model string) error {
+	if err := postTritonModelControl(job, model, "load"); err != nil {
+		return fmt.Errorf("failed to load model %v of serving job %v: %v", model, job.Name(), err)
+	}
+	log.Infof("the model %v of serving job %v has been loaded", model, job.Name())
+	return nil
+}
+
+// UnloadTritonModel unloads the model on every ready pod,the model is still kept in the model repository
+func UnloadTritonModel(job ServingJob, model string) error {
+	if err := postTritonModelControl(job, model, "unload"); err != nil {
+		return fmt.Errorf("failed to unload model %v of serving job %v: %v", model, job.Name(), err)
+	}
+	log.Infof("the model %v of serving job %v has been unloaded", model, job.Name())
+	return nil
+}
+
+// postTritonModelControl sends the load or unload request to every ready pod,
+// the request is not stopped by the failed pods and the errors of all the pods are returned
+func postTritonModelControl(job ServingJob, model, action string) error {
+	clients, err := newTritonPodClients(job)
+	if err != nil {
+		return err
+	}
+	failures := []string{}
+	for _, podName := range sortedTritonPodNames(clients) {
+		resp, err := clients[podName].R().SetBody("{}").Post(fmt.Sprintf("/v2/repository/models/%v/%v", url.PathEscape(model), action))
+		if err := checkTritonResponse(resp, err); err != nil {
+			failures = append(failures, fmt.Sprintf("pod %v: %v", podName, err))
+			continue
+		}
+		log.Debugf("%v the model %v on pod %v successfully", action, model, podName)
+	}
+	if len(failures) != 0 {
+		return fmt.Errorf("failed on %v of %v pods: %v", len(failures), len(clients), strings.Join(failures, "; "))
+	}
+	return nil
+}
+
+// newTritonPodClients returns the clients of the restful port of the ready pods of the triton serving job,
+// the requests are sent through the pod proxy of the api server,because the service proxy only reaches one of the pods
+func newTritonPodClients(job ServingJob) (map[string]*resty.Client, error) {
+	if job.Type() != types.TritonServingJob {
+		return nil, fmt.Errorf("the serving job %v is a %v job,only triton serving job supports the model repository api", job.Name(), job.Type())
+	}
+	target, err := getServingJobInvokeTarget(job)
+	if err != nil {
+		return nil, err
+	}
+	servicePort := v1.ServicePort{Port: target.port}
+	for _, p := range target.service.Spec.Ports {
+		if p.Port == target.port {
+			servicePort = p
+		}
+	}
+	clients := map[string]*resty.Client{}
+	notReady := []string{}
+	for _, pod := range job.Pods() {
+		if !podutils.IsPodReady(pod) {
+			notReady = append(notReady, pod.Name)
+			continue
+		}
+		clients[pod.Name] = newPodProxyClient(pod, getServiceTargetPort(pod, servicePort))
+	}
+	if len(notReady) != 0 {
+		log.Warnf("the pods %v of serving job %v are not ready,they are skipped", strings.Join(notReady, ","), job.Name())
+	}
+	if len(clients) == 0 {
+		return nil, fmt.Errorf("not found the ready pods of serving job %v", job.Name())
+	}
+	return clients, nil
+}
+
+// getServiceTargetPort returns the container port of the pod which the service port targets
+func getServiceTargetPort(pod *v1.Pod, servicePort v1.ServicePort) int32 {
+	switch {
+	case servicePort.TargetPort.Type == intstr.Int && servicePort.TargetPort.IntVal != 0:
+		return servicePort.TargetPort.IntVal
+	case servicePort.TargetPort.Type == intstr.String && servicePort.TargetPort.StrVal != "":
+		for _, c := range pod.Spec.Containers {
+			for _, p := range c.Ports {
+				if p.Name == servicePort.TargetPort.StrVal {
+					return p.ContainerPort
+				}
+			}
+		}
+	}
+	return servicePort.Port
+}
+
+func sortedTritonPodNames(clients map[string]*resty.Client) []string {
+	podNames := []string{}
+	for podName := range clients {
+		podNames = append(podNames, podName)
+	}
+	sort.Strings(podNames)
+	return podNames
+}
+
+func checkTritonResponse(resp *resty.Response, err error) error {
+	if err != nil {
+		return err
+	}
+	if !resp.IsError() {
+		return nil
+	}
+	tritonErr := &tritonError{}
+	if err := json.Unmarshal(resp.Body(), tritonErr); err != nil || tritonErr.Error == "" {
+		return fmt.Errorf("%v", resp.Status())
+	}
+	return fmt.Errorf("%v: %v", resp.Status(), tritonErr.Error)
+}
+
+// PrintTritonModels prints the models in the model repository
+func PrintTritonModels(models []types.TritonModel, format types.FormatStyle) {
+	switch format {
+	case types.JsonFormat:
+		data, _ := json.MarshalIndent(models, "", "    ")
+		fmt.Printf("%v\n", string(data))
+		return
+	case types.YamlFormat:
+		data, _ := yaml.Marshal(models)
+		fmt.Printf("%v", string(data))
+		return
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "MODEL\tVERSION\tSTATE\tREPLICAS\tREASON\n")
+	for _, m := range models {
+		version := m.Version
+		if version == "" {
+			version = "N/A"
+		}
+		state := m.State
+		if state == "" {
+			state = "N/A"
+		}
+		fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\n", m.Name, version, state, m.Replicas, m.Reason)
+	}
+	w.Flush()
+}
@@ -11,6 +11,8 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/kubeflow/arena/pkg/apis/types"
 	"github.com/kubeflow/arena/pkg/util/kubectl"
@@ -22,6 +24,13 @@ const (
 	ResourceGPUCore   v1.ResourceName = "aliyun.com/gpu-core.percentage"
 )
 
+const (
+	seldonDeploymentResource   = "seldondeployments.machinelearning.seldon.io"
+	kfInferenceServiceResource = "inferenceservices.serving.kubeflow.org"
+	// tensorRTServerCommand is the command of the container generated by the trtserving chart
+	tensorRTServerCommand = "/opt/tensorrtserver/bin/trtserver"
+)
+
 func UpdateTensorflowServing(args *types.UpdateTensorFlowServingArgs) error {
 	deploy, err := findAndBuildDeployment(&args.CommonUpdateServingArgs)
 	if err != nil {
@@ -121,7 +130,14 @@ func UpdateTensorflowServing(args *types.UpdateTensorFlowServingArgs) error {
 		}
 	}
 
-	return updateDeployment(args.Name, args.Version, deploy)
+	hpa, err := buildServingAutoscaler(deploy, &args.AutoscalingArgs)
+	if err != nil {
+		return err
+	}
+	if err := updateDeployment(args.Name, args.Version, deploy); err != nil {
+		return err
+	}
+	return saveServingAutoscaler(hpa)
 }
 
 func UpdateTritonServing(args *types.UpdateTritonServingArgs) error {
@@ -201,7 +217,14 @@ func UpdateTritonServing(args *types.UpdateTritonServingArgs) error {
 		}
 	}
 
-	return updateDeployment(args.Name, args.Version, deploy)
+	hpa, err := buildServingAutoscaler(deploy, &args.AutoscalingArgs)
+	if err != nil {
+		return err
+	}
+	if err := updateDeployment(args.Name, args.Version, deploy); err != nil {
+		return err
+	}
+	return saveServingAutoscaler(hpa)
 }
 
 func UpdateCustomServing(args *types.UpdateCustomServingArgs) error {
@@ -257,7 +280,14 @@ func UpdateCustomServing(args *types.UpdateCustomServingArgs) error {
 		deploy.Spec.Template.Spec.Tolerations = tolerations
 	}
 
-	return updateDeployment(args.Name, args.Version, deploy)
+	hpa, err := buildServingAutoscaler(deploy, &args.AutoscalingArgs)
+	if err != nil {
+		return err
+	}
+	if err := updateDeployment(args.Name, args.Version, deploy); err != nil {
+		return err
+	}
+	return saveServingAutoscaler(hpa)
 }
 
 func UpdateKServe(args *types.UpdateKServeArgs) error {
@@ -314,6 +344,271 @@ func UpdateKServe(args *types.UpdateKServeArgs) error {
 	return updateInferenceService(args.Name, args.Version, inferenceService)
 }
 
+func UpdateTensorRTServing(args *types.UpdateTensorRTServingArgs) error {
+	deploy, err := findAndBuildDeployment(&args.CommonUpdateServingArgs)
+	if err != nil {
+		return err
+	}
+
+	if args.Command == "" && args.ModelStore != "" {
+		container := &deploy.Spec.Template.Spec.Containers[0]
+		// only the args of the trtserver started by the chart can be rewritten,
+		// the job submitted with a custom command should be updated by --command
+		if len(container.Command) != 1 || container.Command[0] != tensorRTServerCommand {
+			return fmt.Errorf("--model-store can only be updated for the serving job started by %v,please update the model store by --command", tensorRTServerCommand)
+		}
+		modelStoreArg := fmt.Sprintf("--model-store=%s", args.ModelStore)
+		found := false
+		for i, arg := range container.Args {
+			if strings.HasPrefix(arg, "--model-store=") {
+				container.Args[i] = modelStoreArg
+				found = true
+			}
+		}
+		if !found {
+			container.Args = append(container.Args, modelStoreArg)
+		}
+	}
+
+	if len(args.Annotations) > 0 {
+		for k, v := range args.Annotations {
+			deploy.Annotations[k] = v
+			deploy.Spec.Template.Annotations[k] = v
+		}
+	}
+
+	if len(args.Labels) > 0 {
+		for k, v := range args.Labels {
+			deploy.Labels[k] = v
+			deploy.Spec.Template.Labels[k] = v
+		}
+	}
+
+	updateServingScheduling(&deploy.Spec.Template.Spec, &args.CommonUpdateServingArgs)
+
+	return updateDeployment(args.Name, args.Version, deploy)
+}
+
+// UpdateSeldonServing updates the SeldonDeployment of the serving job,
+// only the first predictor and its first container are changed
+func UpdateSeldonServing(args *types.UpdateSeldonServingArgs) error {
+	if args.Command != "" {
+		return fmt.Errorf("--command is not supported to update the seldon serving job")
+	}
+	job, err := SearchServingJob(args.Namespace, args.Name, args.Version, args.Type)
+	if err != nil {
+		return err
+	}
+	if args.Version == "" {
+		args.Version = job.Convert2JobInfo().Version
+	}
+	obj, err := kubectl.GetResource(seldonDeploymentResource, args.Name, args.Namespace)
+	if err != nil {
+		return err
+	}
+	predictors, found, err := unstructured.NestedSlice(obj.Object, "spec", "predictors")
+	if err != nil {
+		return err
+	}
+	if !found || len(predictors) == 0 {
+		return fmt.Errorf("not found the predictor of seldon deployment %v", args.Name)
+	}
+	predictor := predictors[0].(map[string]interface{})
+
+	componentSpecs, found, err := unstructured.NestedSlice(predictor, "componentSpecs")
+	if err != nil {
+		return err
+	}
+	if !found || len(componentSpecs) == 0 {
+		return fmt.Errorf("not found the component spec of seldon deployment %v", args.Name)
+	}
+	componentSpec := componentSpecs[0].(map[string]interface{})
+	podSpecObj, _, err := unstructured.NestedMap(componentSpec, "spec")
+	if err != nil {
+		return err
+	}
+	podSpec := &v1.PodSpec{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(podSpecObj, podSpec); err != nil {
+		return err
+	}
+	if len(podSpec.Containers) == 0 {
+		return fmt.Errorf("not found the container of seldon deployment %v", args.Name)
+	}
+	updateServingContainer(&podSpec.Containers[0], &args.CommonUpdateServingArgs)
+	syncResourceRequests(&podSpec.Containers[0], &args.CommonUpdateServingArgs)
+	updateServingScheduling(podSpec, &args.CommonUpdateServingArgs)
+	if podSpecObj, err = runtime.DefaultUnstructuredConverter.ToUnstructured(podSpec); err != nil {
+		return err
+	}
+	componentSpec["spec"] = podSpecObj
+	componentSpecs[0] = componentSpec
+	predictor["componentSpecs"] = componentSpecs
+
+	if args.Replicas > 0 {
+		predictor["replicas"] = int64(args.Replicas)
+	}
+	if args.ModelUri != "" {
+		if err := unstructured.SetNestedField(predictor, args.ModelUri, "graph", "modelUri"); err != nil {
+			return err
+		}
+	}
+	if args.Implementation != "" {
+		if err := unstructured.SetNestedField(predictor, args.Implementation, "graph", "implementation"); err != nil {
+			return err
+		}
+	}
+	// the labels of the predictor are propagated to the deployment of the serving job
+	if len(args.Labels) > 0 {
+		labels, _, err := unstructured.NestedStringMap(predictor, "labels")
+		if err != nil {
+			return err
+		}
+		if err := unstructured.SetNestedStringMap(predictor, mergeStringMap(labels, args.Labels), "labels"); err != nil {
+			return err
+		}
+	}
+	predictors[0] = predictor
+	if err := unstructured.SetNestedSlice(obj.Object, predictors, "spec", "predictors"); err != nil {
+		return err
+	}
+
+	obj.SetAnnotations(mergeStringMap(obj.GetAnnotations(), args.Annotations))
+	obj.SetLabels(mergeStringMap(obj.GetLabels(), args.Labels))
+
+	return updateServingResource(args.Name, args.Version, seldonDeploymentResource, obj)
+}
+
+// UpdateKFServing updates the default predictor of the kfserving inference service,
+// the replicas is the minimum replicas of the predictor
+func UpdateKFServing(args *types.UpdateKFServingArgs) error {
+	if args.Command != "" {
+		return fmt.Errorf("--command is not supported to update the kfserving job")
+	}
+	if len(args.NodeSelectors) > 0 || len(args.Tolerations) > 0 {
+		return fmt.Errorf("--selector and --toleration are not supported to update the kfserving job")
+	}
+	job, err := SearchServingJob(args.Namespace, args.Name, args.Version, args.Type)
+	if err != nil {
+		return err
+	}
+	if args.Version == "" {
+		args.Version = job.Convert2JobInfo().Version
+	}
+	name := fmt.Sprintf("%s-%s", args.Name, args.Version)
+	obj, err := kubectl.GetResource(kfInferenceServiceResource, name, args.Namespace)
+	if err != nil {
+		return err
+	}
+	predictor, found, err := unstructured.NestedMap(obj.Object, "spec", "default", "predictor")
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("not found the default predictor of inference service %v", name)
+	}
+	// the predictor has only one model spec keyed by the model type,like tensorflow or custom
+	modelType := ""
+	for key, value := range predictor {
+		if spec, ok := value.(map[string]interface{}); ok {
+			if _, ok := spec["container"]; ok {
+				modelType = key
+			}
+		}
+	}
+	if modelType == "" {
+		return fmt.Errorf("not found the model container of inference service %v", name)
+	}
+	modelSpec := predictor[modelType].(map[string]interface{})
+
+	container := &v1.Container{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(modelSpec["container"].(map[string]interface{}), container); err != nil {
+		return err
+	}
+	updateServingContainer(container, &args.CommonUpdateServingArgs)
+	syncResourceRequests(container, &args.CommonUpdateServingArgs)
+	containerObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(container)
+	if err != nil {
+		return err
+	}
+	modelSpec["container"] = containerObj
+	if args.StorageUri != "" {
+		modelSpec["storageUri"] = args.StorageUri
+	}
+	predictor[modelType] = modelSpec
+
+	if args.Replicas > 0 {
+		predictor["minReplicas"] = int64(args.Replicas)
+	}
+	if err := unstructured.SetNestedMap(obj.Object, predictor, "spec", "default", "predictor"); err != nil {
+		return err
+	}
+
+	obj.SetAnnotations(mergeStringMap(obj.GetAnnotations(), args.Annotations))
+	obj.SetLabels(mergeStringMap(obj.GetLabels(), args.Labels))
+
+	return updateServingResource(args.Name, args.Version, kfInferenceServiceResource, obj)
+}
+
+// updateServingScheduling merges the node selectors and tolerations into the pod spec
+func updateServingScheduling(podSpec *v1.PodSpec, args *types.CommonUpdateServingArgs) {
+	if len(args.NodeSelectors) > 0 {
+		podSpec.NodeSelector = mergeStringMap(podSpec.NodeSelector, args.NodeSelectors)
+	}
+
+	if len(args.Tolerations) > 0 {
+		exist := map[string]bool{}
+		var tolerations []v1.Toleration
+		for _, toleration := range args.Tolerations {
+			tolerations = append(tolerations, v1.Toleration{
+				Key:      toleration.Key,
+				Value:    toleration.Value,
+				Effect:   v1.TaintEffect(toleration.Effect),
+				Operator: v1.TolerationOperator(toleration.Operator),
+			})
+			exist[toleration.Key+toleration.Value] = true
+		}
+
+		for _, preToleration := range podSpec.Tolerations {
+			if !exist[preToleration.Key+preToleration.Value] {
+				tolerations = append(tolerations, preToleration)
+			}
+		}
+		podSpec.Tolerations = tolerations
+	}
+}
+
+// syncResourceRequests copies the limits to the requests as the seldon and kfserving charts do,
+// the gpu resources replaced by the update are removed from the requests
+func syncResourceRequests(container *v1.Container, args *types.CommonUpdateServingArgs) {
+	requests := container.Resources.Requests
+	if requests == nil {
+		requests = make(map[v1.ResourceName]resource.Quantity)
+	}
+	if args.GPUCount > 0 {
+		delete(requests, ResourceGPUMemory)
+	}
+	if args.GPUMemory > 0 || (args.GPUCore > 0 && args.GPUCore%5 == 0) {
+		delete(requests, ResourceGPU)
+	}
+	for k, v := range container.Resources.Limits {
+		requests[k] = v
+	}
+	container.Resources.Requests = requests
+}
+
+func mergeStringMap(dst, src map[string]string) map[string]string {
+	if len(src) == 0 {
+		return dst
+	}
+	if dst == nil {
+		dst = map[string]string{}
+	}
+	for k, v := range src {
+		dst[k] = v
+	}
+	return dst
+}
+
 func findAndBuildDeployment(args *types.CommonUpdateServingArgs) (*appsv1.Deployment, error) {
 	job, err := SearchServingJob(args.Namespace, args.Name, args.Version, args.Type)
 	if err != nil {
@@ -332,6 +627,8 @@ func findAndBuildDeployment(args *types.CommonUpdateServingArgs) (*appsv1.Deploy
 		suffix = "tritoninferenceserver"
 	case types.CustomServingJob:
 		suffix = "custom-serving"
+	case types.TRTServingJob:
+		suffix = "tensorrt-serving"
 	default:
 		return nil, fmt.Errorf("invalid serving job type [%s]", args.Type)
 	}
@@ -342,16 +639,36 @@ func findAndBuildDeployment(args *types.CommonUpdateServingArgs) (*appsv1.Deploy
 		return nil, err
 	}
 
-	if args.Image != "" {
-		deploy.Spec.Template.Spec.Containers[0].Image = args.Image
-	}
-
-	if args.Replicas >= 0 {
+	// the replicas is not changed if --replicas is not set
+	if args.Replicas > 0 {
 		replicas := int32(args.Replicas)
 		deploy.Spec.Replicas = &replicas
 	}
+	updateServingContainer(&deploy.Spec.Template.Spec.Containers[0], args)
 
-	resourceLimits := deploy.Spec.Template.Spec.Containers[0].Resources.Limits
+	if args.Command != "" {
+		// commands: sh -c xxx
+		commands := deploy.Spec.Template.Spec.Containers[0].Command
+		shell := "sh"
+		// the tensorrt serving container runs the server binary directly
+		if len(commands) > 1 && commands[1] == "-c" {
+			shell = commands[0]
+		}
+		newCommands := []string{shell, "-c", args.Command}
+		deploy.Spec.Template.Spec.Containers[0].Command = newCommands
+		deploy.Spec.Template.Spec.Containers[0].Args = []string{}
+	}
+
+	return deploy, nil
+}
+
+// updateServingContainer applies the image, resource limits and envs to the serving container
+func updateServingContainer(container *v1.Container, args *types.CommonUpdateServingArgs) {
+	if args.Image != "" {
+		container.Image = args.Image
+	}
+
+	resourceLimits := container.Resources.Limits
 	if resourceLimits == nil {
 		resourceLimits = make(map[v1.ResourceName]resource.Quantity)
 	}
@@ -378,7 +695,7 @@ func findAndBuildDeployment(args *types.CommonUpdateServingArgs) (*appsv1.Deploy
 	if args.Memory != "" {
 		resourceLimits[v1.ResourceMemory] = resource.MustParse(args.Memory)
 	}
-	deploy.Spec.Template.Spec.Containers[0].Resources.Limits = resourceLimits
+	container.Resources.Limits = resourceLimits
 
 	var newEnvs []v1.EnvVar
 	exist := map[string]bool{}
@@ -392,23 +709,12 @@ func findAndBuildDeployment(args *types.CommonUpdateServingArgs) (*appsv1.Deploy
 			exist[k] = true
 		}
 	}
-	for _, env := range deploy.Spec.Template.Spec.Containers[0].Env {
+	for _, env := range container.Env {
 		if !exist[env.Name] {
 			newEnvs = append(newEnvs, env)
 		}
 	}
-	deploy.Spec.Template.Spec.Containers[0].Env = newEnvs
-
-	if args.Command != "" {
-		// commands: sh -c xxx
-		commands := deploy.Spec.Template.Spec.Containers[0].Command
-		shell := commands[0]
-		newCommands := []string{shell, "-c", args.Command}
-		deploy.Spec.Template.Spec.Containers[0].Command = newCommands
-		deploy.Spec.Template.Spec.Containers[0].Args = []string{}
-	}
-
-	return deploy, nil
+	container.Env = newEnvs
 }
 
 func findAndBuildInferenceService(args *types.UpdateKServeArgs) (*kservev1beta1.InferenceService, error) {
@@ -466,6 +772,17 @@ func updateDeployment(name, version string, deploy *appsv1.Deployment) error {
 	return err
 }
 
+func updateServingResource(name, version, resource string, obj *unstructured.Unstructured) error {
+	err := kubectl.UpdateResource(resource, obj)
+	if err != nil {
+		log.Errorf("The serving job %s with version %s update failed", name, version)
+		return err
+	}
+
+	log.Infof("The serving job %s with version %s has been updated successfully", name, version)
+	return nil
+}
+
 func updateInferenceService(name, version string, inferenceService *kservev1beta1.InferenceService) error {
 	err := kubectl.UpdateInferenceService(inferenceService)
 	if err != nil {
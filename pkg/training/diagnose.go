@@ -0,0 +1,294 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/util"
+)
+
+// the container which syncs the code of job given by --sync-mode
+const initCodeContainerName = "init-code"
+
+// the scores rank the findings,the finding with higher score is more likely the root cause
+var diagnosisScores = map[types.DiagnosisReason]int{
+	types.DiagnosisOOMKilled:             100,
+	types.DiagnosisImagePullFailed:       95,
+	types.DiagnosisCreateContainerFailed: 90,
+	types.DiagnosisInitCodeSyncFailed:    90,
+	types.DiagnosisVolumeMountFailed:     85,
+	types.DiagnosisUnschedulable:         80,
+	types.DiagnosisEvicted:               75,
+	types.DiagnosisPreempted:             75,
+	types.DiagnosisNodeLost:              70,
+	types.DiagnosisCrashLoopBackOff:      65,
+	types.DiagnosisNonZeroExitCode:       60,
+	types.DiagnosisUnknownFailure:        0,
+}
+
+var diagnosisHints = map[types.DiagnosisReason]string{
+	types.DiagnosisOOMKilled:             "the container uses more memory than its limit,increase the memory of the job or reduce the batch size",
+	types.DiagnosisImagePullFailed:       "check the image name,the network of the node and the image pull secrets given by --image-pull-secret",
+	types.DiagnosisCreateContainerFailed: "check the configmaps,secrets and volumes which are referenced by the job",
+	types.DiagnosisInitCodeSyncFailed:    "failed to sync the code,check --sync-mode,--sync-source and the network of the node",
+	types.DiagnosisVolumeMountFailed:     "check the pvcs given by --data and the volumes of the node",
+	types.DiagnosisUnschedulable:         "no node can run the pod,check the resources of the cluster,--selector and --toleration of the job",
+	types.DiagnosisEvicted:               "the pod is evicted by the node under resource pressure,check the disk and memory of the node",
+	types.DiagnosisPreempted:             "the pod is preempted by a pod with higher priority,submit the job with --priority or wait for free resources",
+	types.DiagnosisNodeLost:              "the node of the pod is lost or shut down,submit the job again",
+	types.DiagnosisCrashLoopBackOff:      "the container keeps crashing,check the logs of the instance by 'arena logs'",
+	types.DiagnosisNonZeroExitCode:       "the container exits with error,check the logs of the instance by 'arena logs'",
+	types.DiagnosisUnknownFailure:        "no problem is found in the pods,check the events of the job by 'arena get -e' and the logs of the training operator",
+}
+
+// the hints of the exit codes which are set by the system
+var exitCodeHints = map[int32]string{
+	126: "the command can not be executed,check the permission of the command",
+	127: "the command is not found,check the command and the image",
+	137: "the container is killed by SIGKILL,it may be killed for out of memory or by the system",
+	139: "the container is crashed by segmentation fault",
+	143: "the container is terminated by SIGTERM",
+}
+
+var imagePullFailedReasons = []string{"ErrImagePull", "ImagePullBackOff", "InvalidImageName", "ErrImageNeverPull"}
+
+var createContainerFailedReasons = []string{"CreateContainerConfigError", "CreateContainerError", "RunContainerError"}
+
+// DiagnoseTrainingJob inspects the pods and the events of the training job,and returns the ranked findings
+func DiagnoseTrainingJob(job TrainingJob) *types.TrainingJobDiagnosis {
+	diagnosis := &types.TrainingJobDiagnosis{
+		Name:      job.Name(),
+		Namespace: job.Namespace(),
+		Trainer:   job.Trainer(),
+		Status:    types.TrainingJobStatus(job.GetStatus()),
+		Findings:  []types.DiagnosisFinding{},
+	}
+	events := getWarningEventsOfPods(job)
+	for _, pod := range job.AllPods() {
+		findings := diagnosePod(pod)
+		// the problems which are only reported by the events
+		for _, finding := range diagnoseEvents(pod.Name, events[pod.Name]) {
+			if !hasDiagnosisReason(findings, finding.Reason) {
+				findings = append(findings, finding)
+			}
+		}
+		for _, finding := range findings {
+			finding.Score = diagnosisScores[finding.Reason]
+			if finding.Hint == "" {
+				finding.Hint = diagnosisHints[finding.Reason]
+			}
+			finding.Events = events[pod.Name]
+			diagnosis.Findings = append(diagnosis.Findings, finding)
+		}
+	}
+	if len(diagnosis.Findings) == 0 && diagnosis.Status == types.TrainingJobFailed {
+		diagnosis.Findings = append(diagnosis.Findings, types.DiagnosisFinding{
+			Reason:  types.DiagnosisUnknownFailure,
+			Score:   diagnosisScores[types.DiagnosisUnknownFailure],
+			Message: fmt.Sprintf("the job is failed,but no problem is found in its %v pods", len(job.AllPods())),
+			Hint:    diagnosisHints[types.DiagnosisUnknownFailure],
+		})
+	}
+	sort.SliceStable(diagnosis.Findings, func(i, j int) bool {
+		return diagnosis.Findings[i].Score > diagnosis.Findings[j].Score
+	})
+	return diagnosis
+}
+
+// diagnosePod returns the problems of the pod,the score and the events of the findings are not set
+func diagnosePod(pod *v1.Pod) []types.DiagnosisFinding {
+	findings := []types.DiagnosisFinding{}
+	// addFinding returns the index of the finding,the same problem of a container is only added once
+	addFinding := func(reason types.DiagnosisReason, container, message string) int {
+		for i, finding := range findings {
+			if finding.Reason == reason && finding.Container == container {
+				return i
+			}
+		}
+		findings = append(findings, types.DiagnosisFinding{
+			Reason:    reason,
+			Instance:  pod.Name,
+			Container: container,
+			Message:   message,
+		})
+		return len(findings) - 1
+	}
+	switch {
+	case pod.Status.Reason == "Evicted":
+		addFinding(types.DiagnosisEvicted, "", pod.Status.Message)
+	case isPodLostWithNode(pod):
+		addFinding(types.DiagnosisNodeLost, "", pod.Status.Message)
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodScheduled && condition.Status == v1.ConditionFalse && condition.Reason == v1.PodReasonUnschedulable {
+			addFinding(types.DiagnosisUnschedulable, "", condition.Message)
+		}
+		if condition.Type == v1.DisruptionTarget && condition.Status == v1.ConditionTrue && condition.Reason == "PreemptionByKubeScheduler" {
+			addFinding(types.DiagnosisPreempted, "", condition.Message)
+		}
+	}
+	statuses := append([]v1.ContainerStatus{}, pod.Status.InitContainerStatuses...)
+	statuses = append(statuses, pod.Status.ContainerStatuses...)
+	for _, status := range statuses {
+		if waiting := status.State.Waiting; waiting != nil {
+			message := strings.TrimSpace(fmt.Sprintf("[%v] %v", waiting.Reason, waiting.Message))
+			switch {
+			case util.StringInSlice(waiting.Reason, imagePullFailedReasons):
+				addFinding(types.DiagnosisImagePullFailed, status.Name, message)
+			case util.StringInSlice(waiting.Reason, createContainerFailedReasons):
+				addFinding(types.DiagnosisCreateContainerFailed, status.Name, message)
+			case waiting.Reason == "CrashLoopBackOff" && status.Name == initCodeContainerName:
+				addFinding(types.DiagnosisInitCodeSyncFailed, status.Name, message)
+			// the exit code of the last termination is reported if it is found
+			case waiting.Reason == "CrashLoopBackOff" && status.LastTerminationState.Terminated == nil:
+				addFinding(types.DiagnosisCrashLoopBackOff, status.Name, message)
+			}
+		}
+		// the last termination is checked when the container is restarted
+		terminated := status.State.Terminated
+		if terminated == nil {
+			terminated = status.LastTerminationState.Terminated
+		}
+		if terminated == nil || terminated.ExitCode == 0 {
+			continue
+		}
+		message := fmt.Sprintf("[%v] exit code %v", terminated.Reason, terminated.ExitCode)
+		if terminated.Message != "" {
+			message = fmt.Sprintf("%v: %v", message, terminated.Message)
+		}
+		var finding types.DiagnosisReason
+		switch {
+		case terminated.Reason == "OOMKilled":
+			finding = types.DiagnosisOOMKilled
+		case status.Name == initCodeContainerName:
+			finding = types.DiagnosisInitCodeSyncFailed
+		default:
+			finding = types.DiagnosisNonZeroExitCode
+		}
+		i := addFinding(finding, status.Name, message)
+		findings[i].ExitCode = terminated.ExitCode
+		if hint, ok := exitCodeHints[terminated.ExitCode]; ok && finding == types.DiagnosisNonZeroExitCode {
+			findings[i].Hint = hint
+		}
+	}
+	return findings
+}
+
+// getWarningEventsOfPods returns the warning events of the pods of job,the key is the name of pod
+func getWarningEventsOfPods(job TrainingJob) map[string][]string {
+	result := map[string][]string{}
+	eventsMap, err := GetResourcesEvents(config.GetArenaConfiger().GetClientSet(), job.Namespace(), job.Resources())
+	if err != nil {
+		log.Debugf("failed to get the events of job %v: %v", job.Name(), err)
+		return result
+	}
+	for name, events := range eventsMap {
+		for _, event := range events {
+			if event.Type != v1.EventTypeWarning {
+				continue
+			}
+			result[name] = append(result[name], fmt.Sprintf("[%v] %v", event.Reason, event.Message))
+		}
+	}
+	return result
+}
+
+// diagnoseEvents returns the problems which are reported by the warning events of the pod,
+// like the failures of mounting volumes
+func diagnoseEvents(pod string, events []string) []types.DiagnosisFinding {
+	findings := []types.DiagnosisFinding{}
+	for _, event := range events {
+		var reason types.DiagnosisReason
+		switch {
+		case strings.HasPrefix(event, "[FailedMount]"), strings.HasPrefix(event, "[FailedAttachVolume]"):
+			reason = types.DiagnosisVolumeMountFailed
+		case strings.HasPrefix(event, "[Preempted]"):
+			reason = types.DiagnosisPreempted
+		default:
+			continue
+		}
+		if hasDiagnosisReason(findings, reason) {
+			continue
+		}
+		findings = append(findings, types.DiagnosisFinding{
+			Reason:   reason,
+			Instance: pod,
+			Message:  event,
+		})
+	}
+	return findings
+}
+
+func hasDiagnosisReason(findings []types.DiagnosisFinding, reason types.DiagnosisReason) bool {
+	for _, finding := range findings {
+		if finding.Reason == reason {
+			return true
+		}
+	}
+	return false
+}
+
+// PrintTrainingJobDiagnosis prints the diagnosis report of the training job
+func PrintTrainingJobDiagnosis(diagnosis *types.TrainingJobDiagnosis, format types.FormatStyle) error {
+	switch format {
+	case types.JsonFormat:
+		data, err := json.MarshalIndent(diagnosis, "", "    ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	case types.YamlFormat:
+		data, err := yaml.Marshal(diagnosis)
+		if err != nil {
+			return err
+		}
+		fmt.Print(string(data))
+		return nil
+	}
+	fmt.Printf("Diagnosis of job %v (%v, %v):\n", diagnosis.Name, strings.ToUpper(string(diagnosis.Trainer)), diagnosis.Status)
+	if len(diagnosis.Findings) == 0 {
+		fmt.Println("  No problems are found in the pods of the job")
+		return nil
+	}
+	for i, finding := range diagnosis.Findings {
+		instance := finding.Instance
+		if finding.Container != "" {
+			instance = fmt.Sprintf("%v/%v", finding.Instance, finding.Container)
+		}
+		if instance == "" {
+			instance = "N/A"
+		}
+		fmt.Printf("  %v. %v (instance: %v)\n", i+1, finding.Reason, instance)
+		if finding.Message != "" {
+			fmt.Printf("     Message: %v\n", finding.Message)
+		}
+		fmt.Printf("     Hint:    %v\n", finding.Hint)
+		for _, event := range finding.Events {
+			fmt.Printf("     Event:   %v\n", event)
+		}
+	}
+	return nil
+}
@@ -209,6 +209,11 @@ func printSingleJobHelper(job *types.TrainingJobInfo, resouce []Resource, showEv
 		lines = append(lines, "  Your tensorboard will be available on: ")
 		lines = append(lines, fmt.Sprintf("  %v", job.Tensorboard))
 	}
+	if job.Dashboard != "" {
+		lines = append(lines, "", "Dashboard:")
+		lines = append(lines, "  Your dashboard will be available on: ")
+		lines = append(lines, fmt.Sprintf("  %v", job.Dashboard))
+	}
 	chiefPodNamespace := ""
 	if job.ChiefName != "" {
 		chiefPodNamespace = job.Namespace
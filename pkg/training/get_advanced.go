@@ -142,9 +142,18 @@ func BuildJobInfo(job TrainingJob, showGPUs bool, services []*v1.Service, nodes
 		trainingJobInfo.CreationTimestamp = job.StartTime().Unix()
 	}
 
+	if j, ok := job.(dashboardJob); ok {
+		trainingJobInfo.Dashboard = j.DashboardURL()
+	}
+
 	return trainingJobInfo
 }
 
+// dashboardJob is implemented by the training jobs which have their own dashboard,like rayjob
+type dashboardJob interface {
+	DashboardURL() string
+}
+
 /**
 * getPriorityClass returns priority class name
  */
@@ -0,0 +1,122 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/util/kubeclient"
+)
+
+// the values which are generated by arena when submitting a job,
+// they should not be exported to the job spec
+var (
+	generatedSpecKeys = []string{
+		"podSecurityContext",
+		"isNonRoot",
+		"podGroupName",
+		"podGroupMinAvailable",
+		"hasGangScheduler",
+		"trainingOperatorCRD",
+	}
+	generatedSpecLabels = []string{
+		types.UserNameIdLabel,
+	}
+	generatedSpecAnnotations = []string{
+		types.UserNameNameLabel,
+		types.RequestGPUsOfJobAnnoKey,
+	}
+	// the envs which are set from the values by arena,they are kept if the user gives other values
+	generatedSpecEnvs = map[string]string{
+		"workers": "workers",
+		"gpus":    "gpuCount",
+	}
+)
+
+// GetTrainingJobSpec rebuilds the spec of a training job from the values which are kept
+// in the app configmap of the job when it is submitted
+func GetTrainingJobSpec(name, namespace string, jobType types.TrainingJobType) (*types.TrainingJobSpec, error) {
+	job, err := SearchTrainingJob(name, namespace, jobType)
+	if err != nil {
+		return nil, err
+	}
+	configName := fmt.Sprintf("%v-%v", job.Name(), job.Trainer())
+	configmap, err := kubeclient.GetConfigMap(namespace, configName)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, fmt.Errorf("not found the configmap %v of job %v,the job may not be submitted by arena", configName, name)
+		}
+		return nil, err
+	}
+	values := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(configmap.Data["values"]), &values); err != nil {
+		return nil, fmt.Errorf("failed to parse the values of job %v: %v", name, err)
+	}
+	// the master of pytorchjob is excluded from the workers when it is submitted
+	if workers, ok := values["workers"].(int); ok && job.Trainer() == types.PytorchTrainingJob {
+		values["workers"] = workers + 1
+	}
+	// the contents of the files given by --config-file are not kept in the values,
+	// the job can not be submitted again without them
+	if err := restoreConfigFiles(job, values); err != nil {
+		return nil, err
+	}
+	removeGeneratedSpecValues(values)
+	return &types.TrainingJobSpec{
+		Kind:      job.Trainer(),
+		Name:      job.Name(),
+		Namespace: namespace,
+		Spec:      values,
+	}, nil
+}
+
+func removeGeneratedSpecValues(values map[string]interface{}) {
+	for _, key := range generatedSpecKeys {
+		delete(values, key)
+	}
+	// NVIDIA_VISIBLE_DEVICES=void is added when the job requests no gpus
+	if envs, ok := values["envs"].(map[interface{}]interface{}); ok {
+		if envs["NVIDIA_VISIBLE_DEVICES"] == "void" {
+			delete(envs, "NVIDIA_VISIBLE_DEVICES")
+		}
+		for env, key := range generatedSpecEnvs {
+			if value, ok := envs[env]; ok && fmt.Sprint(value) == fmt.Sprint(values[key]) {
+				delete(envs, env)
+			}
+		}
+		if len(envs) == 0 {
+			delete(values, "envs")
+		}
+	}
+	removeGeneratedSpecItems(values, "labels", generatedSpecLabels)
+	removeGeneratedSpecItems(values, "annotations", generatedSpecAnnotations)
+}
+
+func removeGeneratedSpecItems(values map[string]interface{}, key string, items []string) {
+	m, ok := values[key].(map[interface{}]interface{})
+	if !ok {
+		return
+	}
+	for _, item := range items {
+		delete(m, item)
+	}
+	if len(m) == 0 {
+		delete(values, key)
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/util/kubectl"
+)
+
+// GetModelVersionRegistration returns the model version to register for the succeeded job which is submitted with
+// --register-model-on-success,it returns nil if the job is not succeeded,is not submitted with the option
+// or its model version has been registered
+func GetModelVersionRegistration(jobName, namespace string, jobType types.TrainingJobType) (*types.ModelVersionRegistration, error) {
+	job, err := SearchTrainingJob(jobName, namespace, jobType)
+	if err != nil {
+		return nil, err
+	}
+	if types.TrainingJobStatus(job.GetStatus()) != types.TrainingJobSucceeded {
+		return nil, nil
+	}
+	labels := job.GetLabels()
+	modelName := labels["modelName"]
+	// the model version has been registered when the job is submitted or by the previous observer
+	if modelName == "" || labels["modelVersion"] != "" {
+		return nil, nil
+	}
+	values, _, err := getTrainingJobValues(job)
+	if err != nil {
+		log.Debugf("skip registering the model of job %v/%v: %v", job.Namespace(), job.Name(), err)
+		return nil, nil
+	}
+	if registerOnSuccess, _ := values["registerModelOnSuccess"].(bool); !registerOnSuccess {
+		return nil, nil
+	}
+	source, _ := values["modelSource"].(string)
+	if source == "" {
+		return nil, fmt.Errorf("the model source of job %v/%v is not found", job.Namespace(), job.Name())
+	}
+	image, _ := values["image"].(string)
+	tags := []*types.ModelVersionTag{
+		{Key: "createdBy", Value: "arena"},
+		{Key: "jobName", Value: job.Name()},
+		{Key: "jobNamespace", Value: job.Namespace()},
+		{Key: "jobType", Value: string(job.Trainer())},
+		{Key: "jobUid", Value: job.Uid()},
+		{Key: "image", Value: image},
+		{Key: "gpus", Value: fmt.Sprintf("%v", job.RequestedGPU())},
+		{Key: "duration", Value: job.Duration().Round(time.Second).String()},
+	}
+	return &types.ModelVersionRegistration{
+		JobName:      job.Name(),
+		JobNamespace: job.Namespace(),
+		JobType:      job.Trainer(),
+		ModelName:    modelName,
+		Source:       source,
+		RunLink:      fmt.Sprintf("arena://trainingjobs/%v/%v/%v", job.Namespace(), job.Trainer(), job.Name()),
+		Description:  fmt.Sprintf("registered when the training job %v/%v succeeded", job.Namespace(), job.Name()),
+		Tags:         tags,
+	}, nil
+}
+
+// LabelRegisteredModelVersion adds the label modelVersion to the job,so the model version is registered only once
+func LabelRegisteredModelVersion(registration *types.ModelVersionRegistration, version string) error {
+	crdName, ok := trainingJobCRDs[registration.JobType]
+	if !ok {
+		return fmt.Errorf("the job type %v does not support registering model on success", registration.JobType)
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": map[string]string{"modelVersion": version},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return kubectl.MergePatchResource(crdName, registration.JobName, registration.JobNamespace, patch)
+}
@@ -0,0 +1,511 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	toolscache "k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/k8saccesser"
+	etv1alpha1 "github.com/kubeflow/arena/pkg/operators/et-operator/api/v1alpha1"
+	rayv1 "github.com/kubeflow/arena/pkg/operators/kuberay-operator/apis/ray/v1"
+	mpiv1alpha1 "github.com/kubeflow/arena/pkg/operators/mpi-operator/apis/kubeflow/v1alpha1"
+	pytorchv1 "github.com/kubeflow/arena/pkg/operators/pytorch-operator/apis/pytorch/v1"
+	sparkv1beta2 "github.com/kubeflow/arena/pkg/operators/spark-operator/apis/sparkoperator.k8s.io/v1beta2"
+	tfv1 "github.com/kubeflow/arena/pkg/operators/tf-operator/apis/tensorflow/v1"
+	kubeflowv1 "github.com/kubeflow/arena/pkg/operators/training-operator/apis/kubeflow/v1"
+	volcanov1alpha1 "github.com/kubeflow/arena/pkg/operators/volcano-operator/apis/batch/v1alpha1"
+	"github.com/kubeflow/arena/pkg/util"
+	"github.com/kubeflow/arena/pkg/util/kubeclient"
+	"github.com/kubeflow/arena/pkg/util/kubectl"
+	"github.com/kubeflow/arena/pkg/workflow"
+)
+
+// trainingJobCRDs are the crds of the training jobs which can be retried
+var trainingJobCRDs = map[types.TrainingJobType]string{
+	types.TFTrainingJob:        k8saccesser.TensorflowCRDName,
+	types.PytorchTrainingJob:   k8saccesser.PytorchCRDName,
+	types.MPITrainingJob:       k8saccesser.MPICRDName,
+	types.ETTrainingJob:        k8saccesser.ETCRDName,
+	types.DeepSpeedTrainingJob: k8saccesser.ETCRDName,
+	types.VolcanoTrainingJob:   k8saccesser.VolcanoCRDName,
+	types.SparkTrainingJob:     k8saccesser.SparkCRDName,
+	types.RayTrainingJob:       k8saccesser.RayJobCRDName,
+	types.XGBoostTrainingJob:   k8saccesser.XGBoostCRDName,
+	types.PaddleTrainingJob:    k8saccesser.PaddleCRDName,
+	types.JAXTrainingJob:       k8saccesser.JAXCRDName,
+}
+
+// the reasons of the pods which are lost with their nodes
+var nodeLostPodReasons = []string{"NodeLost", "NodeShutdown", "Terminated"}
+
+// retryKey is the key of the training job in the queue of the retry controller
+type retryKey struct {
+	trainingType types.TrainingJobType
+	namespace    string
+	name         string
+}
+
+// retryController resubmits the failed training jobs by their --max-attempts and --retry-on,
+// the jobs are watched by the shared informers of the cache in daemon mode
+type retryController struct {
+	args     types.RetryControllerArgs
+	trainers map[types.TrainingJobType]Trainer
+	queue    workqueue.RateLimitingInterface
+	// uids stores the uid of the job which is handled by the key,the job may be deleted and created again
+	uids map[retryKey]string
+	// failedAt stores the time when the failed jobs are found,the key is the uid of job
+	failedAt map[string]time.Time
+	// ignored stores the failed jobs which have no retry policy,the key is the uid of job
+	ignored map[string]bool
+}
+
+// RunRetryController watches the training jobs by the shared informers and submits
+// the next attempts of the failed jobs,it never returns
+func RunRetryController(args types.RetryControllerArgs) error {
+	accesser := k8saccesser.GetK8sResourceAccesser()
+	if accesser == nil || accesser.GetCacheClient() == nil {
+		return fmt.Errorf("the retry controller should be run in daemon mode,the informer cache is not enabled")
+	}
+	rc := &retryController{
+		args:     args,
+		trainers: map[types.TrainingJobType]Trainer{},
+		queue:    workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		uids:     map[retryKey]string{},
+		failedAt: map[string]time.Time{},
+		ignored:  map[string]bool{},
+	}
+	defer rc.queue.ShutDown()
+	for trainingType, trainer := range GetAllTrainers() {
+		if _, ok := trainingJobCRDs[trainingType]; !ok || !trainer.IsEnabled() {
+			continue
+		}
+		obj := newTrainingJobObject(trainer)
+		if obj == nil {
+			continue
+		}
+		informer, err := accesser.GetCacheClient().GetInformer(context.Background(), obj)
+		if err != nil {
+			return fmt.Errorf("failed to get the informer of %v: %v", trainingType, err)
+		}
+		if _, err := informer.AddEventHandlerWithResyncPeriod(rc.eventHandler(trainingType), args.ResyncPeriod); err != nil {
+			return fmt.Errorf("failed to watch the %v: %v", trainingType, err)
+		}
+		rc.trainers[trainingType] = trainer
+		log.Debugf("retry controller watches the %v", trainingType)
+	}
+	log.Infof("retry controller is started,resync period: %v,backoff: %v,max backoff: %v", args.ResyncPeriod, args.Backoff, args.MaxBackoff)
+	for rc.processNextKey() {
+	}
+	return nil
+}
+
+// newTrainingJobObject returns the object of the job crd served by the trainer,it is used to get the informer of the crd
+func newTrainingJobObject(trainer Trainer) client.Object {
+	switch t := trainer.(type) {
+	case *TensorFlowJobTrainer:
+		return &tfv1.TFJob{}
+	case *PyTorchJobTrainer:
+		return &pytorchv1.PyTorchJob{}
+	case *MPIJobTrainer:
+		return &mpiv1alpha1.MPIJob{}
+	case *ETJobTrainer, *DeepSpeedJobTrainer:
+		return &etv1alpha1.TrainingJob{}
+	case *VolcanoJobTrainer:
+		return &volcanov1alpha1.Job{}
+	case *SparkJobTrainer:
+		return &sparkv1beta2.SparkApplication{}
+	case *RayJobTrainer:
+		return &rayv1.RayJob{}
+	case *KubeflowJobTrainer:
+		switch t.kind.trainerType {
+		case types.MPITrainingJob:
+			return &kubeflowv1.MPIJob{}
+		case types.XGBoostTrainingJob:
+			return &kubeflowv1.XGBoostJob{}
+		case types.PaddleTrainingJob:
+			return &kubeflowv1.PaddleJob{}
+		case types.JAXTrainingJob:
+			return &kubeflowv1.JAXJob{}
+		}
+	}
+	return nil
+}
+
+// eventHandler adds the jobs of the training type to the queue,the jobs of etjob and deepspeedjob
+// share the crd,so they are distinguished by the label app
+func (rc *retryController) eventHandler(trainingType types.TrainingJobType) toolscache.ResourceEventHandler {
+	enqueue := func(obj interface{}, deleted bool) {
+		if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		job, ok := obj.(metav1.Object)
+		if !ok || job.GetLabels()["app"] != string(trainingType) {
+			return
+		}
+		if !rc.args.AllNamespaces && job.GetNamespace() != rc.args.Namespace {
+			return
+		}
+		// the job has been handled
+		if !deleted && job.GetAnnotations()[types.RetryStatusAnnotation] != "" {
+			return
+		}
+		rc.queue.Add(retryKey{trainingType: trainingType, namespace: job.GetNamespace(), name: job.GetName()})
+	}
+	return toolscache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { enqueue(obj, false) },
+		UpdateFunc: func(_, obj interface{}) { enqueue(obj, false) },
+		DeleteFunc: func(obj interface{}) { enqueue(obj, true) },
+	}
+}
+
+func (rc *retryController) processNextKey() bool {
+	item, shutdown := rc.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer rc.queue.Done(item)
+	key := item.(retryKey)
+	if err := rc.sync(key); err != nil {
+		log.Warnf("failed to retry the job %v/%v: %v", key.namespace, key.name, err)
+		rc.queue.AddRateLimited(key)
+		return true
+	}
+	rc.queue.Forget(key)
+	return true
+}
+
+// sync retries the job of the key if it is failed,the job is read from the informer cache
+func (rc *retryController) sync(key retryKey) error {
+	job, err := rc.trainers[key.trainingType].GetTrainingJob(key.name, key.namespace)
+	if err == types.ErrTrainingJobNotFound {
+		rc.forget(key)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if rc.uids[key] != job.Uid() {
+		rc.forget(key)
+		rc.uids[key] = job.Uid()
+	}
+	if types.TrainingJobStatus(job.GetStatus()) != types.TrainingJobFailed || rc.ignored[job.Uid()] {
+		return nil
+	}
+	delay, err := rc.retry(job)
+	if err != nil {
+		return err
+	}
+	if delay > 0 {
+		rc.queue.AddAfter(key, delay)
+	}
+	return nil
+}
+
+// forget removes the states of the job which is deleted
+func (rc *retryController) forget(key retryKey) {
+	uid, ok := rc.uids[key]
+	if !ok {
+		return
+	}
+	delete(rc.failedAt, uid)
+	delete(rc.ignored, uid)
+	delete(rc.uids, key)
+}
+
+// retry submits the next attempt of the failed job when its failure is matched by --retry-on,
+// it returns the delay before the next attempt can be submitted if the job is in backoff
+func (rc *retryController) retry(job TrainingJob) (time.Duration, error) {
+	crdName, ok := trainingJobCRDs[job.Trainer()]
+	if !ok {
+		rc.ignored[job.Uid()] = true
+		return 0, nil
+	}
+	obj, err := meta.Accessor(job.GetTrainJob())
+	if err != nil {
+		rc.ignored[job.Uid()] = true
+		return 0, nil
+	}
+	annotations := obj.GetAnnotations()
+	// the job has been handled
+	if annotations[types.RetryStatusAnnotation] != "" {
+		rc.ignored[job.Uid()] = true
+		return 0, nil
+	}
+	values, chart, err := getTrainingJobValues(job)
+	if err != nil {
+		rc.ignored[job.Uid()] = true
+		return 0, err
+	}
+	maxAttempts, retryOn := getRetryPolicy(values)
+	if maxAttempts <= 1 {
+		rc.ignored[job.Uid()] = true
+		return 0, nil
+	}
+	attempt := 1
+	if value, ok := annotations[types.RetryAttemptAnnotation]; ok {
+		if attempt, err = strconv.Atoi(value); err != nil {
+			return 0, fmt.Errorf("invalid annotation %v=%v", types.RetryAttemptAnnotation, value)
+		}
+	}
+	origin := job.Name()
+	if value, ok := annotations[types.RetryOriginAnnotation]; ok {
+		origin = value
+	}
+	failure, retryable := matchRetryConditions(classifyJobFailure(job.AllPods()), retryOn)
+	if attempt >= maxAttempts {
+		log.Infof("the job %v/%v has used up its %v attempts", job.Namespace(), job.Name(), maxAttempts)
+		return 0, rc.setRetryStatus(job, crdName, types.RetryExhausted, failure, "")
+	}
+	if !retryable {
+		log.Infof("the failure of job %v/%v is not matched by %v", job.Namespace(), job.Name(), retryOn)
+		return 0, rc.setRetryStatus(job, crdName, types.RetryNotRetryable, failure, "")
+	}
+	// wait for the backoff before submitting the next attempt
+	if _, ok := rc.failedAt[job.Uid()]; !ok {
+		rc.failedAt[job.Uid()] = time.Now()
+	}
+	backoff := rc.backoff(attempt)
+	if elapsed := time.Since(rc.failedAt[job.Uid()]); elapsed < backoff {
+		log.Debugf("the job %v/%v will be retried after %v", job.Namespace(), job.Name(), backoff)
+		return backoff - elapsed, nil
+	}
+	nextName := fmt.Sprintf("%v-attempt-%d", origin, attempt+1)
+	_, err = SearchTrainingJob(nextName, job.Namespace(), job.Trainer())
+	// the next attempt may be submitted before the controller is restarted
+	if err == types.ErrTrainingJobNotFound {
+		if err := resubmitTrainingJob(job, values, chart, nextName); err != nil {
+			return 0, err
+		}
+	} else if err != nil {
+		return 0, err
+	}
+	if err := annotateTrainingJob(crdName, nextName, job.Namespace(), map[string]string{
+		types.RetryAttemptAnnotation:  strconv.Itoa(attempt + 1),
+		types.RetryOriginAnnotation:   origin,
+		types.RetryPreviousAnnotation: job.Name(),
+	}); err != nil {
+		return 0, err
+	}
+	log.Infof("the job %v/%v is failed by %v,submit the attempt %v/%v as %v", job.Namespace(), job.Name(), failure, attempt+1, maxAttempts, nextName)
+	return 0, rc.setRetryStatus(job, crdName, types.RetryResubmitted, failure, nextName)
+}
+
+// backoff returns the delay before submitting the next attempt of the job
+func (rc *retryController) backoff(attempt int) time.Duration {
+	backoff := rc.args.Backoff
+	for i := 1; i < attempt && backoff < rc.args.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > rc.args.MaxBackoff {
+		backoff = rc.args.MaxBackoff
+	}
+	return backoff
+}
+
+func (rc *retryController) setRetryStatus(job TrainingJob, crdName string, status types.RetryStatus, failure, nextName string) error {
+	annotations := map[string]string{
+		types.RetryStatusAnnotation: string(status),
+	}
+	if failure != "" {
+		annotations[types.RetryFailureAnnotation] = failure
+	}
+	if nextName != "" {
+		annotations[types.RetryNextAnnotation] = nextName
+	}
+	if err := annotateTrainingJob(crdName, job.Name(), job.Namespace(), annotations); err != nil {
+		return err
+	}
+	rc.ignored[job.Uid()] = true
+	delete(rc.failedAt, job.Uid())
+	return nil
+}
+
+// getTrainingJobValues returns the values and the chart of the job which are kept in its app configmap
+func getTrainingJobValues(job TrainingJob) (map[string]interface{}, string, error) {
+	configName := fmt.Sprintf("%v-%v", job.Name(), job.Trainer())
+	configmap, err := kubeclient.GetConfigMap(job.Namespace(), configName)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get the configmap %v: %v", configName, err)
+	}
+	values := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(configmap.Data["values"]), &values); err != nil {
+		return nil, "", fmt.Errorf("failed to parse the values of job %v: %v", job.Name(), err)
+	}
+	for key := range configmap.Data {
+		if key != "values" && key != "app" {
+			return values, key, nil
+		}
+	}
+	return nil, "", fmt.Errorf("not found the chart of job %v in configmap %v", job.Name(), configName)
+}
+
+// getRetryPolicy returns the values of --max-attempts and --retry-on of the job
+func getRetryPolicy(values map[string]interface{}) (int, []string) {
+	maxAttempts, _ := values["maxAttempts"].(int)
+	retryOn := []string{}
+	conditions, _ := values["retryOn"].([]interface{})
+	for _, condition := range conditions {
+		retryOn = append(retryOn, fmt.Sprint(condition))
+	}
+	return maxAttempts, retryOn
+}
+
+// classifyJobFailure returns the failures found in the pods of job,like oom,node-lost and exitcode:137
+func classifyJobFailure(pods []*v1.Pod) []string {
+	failures := []string{}
+	addFailure := func(failure string) {
+		if !util.StringInSlice(failure, failures) {
+			failures = append(failures, failure)
+		}
+	}
+	for _, pod := range pods {
+		if isPodLostWithNode(pod) {
+			addFailure(string(types.RetryOnNodeLost))
+		}
+		statuses := append([]v1.ContainerStatus{}, pod.Status.InitContainerStatuses...)
+		statuses = append(statuses, pod.Status.ContainerStatuses...)
+		for _, status := range statuses {
+			for _, state := range []v1.ContainerState{status.State, status.LastTerminationState} {
+				if state.Terminated == nil {
+					continue
+				}
+				if state.Terminated.Reason == "OOMKilled" {
+					addFailure(string(types.RetryOnOOM))
+				}
+				if state.Terminated.ExitCode != 0 {
+					addFailure(fmt.Sprintf("%v%d", types.RetryOnExitCodePrefix, state.Terminated.ExitCode))
+				}
+			}
+		}
+	}
+	return failures
+}
+
+func isPodLostWithNode(pod *v1.Pod) bool {
+	if util.StringInSlice(pod.Status.Reason, nodeLostPodReasons) {
+		return true
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type != v1.DisruptionTarget || condition.Status != v1.ConditionTrue {
+			continue
+		}
+		if condition.Reason == "DeletionByPodGC" || condition.Reason == v1.PodReasonTerminationByKubelet {
+			return true
+		}
+	}
+	return false
+}
+
+// matchRetryConditions returns the failure which is matched by --retry-on,
+// any failure is matched if --retry-on is not set
+func matchRetryConditions(failures []string, retryOn []string) (string, bool) {
+	for _, failure := range failures {
+		if len(retryOn) == 0 || util.StringInSlice(failure, retryOn) {
+			return failure, true
+		}
+	}
+	if len(failures) == 0 {
+		return "", len(retryOn) == 0
+	}
+	return failures[0], false
+}
+
+// resubmitTrainingJob submits the values of the failed job as a new job with the chart of it
+func resubmitTrainingJob(job TrainingJob, values map[string]interface{}, chart, name string) error {
+	// the values which are generated from the job name
+	if podGroupName, ok := values["podGroupName"].(string); ok && podGroupName != "" {
+		values["podGroupName"] = fmt.Sprintf("%v-%v", job.Trainer(), name)
+	}
+	if envs, ok := values["envs"].(map[interface{}]interface{}); ok && envs["MASTER_ADDR"] == fmt.Sprintf("%v-master-0", job.Name()) {
+		envs["MASTER_ADDR"] = fmt.Sprintf("%v-master-0", name)
+	}
+	// the ssh secret generated for the failed job is deleted with it,
+	// so the next attempt creates its own secret by a new key
+	if sshSecret, ok := values["sshSecret"]; ok && sshSecret == "" {
+		secretData, err := util.GenerateRsaKey()
+		if err != nil {
+			return fmt.Errorf("failed to generate the ssh key of job %v: %v", name, err)
+		}
+		values["secretData"] = secretData
+	}
+	if err := restoreConfigFiles(job, values); err != nil {
+		return err
+	}
+	return workflow.SubmitJob(name, string(job.Trainer()), job.Namespace(), values, util.GetChartsFolder()+"/"+chart, types.DryRunNone)
+}
+
+// restoreConfigFiles fills the contents of the files given by --config-file,they are passed as helm options
+// when the job is submitted and kept in the configmaps of the job,the files on the host of the submitter
+// are not available to the retry controller
+func restoreConfigFiles(job TrainingJob, values map[string]interface{}) error {
+	configFiles, ok := values["configFiles"].(map[interface{}]interface{})
+	if !ok {
+		return nil
+	}
+	for containerPathKey, files := range configFiles {
+		fileInfos, ok := files.(map[interface{}]interface{})
+		if !ok {
+			continue
+		}
+		configName := fmt.Sprintf("%v-%v", job.Name(), containerPathKey)
+		configmap, err := kubeclient.GetConfigMap(job.Namespace(), configName)
+		if err != nil {
+			if k8serrors.IsNotFound(err) {
+				return fmt.Errorf("not found the configmap %v which stores the config files of job %v,the job can not be submitted again without them", configName, job.Name())
+			}
+			return fmt.Errorf("failed to get the config files of job %v: %v", job.Name(), err)
+		}
+		for _, file := range fileInfos {
+			fileInfo, ok := file.(map[interface{}]interface{})
+			if !ok {
+				continue
+			}
+			fileName := fmt.Sprint(fileInfo["containerFileName"])
+			content, ok := configmap.Data[fileName]
+			if !ok {
+				return fmt.Errorf("not found the config file %v of job %v in configmap %v", fileName, job.Name(), configName)
+			}
+			fileInfo["content"] = content
+		}
+	}
+	return nil
+}
+
+func annotateTrainingJob(crdName, name, namespace string, annotations map[string]string) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": annotations,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return kubectl.MergePatchResource(crdName, name, namespace, patch)
+}
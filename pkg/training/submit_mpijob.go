@@ -15,9 +15,8 @@
 package training
 
 import (
-	"fmt"
-
 	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/k8saccesser"
 	"github.com/kubeflow/arena/pkg/util"
 	"github.com/kubeflow/arena/pkg/workflow"
 	log "github.com/sirupsen/logrus"
@@ -25,29 +24,23 @@ import (
 
 func SubmitMPIJob(namespace string, submitArgs *types.SubmitMPIJobArgs) (err error) {
 	submitArgs.Namespace = namespace
-	trainers := GetAllTrainers()
-	trainer, ok := trainers[submitArgs.TrainingType]
-	if !ok {
-		return fmt.Errorf("not found trainer whose type is %v", submitArgs.TrainingType)
-	}
-	job, err := trainer.GetTrainingJob(submitArgs.Name, namespace)
-	// if job has been existed,skip to create it and return an error
-	if err == nil && job != nil {
-		return fmt.Errorf("the job %s is already exist, please delete it first. use 'arena delete %s'", submitArgs.Name, submitArgs.Name)
-	}
-	// if error is unknown,return an error
-	if err != types.ErrTrainingJobNotFound {
-		if err == types.ErrNoPrivilegesToOperateJob {
-			return fmt.Errorf("the job %s is already exist and it owned by other user,you have no privileges to operate it", submitArgs.Name)
-		}
+	if err := checkTrainingJobNotExist(submitArgs.Name, namespace, submitArgs.TrainingType, submitArgs.DryRun); err != nil {
 		return err
 	}
+	// render the kubeflow.org/v1 mpijob if the crd is served by training-operator
+	submitArgs.TrainingOperatorCRD = useTrainingOperatorCRD(submitArgs.DryRun, func() bool {
+		return IsTrainingOperatorCRD(k8saccesser.MPICRDName)
+	})
 	// the master is also considered as a worker
 	mpijobChart := util.GetChartsFolder() + "/mpijob"
-	err = workflow.SubmitJob(submitArgs.Name, string(types.MPITrainingJob), namespace, submitArgs, mpijobChart, submitArgs.HelmOptions...)
+	err = workflow.SubmitJob(submitArgs.Name, string(types.MPITrainingJob), namespace, submitArgs, mpijobChart, submitArgs.DryRun, submitArgs.HelmOptions...)
 	if err != nil {
 		return err
 	}
+	// nothing is created when dry running
+	if submitArgs.DryRun != types.DryRunNone {
+		return nil
+	}
 	log.Infof("The Job %s has been submitted successfully", submitArgs.Name)
 	log.Infof("You can run `arena get %s --type %s -n %s` to check the job status", submitArgs.Name, submitArgs.TrainingType, submitArgs.Namespace)
 	return nil
@@ -15,8 +15,6 @@
 package training
 
 import (
-	"fmt"
-
 	"github.com/kubeflow/arena/pkg/apis/types"
 	"github.com/kubeflow/arena/pkg/k8saccesser"
 	"github.com/kubeflow/arena/pkg/util"
@@ -26,34 +24,25 @@ import (
 
 func SubmitPytorchJob(namespace string, submitArgs *types.SubmitPyTorchJobArgs) (err error) {
 	submitArgs.Namespace = namespace
-	trainers := GetAllTrainers()
-	trainer, ok := trainers[submitArgs.TrainingType]
-	if !ok {
-		return fmt.Errorf("not found trainer whose type is %v", submitArgs.TrainingType)
-	}
-	job, err := trainer.GetTrainingJob(submitArgs.Name, namespace)
-	// if job has been existed,skip to create it and return an error
-	if err == nil && job != nil {
-		return fmt.Errorf("the job %s is already exist, please delete it first. use 'arena delete %s'", submitArgs.Name, submitArgs.Name)
-	}
-	// if error is unknown,return an error
-	if err != types.ErrTrainingJobNotFound {
-		if err == types.ErrNoPrivilegesToOperateJob {
-			return fmt.Errorf("the job %s is already exist and it owned by other user,you have no privileges to operate it", submitArgs.Name)
-		}
+	if err := checkTrainingJobNotExist(submitArgs.Name, namespace, submitArgs.TrainingType, submitArgs.DryRun); err != nil {
 		return err
 	}
 	// the master is also considered as a worker
 	submitArgs.WorkerCount = submitArgs.WorkerCount - 1
 
-	compatible := CompatibleJobCRD(k8saccesser.PytorchCRDName, "runPolicy")
-	submitArgs.TrainingOperatorCRD = compatible
+	submitArgs.TrainingOperatorCRD = useTrainingOperatorCRD(submitArgs.DryRun, func() bool {
+		return CompatibleJobCRD(k8saccesser.PytorchCRDName, "runPolicy")
+	})
 
 	pytorchjobChart := util.GetChartsFolder() + "/pytorchjob"
-	err = workflow.SubmitJob(submitArgs.Name, string(types.PytorchTrainingJob), namespace, submitArgs, pytorchjobChart, submitArgs.HelmOptions...)
+	err = workflow.SubmitJob(submitArgs.Name, string(types.PytorchTrainingJob), namespace, submitArgs, pytorchjobChart, submitArgs.DryRun, submitArgs.HelmOptions...)
 	if err != nil {
 		return err
 	}
+	// nothing is created when dry running
+	if submitArgs.DryRun != types.DryRunNone {
+		return nil
+	}
 	log.Infof("The Job %s has been submitted successfully", submitArgs.Name)
 	log.Infof("You can run `arena get %s --type %s -n %s` to check the job status", submitArgs.Name, submitArgs.TrainingType, submitArgs.Namespace)
 	return nil
@@ -0,0 +1,42 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/util"
+	"github.com/kubeflow/arena/pkg/workflow"
+	log "github.com/sirupsen/logrus"
+)
+
+func SubmitXGBoostJob(namespace string, submitArgs *types.SubmitXGBoostJobArgs) (err error) {
+	submitArgs.Namespace = namespace
+	if err := checkTrainingJobNotExist(submitArgs.Name, namespace, submitArgs.TrainingType, submitArgs.DryRun); err != nil {
+		return err
+	}
+
+	xgboostjobChart := util.GetChartsFolder() + "/xgboostjob"
+	err = workflow.SubmitJob(submitArgs.Name, string(types.XGBoostTrainingJob), namespace, submitArgs, xgboostjobChart, submitArgs.DryRun, submitArgs.HelmOptions...)
+	if err != nil {
+		return err
+	}
+	// nothing is created when dry running
+	if submitArgs.DryRun != types.DryRunNone {
+		return nil
+	}
+	log.Infof("The Job %s has been submitted successfully", submitArgs.Name)
+	log.Infof("You can run `arena get %s --type %s -n %s` to check the job status", submitArgs.Name, submitArgs.TrainingType, submitArgs.Namespace)
+	return nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"encoding/json"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/k8saccesser"
+	commonv1 "github.com/kubeflow/arena/pkg/operators/tf-operator/apis/common/v1"
+	kubeflowv1 "github.com/kubeflow/arena/pkg/operators/training-operator/apis/kubeflow/v1"
+	"github.com/kubeflow/arena/pkg/util/kubectl"
+)
+
+// suspendableJobCRDs are the crds of the training jobs which support runPolicy.suspend
+var suspendableJobCRDs = map[types.TrainingJobType]string{
+	types.TFTrainingJob:      k8saccesser.TensorflowCRDName,
+	types.PytorchTrainingJob: k8saccesser.PytorchCRDName,
+	types.MPITrainingJob:     k8saccesser.MPICRDName,
+}
+
+// SuspendTrainingJob suspends or resumes the training job by setting runPolicy.suspend of the job,
+// the pods of the job are deleted when it is suspended and created again when it is resumed
+func SuspendTrainingJob(jobName, namespace string, jobType types.TrainingJobType, suspend bool) error {
+	job, err := SearchTrainingJob(jobName, namespace, jobType)
+	if err != nil {
+		return err
+	}
+	crdName, ok := suspendableJobCRDs[job.Trainer()]
+	if !ok {
+		return fmt.Errorf("the job %v is a %v,only tfjob,pytorchjob and mpijob can be suspended", jobName, job.Trainer())
+	}
+	// only the crds of training-operator have runPolicy.suspend,the mpijob of mpi-operator is
+	// read as v1alpha1 which has no runPolicy,so it must be the kubeflow.org/v1 mpijob
+	var supported bool
+	if job.Trainer() == types.MPITrainingJob {
+		supported = IsTrainingOperatorCRD(crdName)
+	} else {
+		supported = CompatibleJobCRD(crdName, "runPolicy")
+	}
+	if !supported {
+		return fmt.Errorf("the %v crd is not served by training-operator,it does not support suspending jobs", crdName)
+	}
+	status := types.TrainingJobStatus(job.GetStatus())
+	if status == types.TrainingJobSucceeded || status == types.TrainingJobFailed {
+		return fmt.Errorf("the job %v is %v,it can not be suspended or resumed", jobName, status)
+	}
+	suspended, err := isRunPolicySuspended(crdName, jobName, namespace)
+	if err != nil {
+		return err
+	}
+	if suspend && suspended {
+		return fmt.Errorf("the job %v has been suspended", jobName)
+	}
+	if !suspend && !suspended {
+		return fmt.Errorf("the job %v is not suspended,it can not be resumed", jobName)
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"runPolicy": map[string]interface{}{
+				"suspend": suspend,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	log.Debugf("patch %v %v/%v with %v", crdName, namespace, jobName, string(patch))
+	if err := kubectl.MergePatchResource(crdName, jobName, namespace, patch); err != nil {
+		return err
+	}
+	var out string
+	if suspend {
+		out = fmt.Sprintf("job %s suspend success", jobName)
+	} else {
+		out = fmt.Sprintf("job %s resume success", jobName)
+	}
+	fmt.Println(out)
+	return nil
+}
+
+// isRunPolicySuspended returns runPolicy.suspend of the job,it is read from the api server
+// because the tfjob and the pytorchjob types of arena have no runPolicy
+func isRunPolicySuspended(crdName, jobName, namespace string) (bool, error) {
+	obj, err := kubectl.GetResource(crdName, jobName, namespace)
+	if err != nil {
+		return false, fmt.Errorf("failed to get the job %v: %v", jobName, err)
+	}
+	suspended, _, err := unstructured.NestedBool(obj.Object, "spec", "runPolicy", "suspend")
+	if err != nil {
+		return false, fmt.Errorf("invalid runPolicy.suspend of job %v: %v", jobName, err)
+	}
+	return suspended, nil
+}
+
+// isSuspendedJobStatus checks the Suspended condition which is added by training-operator when
+// runPolicy.suspend of the job is true,the finished jobs are never suspended
+func isSuspendedJobStatus(status commonv1.JobStatus) bool {
+	suspended := false
+	for _, condition := range status.Conditions {
+		if condition.Status != v1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case commonv1.JobSucceeded, commonv1.JobFailed:
+			return false
+		case kubeflowv1.JobSuspended:
+			suspended = true
+		}
+	}
+	return suspended
+}
@@ -18,10 +18,12 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strconv"
 	"sync"
 
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/kubeflow/arena/pkg/apis/config"
@@ -29,6 +31,10 @@ import (
 	"github.com/kubeflow/arena/pkg/util/kubectl"
 )
 
+// trainingOperatorCRDConfigKey decides whether the jobs are rendered as the training-operator crds when dry running on the client,
+// the crds can not be detected without accessing the cluster
+const trainingOperatorCRDConfigKey = "training_operator_crd"
+
 var trainers map[types.TrainingJobType]Trainer
 
 var once sync.Once
@@ -45,6 +51,10 @@ func GetAllTrainers() map[types.TrainingJobType]Trainer {
 			NewVolcanoJobTrainer,
 			NewSparkJobTrainer,
 			NewDeepSpeedJobTrainer,
+			NewRayJobTrainer,
+			NewXGBoostJobTrainer,
+			NewPaddleJobTrainer,
+			NewJAXJobTrainer,
 		}
 		var wg sync.WaitGroup
 		for _, initFunc := range trainerInits {
@@ -63,6 +73,50 @@ func GetAllTrainers() map[types.TrainingJobType]Trainer {
 	return trainers
 }
 
+// checkTrainingJobNotExist returns an error if the training job has been existed,
+// it is skipped when dry running on the client because nothing is sent to the cluster
+func checkTrainingJobNotExist(name, namespace string, trainingType types.TrainingJobType, dryRun types.DryRunStrategy) error {
+	if dryRun == types.DryRunClient {
+		return nil
+	}
+	trainers := GetAllTrainers()
+	trainer, ok := trainers[trainingType]
+	if !ok {
+		return fmt.Errorf("not found trainer whose type is %v", trainingType)
+	}
+	job, err := trainer.GetTrainingJob(name, namespace)
+	// if job has been existed,skip to create it and return an error
+	if err == nil && job != nil {
+		return fmt.Errorf("the job %s is already exist, please delete it first. use 'arena delete %s'", name, name)
+	}
+	// if error is unknown,return an error
+	if err != types.ErrTrainingJobNotFound {
+		if err == types.ErrNoPrivilegesToOperateJob {
+			return fmt.Errorf("the job %s is already exist and it owned by other user,you have no privileges to operate it", name)
+		}
+		return err
+	}
+	return nil
+}
+
+// useTrainingOperatorCRD returns true if the job is rendered as the training-operator crd,
+// it is detected from the cluster by detect,or read from the config file when dry running on the client
+func useTrainingOperatorCRD(dryRun types.DryRunStrategy, detect func() bool) bool {
+	if dryRun != types.DryRunClient {
+		return detect()
+	}
+	value, ok := config.GetArenaConfiger().GetConfigsFromConfigFile()[trainingOperatorCRDConfigKey]
+	if !ok {
+		return true
+	}
+	enabled, err := strconv.ParseBool(value)
+	if err != nil {
+		log.Warnf("invalid %v %v in the config file,render the training-operator crd by default", trainingOperatorCRDConfigKey, value)
+		return true
+	}
+	return enabled
+}
+
 type orderedTrainingJob []TrainingJob
 
 func (this orderedTrainingJob) Len() int {
@@ -221,3 +275,24 @@ func CompatibleJobCRD(crdName, fieldToCheck string) bool {
 
 	return compatible
 }
+
+// IsTrainingOperatorCRD returns true if the crd serves the kubeflow.org/v1 version of the kind as training-operator does,
+// the crd name alone can not tell it since the standalone operators(e.g. mpi-operator) install the crds with the same name
+func IsTrainingOperatorCRD(crdName string) bool {
+	crd, err := config.GetArenaConfiger().GetAPIExtensionClientSet().ApiextensionsV1().CustomResourceDefinitions().Get(context.TODO(), crdName, metav1.GetOptions{})
+	if err != nil {
+		log.Errorf("Get crd %v failed, error: %s", crdName, err)
+		return false
+	}
+	return servesTrainingOperatorVersion(crd)
+}
+
+// servesTrainingOperatorVersion returns true if the crd serves the v1 version,which is the version served by training-operator
+func servesTrainingOperatorVersion(crd *apiextensionsv1.CustomResourceDefinition) bool {
+	for _, v := range crd.Spec.Versions {
+		if v.Served && v.Name == "v1" {
+			return true
+		}
+	}
+	return false
+}
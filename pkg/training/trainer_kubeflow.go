@@ -0,0 +1,485 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+	"github.com/kubeflow/arena/pkg/k8saccesser"
+	commonv1 "github.com/kubeflow/arena/pkg/operators/tf-operator/apis/common/v1"
+	kubeflowv1 "github.com/kubeflow/arena/pkg/operators/training-operator/apis/kubeflow/v1"
+	"github.com/kubeflow/arena/pkg/operators/training-operator/client/clientset/versioned"
+)
+
+// KubeflowJob is the training job of the kubeflow.org/v1 kinds served by training-operator,
+// like MPIJob, XGBoostJob, PaddleJob and JAXJob
+type KubeflowJob struct {
+	*BasicJobInfo
+	job          metav1.Object // the origin job object, like *kubeflowv1.XGBoostJob
+	replicaSpecs map[commonv1.ReplicaType]*commonv1.ReplicaSpec
+	runPolicy    *kubeflowv1.RunPolicy
+	status       *commonv1.JobStatus
+	pods         []*v1.Pod // all the pods of the job
+	chiefPod     *v1.Pod   // the master pod,or the launcher pod of mpijob,or the worker-0 pod of jaxjob
+	requestedGPU int64
+	allocatedGPU int64
+	trainerType  types.TrainingJobType
+}
+
+func (kj *KubeflowJob) Name() string {
+	return kj.name
+}
+
+func (kj *KubeflowJob) Uid() string {
+	return string(kj.job.GetUID())
+}
+
+// Get the chief Pod of the Job.
+func (kj *KubeflowJob) ChiefPod() *v1.Pod {
+	return kj.chiefPod
+}
+
+func (kj *KubeflowJob) Trainer() types.TrainingJobType {
+	return kj.trainerType
+}
+
+// Get all the pods of the Training Job
+func (kj *KubeflowJob) AllPods() []*v1.Pod {
+	return kj.pods
+}
+
+func (kj *KubeflowJob) GetTrainJob() interface{} {
+	return kj.job
+}
+
+func (kj *KubeflowJob) GetLabels() map[string]string {
+	return kj.job.GetLabels()
+}
+
+// Get the Status of the Job: RUNNING, PENDING, SUSPENDED, SUCCEEDED, FAILED
+func (kj *KubeflowJob) GetStatus() (status string) {
+	status = "PENDING"
+	if kj.job.GetName() == "" {
+		return status
+	}
+	p := checkStatus(*kj.status)
+	// the Suspended condition may not be added yet after runPolicy.suspend is set
+	suspending := kj.runPolicy != nil && kj.runPolicy.Suspend != nil && *kj.runPolicy.Suspend &&
+		p != commonv1.JobSucceeded && p != commonv1.JobFailed
+	if suspending || isSuspendedJobStatus(*kj.status) {
+		status = string(types.TrainingJobSuspended)
+	} else if p == commonv1.JobCreated || p == commonv1.JobRestarting {
+		status = "PENDING"
+	} else {
+		status = strings.ToUpper(string(p))
+	}
+	return status
+}
+
+// Get the start time
+func (kj *KubeflowJob) StartTime() *metav1.Time {
+	creationTimestamp := kj.job.GetCreationTimestamp()
+	return &creationTimestamp
+}
+
+// Get the Job Age
+func (kj *KubeflowJob) Age() time.Duration {
+	creationTimestamp := kj.job.GetCreationTimestamp()
+	if creationTimestamp.IsZero() {
+		return 0
+	}
+	return metav1.Now().Sub(creationTimestamp.Time)
+}
+
+// Get the Job Training Duration
+func (kj *KubeflowJob) Duration() time.Duration {
+	status := kj.status
+	if status.StartTime == nil || status.StartTime.IsZero() {
+		return 0
+	}
+	if !status.CompletionTime.IsZero() {
+		return status.CompletionTime.Time.Sub(status.StartTime.Time)
+	}
+	if kj.GetStatus() == "FAILED" && kj.chiefPod != nil {
+		cond := getPodLatestCondition(kj.chiefPod)
+		if !cond.LastTransitionTime.IsZero() {
+			creationTimestamp := kj.job.GetCreationTimestamp()
+			return cond.LastTransitionTime.Time.Sub(creationTimestamp.Time)
+		}
+		log.Debugf("the latest condition's time is zero of pod %s", kj.chiefPod.Name)
+	}
+	return metav1.Now().Sub(status.StartTime.Time)
+}
+
+// Get Dashboard url of the job
+func (kj *KubeflowJob) GetJobDashboards(client *kubernetes.Clientset, namespace, arenaNamespace string) ([]string, error) {
+	urls := []string{}
+	dashboardURL := ""
+	var err error
+	for _, ns := range []string{namespace, arenaNamespace, "kube-system"} {
+		dashboardURL, err = dashboard(client, ns, "kubernetes-dashboard")
+		if err == nil && dashboardURL != "" {
+			break
+		}
+		log.Debugf("Get dashboard failed due to %v", err)
+	}
+	if dashboardURL == "" {
+		return urls, fmt.Errorf("No LOGVIEWER Installed.")
+	}
+	if kj.chiefPod == nil || len(kj.chiefPod.Spec.Containers) == 0 {
+		return urls, fmt.Errorf("the chief pod of %v is not ready!", kj.trainerType)
+	}
+	url := fmt.Sprintf("%s/#!/log/%s/%s/%s?namespace=%s\n",
+		dashboardURL,
+		kj.chiefPod.Namespace,
+		kj.chiefPod.Name,
+		kj.chiefPod.Spec.Containers[0].Name,
+		kj.chiefPod.Namespace)
+	urls = append(urls, url)
+	return urls, nil
+}
+
+// Requested GPU count of the Job
+func (kj *KubeflowJob) RequestedGPU() int64 {
+	if kj.requestedGPU > 0 {
+		return kj.requestedGPU
+	}
+	requestGPUs := getRequestGPUsOfJobFromPodAnnotation(kj.pods)
+	if requestGPUs > 0 {
+		return requestGPUs
+	}
+	for _, pod := range kj.pods {
+		kj.requestedGPU += gpuInPod(*pod)
+	}
+	return kj.requestedGPU
+}
+
+// Requested GPU count of the Job
+func (kj *KubeflowJob) AllocatedGPU() int64 {
+	if kj.allocatedGPU > 0 {
+		return kj.allocatedGPU
+	}
+	for _, pod := range kj.pods {
+		kj.allocatedGPU += gpuInActivePod(*pod)
+	}
+	return kj.allocatedGPU
+}
+
+// Get the hostIP of the chief Pod
+func (kj *KubeflowJob) HostIPOfChief() (hostIP string) {
+	hostIP = "N/A"
+	if kj.GetStatus() == "RUNNING" && kj.chiefPod != nil {
+		hostIP = kj.chiefPod.Status.HostIP
+	}
+	return hostIP
+}
+
+func (kj *KubeflowJob) Namespace() string {
+	return kj.job.GetNamespace()
+}
+
+// Get PriorityClass
+func (kj *KubeflowJob) GetPriorityClass() string {
+	for _, spec := range kj.replicaSpecs {
+		if spec != nil && spec.Template.Spec.PriorityClassName != "" {
+			return spec.Template.Spec.PriorityClassName
+		}
+	}
+	return ""
+}
+
+// kubeflowJobKind describes how to get the jobs of a kubeflow.org/v1 kind from the cluster
+type kubeflowJobKind struct {
+	trainerType types.TrainingJobType
+	crdName     string
+	// the replica type(lower case) of the chief pod,the pod with index 0 is the chief pod
+	// if there are multiple replicas
+	chiefReplicaType string
+	isPod            func(name, ns string, pod *v1.Pod) bool
+	getJob           func(client *versioned.Clientset, namespace, name string) (*KubeflowJob, error)
+	listJobs         func(client *versioned.Clientset, namespace, labels string) ([]*KubeflowJob, error)
+}
+
+// KubeflowJobTrainer is the trainer of the kubeflow.org/v1 jobs of training-operator
+type KubeflowJobTrainer struct {
+	client         *kubernetes.Clientset
+	trainingClient *versioned.Clientset
+	kind           kubeflowJobKind
+	// check if it's enabled
+	enabled bool
+}
+
+func newKubeflowJobTrainer(kind kubeflowJobKind) *KubeflowJobTrainer {
+	enable := false
+	trainingClient := versioned.NewForConfigOrDie(config.GetArenaConfiger().GetRestConfig())
+	_, err := config.GetArenaConfiger().GetAPIExtensionClientSet().ApiextensionsV1().CustomResourceDefinitions().Get(context.TODO(), kind.crdName, metav1.GetOptions{})
+	if err == nil {
+		log.Debugf("%v trainer is enabled", kind.trainerType)
+		enable = true
+	} else {
+		log.Debugf("%v trainer is disabled,reason: %v", kind.trainerType, err)
+	}
+	log.Debugf("Succeed to init %v trainer", kind.trainerType)
+	return &KubeflowJobTrainer{
+		client:         config.GetArenaConfiger().GetClientSet(),
+		trainingClient: trainingClient,
+		kind:           kind,
+		enabled:        enable,
+	}
+}
+
+// NewXGBoostJobTrainer returns the trainer of the XGBoostJob of training-operator
+func NewXGBoostJobTrainer() Trainer {
+	return newKubeflowJobTrainer(kubeflowJobKind{
+		trainerType:      types.XGBoostTrainingJob,
+		crdName:          k8saccesser.XGBoostCRDName,
+		chiefReplicaType: strings.ToLower(string(kubeflowv1.XGBoostJobReplicaTypeMaster)),
+		isPod:            utils.IsXGBoostPod,
+		getJob: func(client *versioned.Clientset, namespace, name string) (*KubeflowJob, error) {
+			job, err := k8saccesser.GetK8sResourceAccesser().GetXGBoostJob(client, namespace, name)
+			if err != nil {
+				return nil, err
+			}
+			return newXGBoostJob(job), nil
+		},
+		listJobs: func(client *versioned.Clientset, namespace, labels string) ([]*KubeflowJob, error) {
+			jobs, err := k8saccesser.GetK8sResourceAccesser().ListXGBoostJobs(client, namespace, labels)
+			if err != nil {
+				return nil, err
+			}
+			kubeflowJobs := []*KubeflowJob{}
+			for _, job := range jobs {
+				kubeflowJobs = append(kubeflowJobs, newXGBoostJob(job))
+			}
+			return kubeflowJobs, nil
+		},
+	})
+}
+
+// NewPaddleJobTrainer returns the trainer of the PaddleJob of training-operator
+func NewPaddleJobTrainer() Trainer {
+	return newKubeflowJobTrainer(kubeflowJobKind{
+		trainerType:      types.PaddleTrainingJob,
+		crdName:          k8saccesser.PaddleCRDName,
+		chiefReplicaType: strings.ToLower(string(kubeflowv1.PaddleJobReplicaTypeMaster)),
+		isPod:            utils.IsPaddlePod,
+		getJob: func(client *versioned.Clientset, namespace, name string) (*KubeflowJob, error) {
+			job, err := k8saccesser.GetK8sResourceAccesser().GetPaddleJob(client, namespace, name)
+			if err != nil {
+				return nil, err
+			}
+			return newPaddleJob(job), nil
+		},
+		listJobs: func(client *versioned.Clientset, namespace, labels string) ([]*KubeflowJob, error) {
+			jobs, err := k8saccesser.GetK8sResourceAccesser().ListPaddleJobs(client, namespace, labels)
+			if err != nil {
+				return nil, err
+			}
+			kubeflowJobs := []*KubeflowJob{}
+			for _, job := range jobs {
+				kubeflowJobs = append(kubeflowJobs, newPaddleJob(job))
+			}
+			return kubeflowJobs, nil
+		},
+	})
+}
+
+// NewJAXJobTrainer returns the trainer of the JAXJob of training-operator
+func NewJAXJobTrainer() Trainer {
+	return newKubeflowJobTrainer(kubeflowJobKind{
+		trainerType:      types.JAXTrainingJob,
+		crdName:          k8saccesser.JAXCRDName,
+		chiefReplicaType: strings.ToLower(string(kubeflowv1.JAXJobReplicaTypeWorker)),
+		isPod:            utils.IsJAXPod,
+		getJob: func(client *versioned.Clientset, namespace, name string) (*KubeflowJob, error) {
+			job, err := k8saccesser.GetK8sResourceAccesser().GetJAXJob(client, namespace, name)
+			if err != nil {
+				return nil, err
+			}
+			return newJAXJob(job), nil
+		},
+		listJobs: func(client *versioned.Clientset, namespace, labels string) ([]*KubeflowJob, error) {
+			jobs, err := k8saccesser.GetK8sResourceAccesser().ListJAXJobs(client, namespace, labels)
+			if err != nil {
+				return nil, err
+			}
+			kubeflowJobs := []*KubeflowJob{}
+			for _, job := range jobs {
+				kubeflowJobs = append(kubeflowJobs, newJAXJob(job))
+			}
+			return kubeflowJobs, nil
+		},
+	})
+}
+
+// newMPIJobV1Trainer returns the trainer of the kubeflow.org/v1 MPIJob of training-operator,
+// it is used when the mpijob crd of the cluster is served by training-operator
+func newMPIJobV1Trainer() Trainer {
+	return newKubeflowJobTrainer(kubeflowJobKind{
+		trainerType:      types.MPITrainingJob,
+		crdName:          k8saccesser.MPICRDName,
+		chiefReplicaType: strings.ToLower(string(kubeflowv1.MPIJobReplicaTypeLauncher)),
+		isPod:            utils.IsMPIPod,
+		getJob: func(client *versioned.Clientset, namespace, name string) (*KubeflowJob, error) {
+			job, err := k8saccesser.GetK8sResourceAccesser().GetMPIJobV1(client, namespace, name)
+			if err != nil {
+				return nil, err
+			}
+			return newMPIJobV1(job), nil
+		},
+		listJobs: func(client *versioned.Clientset, namespace, labels string) ([]*KubeflowJob, error) {
+			jobs, err := k8saccesser.GetK8sResourceAccesser().ListMPIJobsV1(client, namespace, labels)
+			if err != nil {
+				return nil, err
+			}
+			kubeflowJobs := []*KubeflowJob{}
+			for _, job := range jobs {
+				kubeflowJobs = append(kubeflowJobs, newMPIJobV1(job))
+			}
+			return kubeflowJobs, nil
+		},
+	})
+}
+
+func newXGBoostJob(job *kubeflowv1.XGBoostJob) *KubeflowJob {
+	return &KubeflowJob{
+		BasicJobInfo: &BasicJobInfo{name: job.Name},
+		job:          job,
+		replicaSpecs: job.Spec.XGBReplicaSpecs,
+		runPolicy:    &job.Spec.RunPolicy,
+		status:       &job.Status,
+	}
+}
+
+func newPaddleJob(job *kubeflowv1.PaddleJob) *KubeflowJob {
+	return &KubeflowJob{
+		BasicJobInfo: &BasicJobInfo{name: job.Name},
+		job:          job,
+		replicaSpecs: job.Spec.PaddleReplicaSpecs,
+		runPolicy:    &job.Spec.RunPolicy,
+		status:       &job.Status,
+	}
+}
+
+func newJAXJob(job *kubeflowv1.JAXJob) *KubeflowJob {
+	return &KubeflowJob{
+		BasicJobInfo: &BasicJobInfo{name: job.Name},
+		job:          job,
+		replicaSpecs: job.Spec.JAXReplicaSpecs,
+		runPolicy:    &job.Spec.RunPolicy,
+		status:       &job.Status,
+	}
+}
+
+func newMPIJobV1(job *kubeflowv1.MPIJob) *KubeflowJob {
+	return &KubeflowJob{
+		BasicJobInfo: &BasicJobInfo{name: job.Name},
+		job:          job,
+		replicaSpecs: job.Spec.MPIReplicaSpecs,
+		runPolicy:    &job.Spec.RunPolicy,
+		status:       &job.Status,
+	}
+}
+
+// IsEnabled is used to get the trainer is enable or not
+func (kt *KubeflowJobTrainer) IsEnabled() bool {
+	return kt.enabled
+}
+
+// Get the type
+func (kt *KubeflowJobTrainer) Type() types.TrainingJobType {
+	return kt.kind.trainerType
+}
+
+// check if it's the job of the trainer
+func (kt *KubeflowJobTrainer) IsSupported(name, ns string) bool {
+	if !kt.enabled {
+		return false
+	}
+	_, err := kt.GetTrainingJob(name, ns)
+	return err == nil
+}
+
+// Get the training job from cache or directly
+func (kt *KubeflowJobTrainer) GetTrainingJob(name, namespace string) (TrainingJob, error) {
+	job, err := kt.kind.getJob(kt.trainingClient, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := CheckJobIsOwnedByTrainer(job.GetLabels()); err != nil {
+		return nil, err
+	}
+	allPods, err := k8saccesser.GetK8sResourceAccesser().ListPods(namespace, fmt.Sprintf("release=%v,app=%v", name, kt.Type()), "", nil)
+	if err != nil {
+		return nil, err
+	}
+	kt.complete(job, allPods)
+	return job, nil
+}
+
+// List Training jobs
+func (kt *KubeflowJobTrainer) ListTrainingJobs(namespace string, allNamespace bool) ([]TrainingJob, error) {
+	if allNamespace {
+		namespace = metav1.NamespaceAll
+	}
+	trainingJobs := []TrainingJob{}
+	jobLabels := GetTrainingJobLabels(kt.Type())
+	jobs, err := kt.kind.listJobs(kt.trainingClient, namespace, jobLabels)
+	if err != nil {
+		return trainingJobs, err
+	}
+	pods, err := k8saccesser.GetK8sResourceAccesser().ListPods(namespace, fmt.Sprintf("app=%v", kt.Type()), "", nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, job := range jobs {
+		kt.complete(job, pods)
+		trainingJobs = append(trainingJobs, job)
+	}
+	return trainingJobs, nil
+}
+
+// isChiefPod checks the pod is the chief pod of the job
+func (kj *KubeflowJob) isChiefPod(pod *v1.Pod, chiefReplicaType string) bool {
+	if pod.Labels[TrainingReplicaTypeLabel] != chiefReplicaType {
+		return false
+	}
+	index, ok := pod.Labels[TrainingReplicaIndexLabel]
+	return !ok || index == "0"
+}
+
+// complete fills the pods,the chief pod and the resources of the job
+func (kt *KubeflowJobTrainer) complete(job *KubeflowJob, podList []*v1.Pod) {
+	// important for getting job status
+	job.status.Conditions = makeJobStatusSortedByTime(job.status.Conditions)
+	pods, chiefPod := getPodsOfTrainingJob(job.job.GetName(), job.job.GetNamespace(), podList, kt.kind.isPod, func(pod *v1.Pod) bool {
+		return job.isChiefPod(pod, kt.kind.chiefReplicaType)
+	})
+	job.pods = pods
+	job.chiefPod = chiefPod
+	job.resources = podResources(pods)
+	job.trainerType = kt.Type()
+}
@@ -242,6 +242,11 @@ func NewMPIJobTrainer() Trainer {
 	// this step is used to check operator is installed or not
 	_, err := config.GetArenaConfiger().GetAPIExtensionClientSet().ApiextensionsV1().CustomResourceDefinitions().Get(context.TODO(), k8saccesser.MPICRDName, metav1.GetOptions{})
 	if err == nil {
+		// use the kubeflow.org/v1 MPIJobTrainer if the mpijob crd serves v1 as training-operator does
+		if IsTrainingOperatorCRD(k8saccesser.MPICRDName) {
+			log.Debugf("MPIJob crd is served by training-operator, use the kubeflow.org/v1 MPIJobTrainer")
+			return newMPIJobV1Trainer()
+		}
 		log.Debugf("MPIJobTrainer is enabled")
 		enable = true
 	} else {
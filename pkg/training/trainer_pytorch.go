@@ -88,7 +88,7 @@ func (pj *PyTorchJob) GetLabels() map[string]string {
 	return pj.pytorchjob.Labels
 }
 
-// Get the Status of the Job: RUNNING, PENDING, SUCCEEDED, FAILED
+// Get the Status of the Job: RUNNING, PENDING, SUSPENDED, SUCCEEDED, FAILED
 func (pj *PyTorchJob) GetStatus() (status string) {
 	status = "PENDING"
 	pytorchjob := pj.pytorchjob
@@ -97,7 +97,9 @@ func (pj *PyTorchJob) GetStatus() (status string) {
 	}
 
 	p := checkStatus(pytorchjob.Status)
-	if p == commonv1.JobCreated || p == commonv1.JobRestarting {
+	if isSuspendedJobStatus(pytorchjob.Status) {
+		status = string(types.TrainingJobSuspended)
+	} else if p == commonv1.JobCreated || p == commonv1.JobRestarting {
 		status = "PENDING"
 	} else {
 		status = strings.ToUpper(string(p))
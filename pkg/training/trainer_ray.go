@@ -0,0 +1,320 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/apis/utils"
+	"github.com/kubeflow/arena/pkg/k8saccesser"
+	rayv1 "github.com/kubeflow/arena/pkg/operators/kuberay-operator/apis/ray/v1"
+	"github.com/kubeflow/arena/pkg/operators/kuberay-operator/client/clientset/versioned"
+)
+
+const (
+	// kuberay added labels for the pods of ray cluster
+	rayNodeTypeLabel = "ray.io/node-type"
+	// the label is added to the submitter pod by the rayjob chart
+	rayJobRoleLabel = "job-role"
+)
+
+// RayJob wraps the RayJob of KubeRay
+type RayJob struct {
+	*BasicJobInfo
+	rayjob       *rayv1.RayJob
+	pods         []*v1.Pod // all the pods including the head,workers and the submitter
+	chiefPod     *v1.Pod   // the submitter pod,or the head pod if the submitter is not found
+	requestedGPU int64
+	allocatedGPU int64
+	trainerType  types.TrainingJobType
+}
+
+func (rj *RayJob) Name() string {
+	return rj.name
+}
+
+func (rj *RayJob) Uid() string {
+	return string(rj.rayjob.UID)
+}
+
+// Get the chief Pod of the Job.
+func (rj *RayJob) ChiefPod() *v1.Pod {
+	return rj.chiefPod
+}
+
+func (rj *RayJob) Trainer() types.TrainingJobType {
+	return rj.trainerType
+}
+
+// Get all the pods of the Training Job
+func (rj *RayJob) AllPods() []*v1.Pod {
+	return rj.pods
+}
+
+func (rj *RayJob) GetTrainJob() interface{} {
+	return rj.rayjob
+}
+
+func (rj *RayJob) GetLabels() map[string]string {
+	return rj.rayjob.Labels
+}
+
+// Get the Status of the Job: RUNNING, PENDING, SUCCEEDED, FAILED
+func (rj *RayJob) GetStatus() (status string) {
+	return string(getRayJobStatus(rj.rayjob))
+}
+
+// getRayJobStatus maps the job status and the deployment status of rayjob to the training job status
+func getRayJobStatus(rayjob *rayv1.RayJob) types.TrainingJobStatus {
+	switch rayjob.Status.JobStatus {
+	case rayv1.JobStatusSucceeded:
+		return types.TrainingJobSucceeded
+	case rayv1.JobStatusFailed, rayv1.JobStatusStopped:
+		return types.TrainingJobFailed
+	}
+	switch rayjob.Status.JobDeploymentStatus {
+	case rayv1.JobDeploymentStatusFailed, rayv1.JobDeploymentStatusValidationFailed:
+		return types.TrainingJobFailed
+	}
+	if rayjob.Status.JobStatus == rayv1.JobStatusRunning {
+		return types.TrainingJobRunning
+	}
+	return types.TrainingJobPending
+}
+
+// Get the start time
+func (rj *RayJob) StartTime() *metav1.Time {
+	return &rj.rayjob.CreationTimestamp
+}
+
+// Get the Job Age
+func (rj *RayJob) Age() time.Duration {
+	job := rj.rayjob
+	if job.CreationTimestamp.IsZero() {
+		return 0
+	}
+	return metav1.Now().Sub(job.CreationTimestamp.Time)
+}
+
+// Get the Job Training Duration
+func (rj *RayJob) Duration() time.Duration {
+	job := rj.rayjob
+	if job.Status.StartTime == nil || job.Status.StartTime.IsZero() {
+		return 0
+	}
+	if job.Status.EndTime != nil && !job.Status.EndTime.IsZero() {
+		return job.Status.EndTime.Time.Sub(job.Status.StartTime.Time)
+	}
+	return metav1.Now().Sub(job.Status.StartTime.Time)
+}
+
+// DashboardURL returns the url of the ray dashboard,it is only reachable in the cluster
+func (rj *RayJob) DashboardURL() string {
+	url := rj.rayjob.Status.DashboardURL
+	if url == "" {
+		return ""
+	}
+	if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
+		url = "http://" + url
+	}
+	return url
+}
+
+// Get Dashboard url of the job
+func (rj *RayJob) GetJobDashboards(client *kubernetes.Clientset, namespace, arenaNamespace string) ([]string, error) {
+	url := rj.DashboardURL()
+	if url == "" {
+		return []string{}, fmt.Errorf("the ray dashboard of job %v is not ready", rj.name)
+	}
+	return []string{url}, nil
+}
+
+// Requested GPU count of the Job
+func (rj *RayJob) RequestedGPU() int64 {
+	if rj.requestedGPU > 0 {
+		return rj.requestedGPU
+	}
+	requestGPUs := getRequestGPUsOfJobFromPodAnnotation(rj.pods)
+	if requestGPUs > 0 {
+		return requestGPUs
+	}
+	for _, pod := range rj.pods {
+		rj.requestedGPU += gpuInPod(*pod)
+	}
+	return rj.requestedGPU
+}
+
+// Requested GPU count of the Job
+func (rj *RayJob) AllocatedGPU() int64 {
+	if rj.allocatedGPU > 0 {
+		return rj.allocatedGPU
+	}
+	for _, pod := range rj.pods {
+		rj.allocatedGPU += gpuInActivePod(*pod)
+	}
+	return rj.allocatedGPU
+}
+
+// Get the hostIP of the chief Pod
+func (rj *RayJob) HostIPOfChief() (hostIP string) {
+	hostIP = "N/A"
+	if rj.GetStatus() == "RUNNING" && rj.chiefPod != nil {
+		hostIP = rj.chiefPod.Status.HostIP
+	}
+	return hostIP
+}
+
+func (rj *RayJob) Namespace() string {
+	return rj.rayjob.Namespace
+}
+
+// Get PriorityClass
+func (rj *RayJob) GetPriorityClass() string {
+	spec := rj.rayjob.Spec.RayClusterSpec
+	if spec == nil {
+		return ""
+	}
+	return spec.HeadGroupSpec.Template.Spec.PriorityClassName
+}
+
+// RayJobTrainer is the trainer of the RayJob of KubeRay
+type RayJobTrainer struct {
+	client       *kubernetes.Clientset
+	rayjobClient *versioned.Clientset
+	trainerType  types.TrainingJobType
+	// check if it's enabled
+	enabled bool
+}
+
+// NewRayJobTrainer
+func NewRayJobTrainer() Trainer {
+	enable := false
+	rayjobClient := versioned.NewForConfigOrDie(config.GetArenaConfiger().GetRestConfig())
+	_, err := config.GetArenaConfiger().GetAPIExtensionClientSet().ApiextensionsV1().CustomResourceDefinitions().Get(context.TODO(), k8saccesser.RayJobCRDName, metav1.GetOptions{})
+	if err == nil {
+		log.Debugf("RayJobTrainer is enabled")
+		enable = true
+	} else {
+		log.Debugf("RayJobTrainer is disabled,reason: %v", err)
+	}
+	log.Debugf("Succeed to init RayJobTrainer")
+	return &RayJobTrainer{
+		rayjobClient: rayjobClient,
+		client:       config.GetArenaConfiger().GetClientSet(),
+		trainerType:  types.RayTrainingJob,
+		enabled:      enable,
+	}
+}
+
+// IsEnabled is used to get the trainer is enable or not
+func (rt *RayJobTrainer) IsEnabled() bool {
+	return rt.enabled
+}
+
+// Get the type
+func (rt *RayJobTrainer) Type() types.TrainingJobType {
+	return rt.trainerType
+}
+
+// check if it's ray job
+func (rt *RayJobTrainer) IsSupported(name, ns string) bool {
+	if !rt.enabled {
+		return false
+	}
+	_, err := rt.GetTrainingJob(name, ns)
+	return err == nil
+}
+
+// Get the training job from cache or directly
+func (rt *RayJobTrainer) GetTrainingJob(name, namespace string) (TrainingJob, error) {
+	rayjob, err := k8saccesser.GetK8sResourceAccesser().GetRayJob(rt.rayjobClient, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := CheckJobIsOwnedByTrainer(rayjob.Labels); err != nil {
+		return nil, err
+	}
+	allPods, err := k8saccesser.GetK8sResourceAccesser().ListPods(namespace, fmt.Sprintf("release=%v,app=%v", name, rt.Type()), "", nil)
+	if err != nil {
+		return nil, err
+	}
+	pods, chiefPod := getPodsOfRayJob(rayjob, allPods)
+	return &RayJob{
+		BasicJobInfo: &BasicJobInfo{
+			resources: podResources(pods),
+			name:      name,
+		},
+		rayjob:      rayjob,
+		chiefPod:    chiefPod,
+		pods:        pods,
+		trainerType: rt.Type(),
+	}, nil
+}
+
+// List Training jobs
+func (rt *RayJobTrainer) ListTrainingJobs(namespace string, allNamespace bool) ([]TrainingJob, error) {
+	if allNamespace {
+		namespace = metav1.NamespaceAll
+	}
+	trainingJobs := []TrainingJob{}
+	jobLabels := GetTrainingJobLabels(rt.Type())
+	rayjobs, err := k8saccesser.GetK8sResourceAccesser().ListRayJobs(rt.rayjobClient, namespace, jobLabels)
+	if err != nil {
+		return trainingJobs, err
+	}
+	pods, err := k8saccesser.GetK8sResourceAccesser().ListPods(namespace, fmt.Sprintf("app=%v", rt.Type()), "", nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, rayjob := range rayjobs {
+		filterPods, chiefPod := getPodsOfRayJob(rayjob, pods)
+		trainingJobs = append(trainingJobs, &RayJob{
+			BasicJobInfo: &BasicJobInfo{
+				resources: podResources(filterPods),
+				name:      rayjob.Name,
+			},
+			rayjob:      rayjob,
+			chiefPod:    chiefPod,
+			pods:        filterPods,
+			trainerType: rt.Type(),
+		})
+	}
+	return trainingJobs, nil
+}
+
+// getPodsOfRayJob filters out the pods of rayjob,the submitter pod which runs `ray job submit`
+// is the chief pod,the head pod is used if the submitter pod is not created
+func getPodsOfRayJob(rayjob *rayv1.RayJob, podList []*v1.Pod) ([]*v1.Pod, *v1.Pod) {
+	pods, chiefPod := getPodsOfTrainingJob(rayjob.Name, rayjob.Namespace, podList, utils.IsRayPod, func(pod *v1.Pod) bool {
+		return pod.Labels[rayJobRoleLabel] == "submitter"
+	})
+	if chiefPod != nil {
+		return pods, chiefPod
+	}
+	_, chiefPod = getPodsOfTrainingJob(rayjob.Name, rayjob.Namespace, pods, utils.IsRayPod, func(pod *v1.Pod) bool {
+		return pod.Labels[rayNodeTypeLabel] == "head"
+	})
+	return pods, chiefPod
+}
@@ -94,12 +94,15 @@ func (tj *TensorFlowJob) GetLabels() map[string]string {
 	return tj.tfjob.Labels
 }
 
-// GetStatus returns the status of the Job: RUNNING, PENDING, SUCCEEDED, FAILED
+// GetStatus returns the status of the Job: RUNNING, PENDING, SUSPENDED, SUCCEEDED, FAILED
 func (tj *TensorFlowJob) GetStatus() (status string) {
 	status = "PENDING"
 	if tj.tfjob.Name == "" {
 		return status
 	}
+	if isSuspendedJobStatus(tj.tfjob.Status) {
+		return string(types.TrainingJobSuspended)
+	}
 	t := checkStatus(tj.tfjob.Status)
 	switch t {
 	case commonv1.JobCreated, commonv1.JobRestarting:
@@ -0,0 +1,84 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"testing"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+func newMPIJobCRD(versions ...apiextensionsv1.CustomResourceDefinitionVersion) *apiextensionsv1.CustomResourceDefinition {
+	// every version has the field mpiReplicaSpecs,which can not tell training-operator from mpi-operator
+	for i := range versions {
+		versions[i].Schema = &apiextensionsv1.CustomResourceValidation{
+			OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+				Properties: map[string]apiextensionsv1.JSONSchemaProps{
+					"spec": {
+						Properties: map[string]apiextensionsv1.JSONSchemaProps{
+							"mpiReplicaSpecs": {Type: "object"},
+						},
+					},
+				},
+			},
+		}
+	}
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	crd.Name = "mpijobs.kubeflow.org"
+	crd.Spec.Versions = versions
+	return crd
+}
+
+func TestServesTrainingOperatorVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		crd      *apiextensionsv1.CustomResourceDefinition
+		expected bool
+	}{
+		{
+			name:     "training-operator v1",
+			crd:      newMPIJobCRD(apiextensionsv1.CustomResourceDefinitionVersion{Name: "v1", Served: true, Storage: true}),
+			expected: true,
+		},
+		{
+			name:     "mpi-operator v2beta1",
+			crd:      newMPIJobCRD(apiextensionsv1.CustomResourceDefinitionVersion{Name: "v2beta1", Served: true, Storage: true}),
+			expected: false,
+		},
+		{
+			name: "mpi-operator v1alpha2 and v2beta1",
+			crd: newMPIJobCRD(
+				apiextensionsv1.CustomResourceDefinitionVersion{Name: "v1alpha2", Served: true},
+				apiextensionsv1.CustomResourceDefinitionVersion{Name: "v2beta1", Served: true, Storage: true},
+			),
+			expected: false,
+		},
+		{
+			name: "v1 is not served",
+			crd: newMPIJobCRD(
+				apiextensionsv1.CustomResourceDefinitionVersion{Name: "v1", Served: false},
+				apiextensionsv1.CustomResourceDefinitionVersion{Name: "v2beta1", Served: true, Storage: true},
+			),
+			expected: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := servesTrainingOperatorVersion(test.crd); got != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
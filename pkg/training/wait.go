@@ -0,0 +1,110 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package training
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/kubeflow/arena/pkg/apis/types"
+	"github.com/kubeflow/arena/pkg/k8saccesser"
+)
+
+// waitResyncPeriod is used to check the job status even though no pods or events are changed,
+// some status changes of job crd don't touch the pods
+var waitResyncPeriod = 1 * time.Minute
+
+// WaitTrainingJob blocks until the training job reaches the expected status,it returns
+// types.ErrTrainingJobStatusUnreachable if the job has finished with another status,
+// and returns the error of the context if the context is done before that.
+func WaitTrainingJob(ctx context.Context, jobName, namespace string, jobType types.TrainingJobType, expected types.TrainingJobStatus) (types.TrainingJobStatus, error) {
+	job, err := SearchTrainingJob(jobName, namespace, jobType)
+	if err != nil {
+		return "", err
+	}
+	jobType = job.Trainer()
+	status := types.TrainingJobStatus(GetJobRealStatus(job))
+	if finished, err := checkWaitStatus(status, expected); finished {
+		return status, err
+	}
+	accesser := k8saccesser.GetK8sResourceAccesser()
+	podLabels := fmt.Sprintf("release=%v", jobName)
+	eventFields := fmt.Sprintf("involvedObject.name=%v", jobName)
+	var podWatcher, eventWatcher watch.Interface
+	defer func() {
+		for _, w := range []watch.Interface{podWatcher, eventWatcher} {
+			if w != nil {
+				w.Stop()
+			}
+		}
+	}()
+	ticker := time.NewTicker(waitResyncPeriod)
+	defer ticker.Stop()
+	for {
+		// the watchers are closed by api server periodically,create them again
+		if podWatcher == nil {
+			if podWatcher, err = accesser.WatchPods(ctx, namespace, podLabels, ""); err != nil {
+				return status, err
+			}
+		}
+		if eventWatcher == nil {
+			if eventWatcher, err = accesser.WatchEvents(ctx, namespace, eventFields); err != nil {
+				return status, err
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return status, ctx.Err()
+		case _, ok := <-podWatcher.ResultChan():
+			if !ok {
+				podWatcher = nil
+				continue
+			}
+		case _, ok := <-eventWatcher.ResultChan():
+			if !ok {
+				eventWatcher = nil
+				continue
+			}
+		case <-ticker.C:
+		}
+		job, err = SearchTrainingJob(jobName, namespace, jobType)
+		if err != nil {
+			return status, err
+		}
+		newStatus := types.TrainingJobStatus(GetJobRealStatus(job))
+		if newStatus != status {
+			log.Infof("the status of job %v has changed from %v to %v", jobName, status, newStatus)
+			status = newStatus
+		}
+		if finished, err := checkWaitStatus(status, expected); finished {
+			return status, err
+		}
+	}
+}
+
+// checkWaitStatus returns true if there is no need to wait the job any more
+func checkWaitStatus(status, expected types.TrainingJobStatus) (bool, error) {
+	if status == expected {
+		return true, nil
+	}
+	if status == types.TrainingJobSucceeded || status == types.TrainingJobFailed {
+		return true, types.ErrTrainingJobStatusUnreachable
+	}
+	return false, nil
+}
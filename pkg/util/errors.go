@@ -34,3 +34,17 @@ func IsConnectionRefusedError(err error) bool {
 func IsUnexpectedEOFError(err error) bool {
 	return checkError(err, "unexpected EOF")
 }
+
+// ExitError is an error which carries the exit code of the command
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+func (e *ExitError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ExitError) Unwrap() error {
+	return e.Err
+}
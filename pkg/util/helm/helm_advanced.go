@@ -17,11 +17,15 @@ package helm
 import (
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strings"
 
 	log "github.com/sirupsen/logrus"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/engine"
+	"helm.sh/helm/v3/pkg/releaseutil"
+	"helm.sh/helm/v3/pkg/strvals"
 )
 
 /*
@@ -44,8 +48,8 @@ func GenerateValueFile(values interface{}) (valueFileName string, err error) {
 }
 
 /**
-* generate helm template without tiller: helm template -f values.yaml chart_name
-* Exec /usr/local/bin/helm, [template -f /tmp/values313606961 --namespace default --name hj /charts/tf-horovod]
+* generate helm template without tiller,it works like: helm template -f values.yaml --namespace default hj /charts/tf-horovod
+* the chart is rendered in process by the helm library,the supported options are --set,--set-string and --set-file
 * returns generated template file: templateFileName
  */
 func GenerateHelmTemplate(name string, namespace string, valueFileName string, chartName string, options ...string) (templateFileName string, err error) {
@@ -54,95 +58,105 @@ func GenerateHelmTemplate(name string, namespace string, valueFileName string, c
 	if err != nil {
 		return templateFileName, err
 	}
+	defer templateFile.Close()
 	templateFileName = templateFile.Name()
 
-	binary, err := exec.LookPath(helmCmd[0])
+	// 1. load the chart
+	chart, err := loader.Load(chartName)
 	if err != nil {
-		return templateFileName, err
+		return templateFileName, fmt.Errorf("failed to load chart %v: %v", chartName, err)
 	}
 
-	// 3. check if the chart file exists
-	// if strings.HasPrefix(chartName, "/") {
-	if _, err = os.Stat(chartName); err != nil {
+	// 2. merge the values file with the options
+	values, err := chartutil.ReadValuesFile(valueFileName)
+	if err != nil {
+		return templateFileName, fmt.Errorf("failed to read values file %v: %v", valueFileName, err)
+	}
+	if err := parseHelmOptions(values, options); err != nil {
+		return templateFileName, err
+	}
+	if err := chartutil.ProcessDependencies(chart, values); err != nil {
 		return templateFileName, err
 	}
-	// }
 
-	// 4. prepare the arguments
-	args := []string{binary, "template", "-f", valueFileName,
-		"--namespace", namespace,
-		name,
+	// 3. render the templates
+	releaseOptions := chartutil.ReleaseOptions{
+		Name:      name,
+		Namespace: namespace,
+		Revision:  1,
+		IsInstall: true,
 	}
-	if len(options) != 0 {
-		args = append(args, options...)
+	renderValues, err := chartutil.ToRenderValues(chart, values, releaseOptions, chartutil.DefaultCapabilities)
+	if err != nil {
+		return templateFileName, err
 	}
-
-	args = append(args, []string{chartName, ">", templateFileName}...)
-
-	log.Debugf("Exec bash -c %v", args)
-
-	// return syscall.Exec(cmd, args, env)
-	// 5. execute the command
-	log.Debugf("Generating template  %v", args)
-	cmd := exec.Command("bash", "-c", strings.Join(args, " "))
-	// cmd.Env = env
-	out, err := cmd.CombinedOutput()
-	fmt.Printf("%s", string(out))
+	log.Debugf("Generating template of chart %v with values file %v", chartName, valueFileName)
+	files, err := engine.Render(chart, renderValues)
 	if err != nil {
-		return templateFileName, fmt.Errorf("Failed to execute %s, %v with %v", binary, args, err)
+		return templateFileName, fmt.Errorf("failed to render chart %v: %v", chartName, err)
 	}
 
-	// // 6. clean up the value file if needed
-	// if log.GetLevel() != log.DebugLevel {
-	// 	err = os.Remove(valueFileName)
-	// 	if err != nil {
-	// 		log.Warnf("Failed to delete %s due to %v", valueFileName, err)
-	// 	}
-	// }
-
+	// 4. write the manifests in the install order
+	hooks, manifests, err := releaseutil.SortManifests(files, chartutil.DefaultCapabilities.APIVersions, releaseutil.InstallOrder)
+	if err != nil {
+		return templateFileName, fmt.Errorf("failed to parse the manifests of chart %v: %v", chartName, err)
+	}
+	for _, m := range manifests {
+		if _, err := fmt.Fprintf(templateFile, "---\n# Source: %s\n%s\n", m.Name, m.Content); err != nil {
+			return templateFileName, err
+		}
+	}
+	for _, h := range hooks {
+		if _, err := fmt.Fprintf(templateFile, "---\n# Source: %s\n%s\n", h.Path, h.Manifest); err != nil {
+			return templateFileName, err
+		}
+	}
 	return templateFileName, nil
 }
 
+// parseHelmOptions merges the helm options like "--set-file key=path" into the values
+func parseHelmOptions(values map[string]interface{}, options []string) error {
+	for _, option := range options {
+		flag, value, found := strings.Cut(strings.TrimSpace(option), " ")
+		if !found {
+			flag, value, _ = strings.Cut(option, "=")
+		}
+		value = strings.TrimSpace(value)
+		var err error
+		switch flag {
+		case "--set":
+			err = strvals.ParseInto(value, values)
+		case "--set-string":
+			err = strvals.ParseIntoString(value, values)
+		case "--set-file":
+			err = strvals.ParseIntoFile(value, values, func(rs []rune) (interface{}, error) {
+				content, err := os.ReadFile(string(rs))
+				return string(content), err
+			})
+		default:
+			return fmt.Errorf("unsupported helm option %v", option)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to parse helm option %v: %v", option, err)
+		}
+	}
+	return nil
+}
+
 /**
 * Check the chart version by given the chart directory
-* helm inspect chart /charts/tf-horovod
+* it works like: helm inspect chart /charts/tf-horovod
  */
 
 func GetChartVersion(chart string) (version string, err error) {
-	binary, err := exec.LookPath(helmCmd[0])
+	c, err := loader.Load(chart)
 	if err != nil {
-		return "", err
+		return "", fmt.Errorf("failed to load chart %v: %v", chart, err)
 	}
-
-	// 1. check if the chart file exists, if it's it's unix path, then check if it's exist
-	// if strings.HasPrefix(chart, "/") {
-	if _, err = os.Stat(chart); err != nil {
-		return "", err
+	if c.Metadata == nil || c.Metadata.Version == "" {
+		return "", fmt.Errorf("failed to find version of chart %v", chart)
 	}
-	// }
-
-	// 2. prepare the arguments
-	args := []string{binary, "inspect", "chart", chart,
-		"|", "grep", "version:"}
-	log.Debugf("Exec bash -c %v", args)
-
-	cmd := exec.Command("bash", "-c", strings.Join(args, " "))
-	out, err := cmd.Output()
-	if err != nil {
-		return "", err
-	}
-
-	lines := strings.Split(string(out), "\n")
-	if len(lines) == 0 {
-		return "", fmt.Errorf("Failed to find version when executing %s, result is %s", args, out)
-	}
-	fields := strings.Split(lines[0], ":")
-	if len(fields) != 2 {
-		return "", fmt.Errorf("Failed to find version when executing %s, result is %s", args, out)
-	}
-
-	version = strings.TrimSpace(fields[1])
-	return version, nil
+	return c.Metadata.Version, nil
 }
 
 func GetChartName(chart string) string {
@@ -108,3 +108,27 @@ func CreateAppConfigmap(name, namespace, configFileName, appInfoFileName, chartN
 	_, err = client.CoreV1().ConfigMaps(namespace).Create(context.TODO(), configmap, metav1.CreateOptions{})
 	return err
 }
+
+// SaveConfigMapData creates the configmap with the data,or replaces the data if the configmap exists
+func SaveConfigMapData(namespace, name string, labels map[string]string, data map[string]string) error {
+	client := config.GetArenaConfiger().GetClientSet()
+	configmap, err := client.CoreV1().ConfigMaps(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err == nil {
+		configmap.Data = data
+		_, err = client.CoreV1().ConfigMaps(namespace).Update(context.TODO(), configmap, metav1.UpdateOptions{})
+		return err
+	}
+	if !k8serrors.IsNotFound(err) {
+		return err
+	}
+	configmap = &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    labels,
+		},
+		Data: data,
+	}
+	_, err = client.CoreV1().ConfigMaps(namespace).Create(context.TODO(), configmap, metav1.CreateOptions{})
+	return err
+}
@@ -0,0 +1,140 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubectl
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/restmapper"
+
+	"github.com/kubeflow/arena/pkg/apis/config"
+)
+
+var (
+	clientsOnce   sync.Once
+	clientsErr    error
+	kubeClient    kubernetes.Interface
+	dynamicClient dynamic.Interface
+	restMapper    meta.RESTMapper
+)
+
+// SetClients replaces the clients used to access the cluster,it is used to run
+// the functions of this package with fake clients
+func SetClients(kube kubernetes.Interface, dc dynamic.Interface, mapper meta.RESTMapper) {
+	clientsOnce.Do(func() {})
+	kubeClient, dynamicClient, restMapper, clientsErr = kube, dc, mapper, nil
+}
+
+// initClients creates the clients from the arena configer at the first time they are used
+func initClients() error {
+	clientsOnce.Do(func() {
+		arenaConfiger := config.GetArenaConfiger()
+		clientset := arenaConfiger.GetClientSet()
+		dc, err := dynamic.NewForConfig(arenaConfiger.GetRestConfig())
+		if err != nil {
+			clientsErr = fmt.Errorf("failed to create dynamic client: %v", err)
+			return
+		}
+		kubeClient = clientset
+		dynamicClient = dc
+		restMapper = restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(clientset.Discovery()))
+	})
+	return clientsErr
+}
+
+// resourceInterface returns the dynamic client of the resource,the namespace is ignored
+// if the resource is cluster scoped
+func resourceInterface(gvk schema.GroupVersionKind, namespace string) (dynamic.ResourceInterface, error) {
+	if err := initClients(); err != nil {
+		return nil, err
+	}
+	mapping, err := restMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find the resource of %v: %v", gvk, err)
+	}
+	if mapping.Scope.Name() == meta.RESTScopeNameRoot {
+		return dynamicClient.Resource(mapping.Resource), nil
+	}
+	return dynamicClient.Resource(mapping.Resource).Namespace(namespace), nil
+}
+
+// resourceInterfaceFor returns the dynamic client of the resource given as "<resource>.<group>",
+// like "tfjob.kubeflow.org", "tfjobs.kubeflow.org" or "configmap"
+func resourceInterfaceFor(resource, namespace string) (dynamic.ResourceInterface, error) {
+	if err := initClients(); err != nil {
+		return nil, err
+	}
+	gvk, err := restMapper.KindFor(schema.ParseGroupResource(resource).WithVersion(""))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find the resource %v: %v", resource, err)
+	}
+	return resourceInterface(gvk, namespace)
+}
+
+// appInfoResource returns the app info of the object,it is the same as the output of
+// "kubectl create --dry-run=client -o name",like "tfjob.kubeflow.org/tf-test"
+func appInfoResource(obj *unstructured.Unstructured) string {
+	return fmt.Sprintf("%s/%s", strings.ToLower(obj.GroupVersionKind().GroupKind().String()), obj.GetName())
+}
+
+// parseAppInfoResource parses the resource of app info like "tfjob.kubeflow.org/tf-test"
+func parseAppInfoResource(resource string) (string, string, error) {
+	kind, name, found := strings.Cut(strings.TrimSpace(resource), "/")
+	if !found || kind == "" || name == "" {
+		return "", "", fmt.Errorf("invalid resource %v in app info", resource)
+	}
+	return kind, name, nil
+}
+
+// readManifests reads the kubernetes objects from the manifests file rendered by helm
+func readManifests(fileName string) ([]*unstructured.Unstructured, error) {
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	objects := []*unstructured.Unstructured{}
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bytes.NewReader(content), 4096)
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("failed to parse manifests %v: %v", fileName, err)
+		}
+		// skip the empty documents
+		if len(obj.Object) == 0 {
+			continue
+		}
+		if obj.GetKind() == "" || obj.GetName() == "" {
+			return nil, fmt.Errorf("failed to parse manifests %v: kind and name of object must be set", fileName)
+		}
+		objects = append(objects, obj)
+	}
+	return objects, nil
+}
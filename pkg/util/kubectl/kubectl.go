@@ -19,7 +19,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"os/exec"
 	"strings"
 	"sync"
 
@@ -27,62 +26,49 @@ import (
 	kserveClient "github.com/kserve/kserve/pkg/client/clientset/versioned"
 	log "github.com/sirupsen/logrus"
 	v1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
 
 	"github.com/kubeflow/arena/pkg/apis/config"
 )
 
-var kubectlCmd = []string{"arena-kubectl"}
+// fieldManager is the name of manager which is recorded in the managed fields of objects
+const fieldManager = "arena"
 
 /**
-* dry-run creating kubernetes App Info for delete in future
-* Exec /usr/local/bin/kubectl, [create --dry-run -f /tmp/values313606961 --namespace default]
+* dry-run creating kubernetes App Info for delete in future,it works like:
+* kubectl create --dry-run=client -o name -f /tmp/values313606961 --namespace default
 **/
 
 func SaveAppInfo(fileName, namespace string) (configFileName string, err error) {
-	if _, err = os.Stat(fileName); os.IsNotExist(err) {
+	objects, err := readManifests(fileName)
+	if err != nil {
 		return "", err
 	}
-
-	args := []string{"create", "--dry-run=client", "--namespace", namespace, "-f", fileName}
-	out, err := kubectl(args)
-	output := string(out)
 	result := []string{}
-
-	// fmt.Printf("%s\n", string(out))
-	if err != nil {
-		log.Errorf("Failed to execute %s, %v with %v", "kubectl", args, err)
-		log.Errorf("The output is %s\n", output)
-		return "", err
+	for _, obj := range objects {
+		result = append(result, appInfoResource(obj))
 	}
+	log.Debugf("app info of %v: %v", fileName, result)
 
 	// 1. generate the config file
 	configFile, err := os.CreateTemp("", "config")
 	if err != nil {
-		log.Errorf("Failed to create tmp file %v due to %v", configFile.Name(), err)
+		log.Errorf("Failed to create tmp file due to %v", err)
 		return "", err
 	}
+	defer configFile.Close()
 
 	configFileName = configFile.Name()
 	log.Debugf("Save the config file %s", configFileName)
 
 	// 2. save app types to config file
-	lines := strings.Split(output, "\n")
-	log.Debugf("dry run result: %v", lines)
-
-	for _, line := range lines {
-		line := strings.TrimSpace(line)
-		cols := strings.Fields(line)
-		log.Debugf("cols: %s, %d", cols, len(cols))
-		if len(cols) == 0 {
-			continue
-		}
-		result = append(result, cols[0])
-	}
-
 	data := []byte(strings.Join(result, "\n"))
-	defer configFile.Close()
 	_, err = configFile.Write(data)
 	if err != nil {
 		log.Errorf("Failed to write %v to %s due to %v", data, configFileName, err)
@@ -93,41 +79,48 @@ func SaveAppInfo(fileName, namespace string) (configFileName string, err error)
 }
 
 /**
-* Delete kubernetes config to uninstall app
-* Exec /usr/local/bin/kubectl, [delete -f /tmp/values313606961 --namespace default]
+* Delete kubernetes config to uninstall app,it works like:
+* kubectl delete -f /tmp/values313606961 --namespace default
 **/
 func UninstallApps(fileName, namespace string) (err error) {
-	if _, err = os.Stat(fileName); os.IsNotExist(err) {
+	objects, err := readManifests(fileName)
+	if err != nil {
 		return err
 	}
-
-	args := []string{"delete", "--namespace", namespace, "-f", fileName}
-	out, err := kubectl(args)
-
-	fmt.Printf("%s\n", string(out))
-	if err != nil {
-		log.Debugf("Failed to execute %s, %v with %v", "kubectl", args, err)
+	errs := []string{}
+	for _, obj := range objects {
+		resource := appInfoResource(obj)
+		ri, err := resourceInterface(obj.GroupVersionKind(), namespaceOf(obj, namespace))
+		if err == nil {
+			err = deleteResource(ri, obj.GetName())
+		}
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("failed to delete %v: %v", resource, err))
+			continue
+		}
+		fmt.Printf("%s deleted\n", resource)
 	}
-
-	return err
+	if len(errs) != 0 {
+		return fmt.Errorf("%v", strings.Join(errs, "\n"))
+	}
+	return nil
 }
 
 /**
-* Delete kubernetes config to uninstall app
-* Exec /usr/local/bin/kubectl, [delete -f /tmp/values313606961 --namespace default]
+* Delete the resources recorded in the app info file to uninstall app,
+* the resources which are not found are ignored
 **/
 func UninstallAppsWithAppInfoFile(appInfoFile, namespace string) error {
-	binary, err := exec.LookPath(kubectlCmd[0])
-	if err != nil {
-		return err
-	}
-
 	data, err := os.ReadFile(appInfoFile)
 	if err != nil {
 		return err
 	}
-	resources := strings.Split(string(data), "\n")
-	// Error from server (NotFound): tfjobs.kubeflow.org "tf-standalone-test-3" not found
+	resources := []string{}
+	for _, r := range strings.Split(string(data), "\n") {
+		if strings.TrimSpace(r) != "" {
+			resources = append(resources, r)
+		}
+	}
 
 	var wg sync.WaitGroup
 	locker := new(sync.RWMutex)
@@ -137,19 +130,14 @@ func UninstallAppsWithAppInfoFile(appInfoFile, namespace string) error {
 		resource := r
 		go func() {
 			defer wg.Done()
-			args := []string{binary, "delete", resource, "--namespace", namespace}
-			log.Debugf("Exec bash -c %v", args)
-			cmd := exec.Command("bash", "-c", strings.Join(args, " "))
-			out, err := cmd.CombinedOutput()
-			if err != nil {
-				if !strings.Contains(string(out), "Error from server (NotFound): ") {
-					locker.Lock()
-					errs = append(errs, string(out))
-					locker.Unlock()
-				}
+			err := deleteAppInfoResource(resource, namespace)
+			if err != nil && !k8serrors.IsNotFound(err) {
+				locker.Lock()
+				errs = append(errs, fmt.Sprintf("failed to delete %v: %v", resource, err))
+				locker.Unlock()
 				return
 			}
-			log.Debugf("%v", out)
+			log.Debugf("%v is deleted", resource)
 		}()
 	}
 	wg.Wait()
@@ -160,24 +148,89 @@ func UninstallAppsWithAppInfoFile(appInfoFile, namespace string) error {
 	return nil
 }
 
+func deleteAppInfoResource(resource, namespace string) error {
+	kind, name, err := parseAppInfoResource(resource)
+	if err != nil {
+		return err
+	}
+	ri, err := resourceInterfaceFor(kind, namespace)
+	if err != nil {
+		return err
+	}
+	return deleteResource(ri, name)
+}
+
+func deleteResource(ri dynamic.ResourceInterface, name string) error {
+	propagation := metav1.DeletePropagationBackground
+	return ri.Delete(context.TODO(), name, metav1.DeleteOptions{PropagationPolicy: &propagation})
+}
+
 /**
-* Apply kubernetes config to install app
-* Exec /usr/local/bin/kubectl, [apply -f /tmp/values313606961 --namespace default]
+* Apply kubernetes config to install app,it works like:
+* kubectl apply -f /tmp/values313606961 --namespace default
+* the objects are validated by the api server without being persisted if dryRun is true
 **/
-func InstallApps(fileName, namespace string) (output string, err error) {
-	if _, err = os.Stat(fileName); os.IsNotExist(err) {
+func InstallApps(fileName, namespace string, dryRun bool) (output string, err error) {
+	objects, err := readManifests(fileName)
+	if err != nil {
 		return output, err
 	}
+	suffix := ""
+	var dryRunOption []string
+	if dryRun {
+		suffix = " (server dry run)"
+		dryRunOption = []string{metav1.DryRunAll}
+	}
+	lines := []string{}
+	for _, obj := range objects {
+		resource := appInfoResource(obj)
+		action, err := applyObject(obj, namespace, dryRunOption)
+		if err != nil {
+			log.Debugf("Failed to apply %v: %v", resource, err)
+			return strings.Join(lines, ""), fmt.Errorf("failed to apply %v: %v", resource, err)
+		}
+		lines = append(lines, fmt.Sprintf("%s %s%s\n", resource, action, suffix))
+	}
+	output = strings.Join(lines, "")
+	log.Debugf("%s", output)
+	return output, nil
+}
 
-	args := []string{"apply", "--namespace", namespace, "-f", fileName}
-	out, err := kubectl(args)
-
-	log.Debugf("%s", string(out))
+// applyObject creates the object,or updates it if it already exists
+func applyObject(obj *unstructured.Unstructured, namespace string, dryRun []string) (string, error) {
+	ri, err := resourceInterface(obj.GroupVersionKind(), namespaceOf(obj, namespace))
 	if err != nil {
-		log.Debugf("Failed to execute %s, %v with %v", "kubectl", args, err)
+		return "", err
+	}
+	obj = obj.DeepCopy()
+	if obj.GetNamespace() == "" {
+		obj.SetNamespace(namespace)
+	}
+	_, err = ri.Create(context.TODO(), obj, metav1.CreateOptions{DryRun: dryRun, FieldManager: fieldManager})
+	if err == nil {
+		return "created", nil
 	}
+	if !k8serrors.IsAlreadyExists(err) {
+		return "", err
+	}
+	existing, err := ri.Get(context.TODO(), obj.GetName(), metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	obj.SetResourceVersion(existing.GetResourceVersion())
+	_, err = ri.Update(context.TODO(), obj, metav1.UpdateOptions{DryRun: dryRun, FieldManager: fieldManager})
+	if err != nil {
+		return "", err
+	}
+	return "configured", nil
+}
 
-	return string(out), err
+// namespaceOf returns the namespace of object,the default namespace is returned if it is not set
+func namespaceOf(obj *unstructured.Unstructured, namespace string) string {
+	if obj.GetNamespace() != "" {
+		return obj.GetNamespace()
+	}
+	return namespace
 }
 
 /**
@@ -185,42 +238,92 @@ func InstallApps(fileName, namespace string) (output string, err error) {
 * create configMap by using name, namespace and configFile
 **/
 func CreateAppConfigmap(name, trainingType, namespace, configFileName, appInfoFileName, chartName, chartVersion string) (err error) {
-	if _, err = os.Stat(configFileName); os.IsNotExist(err) {
+	values, err := os.ReadFile(configFileName)
+	if err != nil {
 		return err
 	}
-
-	if _, err = os.Stat(appInfoFileName); os.IsNotExist(err) {
+	appInfo, err := os.ReadFile(appInfoFileName)
+	if err != nil {
 		return err
 	}
+	if err := initClients(); err != nil {
+		return err
+	}
+	configmap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s", name, trainingType),
+			Namespace: namespace,
+		},
+		Data: map[string]string{
+			"values":  string(values),
+			"app":     string(appInfo),
+			chartName: chartVersion,
+		},
+	}
+	_, err = kubeClient.CoreV1().ConfigMaps(namespace).Create(context.TODO(), configmap, metav1.CreateOptions{})
+	if err != nil {
+		log.Debugf("Failed to create configmap %v: %v", configmap.Name, err)
+		return err
+	}
+	fmt.Printf("configmap/%s created\n", configmap.Name)
+	return nil
+}
 
-	args := []string{"create", "configmap", fmt.Sprintf("%s-%s", name, trainingType),
-		"--namespace", namespace,
-		fmt.Sprintf("--from-file=%s=%s", "values", configFileName),
-		fmt.Sprintf("--from-file=%s=%s", "app", appInfoFileName),
-		fmt.Sprintf("--from-literal=%s=%s", chartName, chartVersion)}
-	// "--overrides='{\"metadata\":{\"label\":\"createdBy\": \"arena\"}}'"}
-	out, err := kubectl(args)
+// LabelAppConfigmap adds the label like "createdBy=arena" to the configmap of app
+func LabelAppConfigmap(name, trainingType, namespace, label string) (err error) {
+	key, value, found := strings.Cut(label, "=")
+	if !found {
+		return fmt.Errorf("invalid label %v,it should be key=value", label)
+	}
+	configmapName := fmt.Sprintf("%s-%s", name, trainingType)
+	err = labelResource("configmap", configmapName, namespace, key, value)
+	if err != nil {
+		log.Debugf("Failed to label configmap %v: %v", configmapName, err)
+		return err
+	}
+	fmt.Printf("configmap/%s labeled\n", configmapName)
+	return nil
+}
 
-	fmt.Printf("%s", string(out))
+// labelResource adds the label to the resource given as "<resource>.<group>",like "tfjobs.kubeflow.org"
+func labelResource(resource, name, namespace, key, value string) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": map[string]string{key: value},
+		},
+	})
 	if err != nil {
-		log.Debugf("Failed to execute %s, %v with %v", "kubectl", args, err)
+		return err
 	}
+	return MergePatchResource(resource, name, namespace, patch)
+}
 
+// MergePatchResource applies the json merge patch to the resource given as "<resource>.<group>",like "tfjobs.kubeflow.org"
+func MergePatchResource(resource, name, namespace string, patch []byte) error {
+	ri, err := resourceInterfaceFor(resource, namespace)
+	if err != nil {
+		return err
+	}
+	_, err = ri.Patch(context.TODO(), name, k8stypes.MergePatchType, patch, metav1.PatchOptions{FieldManager: fieldManager})
 	return err
 }
 
-func LabelAppConfigmap(name, trainingType, namespace, label string) (err error) {
-	args := []string{"label", "configmap", fmt.Sprintf("%s-%s", name, trainingType),
-		"--namespace", namespace,
-		label}
-	// "--overrides='{\"metadata\":{\"label\":\"createdBy\": \"arena\"}}'"}
-	out, err := kubectl(args)
-
-	fmt.Printf("%s", string(out))
+// GetResource returns the object of the resource given as "<resource>.<group>",like "seldondeployments.machinelearning.seldon.io"
+func GetResource(resource, name, namespace string) (*unstructured.Unstructured, error) {
+	ri, err := resourceInterfaceFor(resource, namespace)
 	if err != nil {
-		log.Debugf("Failed to execute %s, %v with %v", "kubectl", args, err)
+		return nil, err
 	}
+	return ri.Get(context.TODO(), name, metav1.GetOptions{})
+}
 
+// UpdateResource updates the object of the resource given as "<resource>.<group>"
+func UpdateResource(resource string, obj *unstructured.Unstructured) error {
+	ri, err := resourceInterfaceFor(resource, obj.GetNamespace())
+	if err != nil {
+		return err
+	}
+	_, err = ri.Update(context.TODO(), obj, metav1.UpdateOptions{FieldManager: fieldManager})
 	return err
 }
 
@@ -229,17 +332,15 @@ func LabelAppConfigmap(name, trainingType, namespace, label string) (err error)
 * delete configMap by using name, namespace
 **/
 func DeleteAppConfigMap(name, namespace string) (err error) {
-	args := []string{"delete", "configmap", name, "--namespace", namespace}
-	out, err := kubectl(args)
-
-	if err != nil && !strings.Contains(string(out), "Error from server (NotFound): ") {
-		log.Debugf("Failed to execute %s, %v with %v", "kubectl", args, err)
-		log.Debugf("%s", string(out))
+	if err := initClients(); err != nil {
 		return err
-	} else {
-		log.Debugf("configmap %s has been deleted successfully", name)
 	}
-
+	err = kubeClient.CoreV1().ConfigMaps(namespace).Delete(context.TODO(), name, metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		log.Debugf("Failed to delete configmap %v: %v", name, err)
+		return err
+	}
+	log.Debugf("configmap %s has been deleted successfully", name)
 	return nil
 }
 
@@ -248,21 +349,16 @@ func DeleteAppConfigMap(name, namespace string) (err error) {
 * get configMap by using name, namespace
 **/
 func CheckAppConfigMap(name, namespace string) (found bool) {
-	args := []string{"get", "configmap", name, "--namespace", namespace}
-	out, err := kubectl(args)
-
+	if err := initClients(); err != nil {
+		log.Debugf("Failed to get configmap %v: %v", name, err)
+		return false
+	}
+	_, err := kubeClient.CoreV1().ConfigMaps(namespace).Get(context.TODO(), name, metav1.GetOptions{})
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(`Error from server (NotFound): configmaps "%v" not found`, name)) {
-			found = false
-		}
-		log.Debugf("Failed to execute %s, %v with %v", "kubectl", args, err)
-		log.Debugf("%s", string(out))
-	} else {
-		log.Debugf("%s", string(out))
-		found = true
+		log.Debugf("Failed to get configmap %v: %v", name, err)
+		return false
 	}
-
-	return found
+	return true
 }
 
 /**
@@ -270,75 +366,39 @@ func CheckAppConfigMap(name, namespace string) (found bool) {
 * save the key of configMap into a file
 **/
 func SaveAppConfigMapToFile(name, key, namespace string) (fileName string, err error) {
-	binary, err := exec.LookPath(kubectlCmd[0])
-	if err != nil {
+	if err := initClients(); err != nil {
 		return "", err
 	}
+	configmap, err := kubeClient.CoreV1().ConfigMaps(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get configmap %v: %v", name, err)
+	}
 
 	file, err := os.CreateTemp(os.TempDir(), name)
 	if err != nil {
-		log.Errorf("Failed to create tmp file %v due to %v", file.Name(), err)
+		log.Errorf("Failed to create tmp file due to %v", err)
 		return fileName, err
 	}
+	defer file.Close()
 	fileName = file.Name()
+	log.Debugf("Save the key %v of configmap %v to %v", key, name, fileName)
 
-	args := []string{binary, "get", "configmap", name,
-		"--namespace", namespace,
-		fmt.Sprintf("-o=jsonpath='{.data.%s}'", key),
-		">", fileName}
-	log.Debugf("Exec bash -c %s", strings.Join(args, " "))
-
-	cmd := exec.Command("bash", "-c", strings.Join(args, " "))
-	out, err := cmd.Output()
-	fmt.Printf("%s", string(out))
-
-	if err != nil {
-		return fileName, fmt.Errorf("Failed to execute %s, %v with %v", "kubectl", args, err)
-	}
+	_, err = file.WriteString(configmap.Data[key])
 	return fileName, err
 }
 
-func kubectl(args []string) ([]byte, error) {
-	binary, err := exec.LookPath(kubectlCmd[0])
+func GetCrdNames() ([]string, error) {
+	ri, err := resourceInterface(apiextensionsv1.SchemeGroupVersion.WithKind("CustomResourceDefinition"), "")
 	if err != nil {
 		return nil, err
 	}
-
-	// 1. prepare the arguments
-	// args := []string{"create", "configmap", name, "--namespace", namespace, fmt.Sprintf("--from-file=%s=%s", name, configFileName)}
-	log.Debugf("Exec %s, %v", binary, args)
-
-	env := os.Environ()
-
-	// return syscall.Exec(cmd, args, env)
-	// 2. execute the command
-	cmd := exec.Command(binary, args...)
-	cmd.Env = env
-	return cmd.CombinedOutput()
-}
-
-func GetCrdNames() ([]string, error) {
-	crdNames := []string{}
-	args := []string{"get", "crds"}
-	out, err := kubectl(args)
+	crds, err := ri.List(context.TODO(), metav1.ListOptions{})
 	if err != nil {
-		if strings.Contains(err.Error(), "No resources found.") {
-			return crdNames, nil
-		}
 		return nil, err
 	}
-	for _, line := range strings.Split(string(out), "\n") {
-		item := strings.Trim(line, " ")
-		if item == "" {
-			continue
-		}
-		if strings.Contains(item, "No resources found.") {
-			continue
-		}
-		if strings.Contains(item, "CREATED AT") {
-			continue
-		}
-		crdNames = append(crdNames, strings.Trim(strings.Split(item, " ")[0], " "))
+	crdNames := []string{}
+	for _, crd := range crds.Items {
+		crdNames = append(crdNames, crd.GetName())
 	}
 	return crdNames, nil
 }
@@ -385,49 +445,47 @@ func PatchOwnerReferenceWithAppInfoFile(name, trainingType, appInfoFile, namespa
 		return nil
 	}
 
-	binary, err := exec.LookPath(kubectlCmd[0])
-	if err != nil {
-		return fmt.Errorf("failed to locate kubectl binary: %v", err)
-	}
 	errs := []string{}
 
 	// get training job
-	args := []string{binary, "get", trainingType, name, "--namespace", namespace, "-o json"}
-	cmd := exec.Command("bash", "-c", strings.Join(args, " "))
-	out, err := cmd.CombinedOutput()
+	ri, err := resourceInterfaceFor(fmt.Sprintf("%v.kubeflow.org", trainingType), namespace)
+	if err != nil {
+		return err
+	}
+	obj, err := ri.Get(context.TODO(), name, metav1.GetOptions{})
 	if err != nil {
 		return fmt.Errorf("failed to get training job: %v", err)
 	}
 
-	obj := &unstructured.Unstructured{}
-	err = json.Unmarshal(out, obj)
+	patch, err := json.Marshal([]map[string]interface{}{
+		{
+			"op":   "add",
+			"path": "/metadata/ownerReferences",
+			"value": []metav1.OwnerReference{
+				*metav1.NewControllerRef(obj, obj.GroupVersionKind()),
+			},
+		},
+	})
 	if err != nil {
-		return fmt.Errorf("failed to unmarshal training job: %v", err)
+		return err
 	}
 
-	patch := fmt.Sprintf(`-p='[{"op": "add", "path": "/metadata/ownerReferences", `+
-		`"value": [{"apiVersion": "%s","kind": "%s","name": "%s","uid": "%s","blockOwnerDeletion": true,"controller": true}]}]'`,
-		obj.GetAPIVersion(), obj.GetKind(), name, obj.GetUID())
-
 	// add configmap
 	configmapName := fmt.Sprintf("%v-%v", name, trainingType)
 	resources = append(resources, "configmap/"+configmapName)
 
 	for _, resource := range resources {
 		// skip tfjob / pytorchjob.
-		if resource == "tfjob.kubeflow.org/"+name ||
+		if strings.TrimSpace(resource) == "" ||
+			resource == "tfjob.kubeflow.org/"+name ||
 			resource == "pytorchjob.kubeflow.org/"+name {
 			continue
 		}
 
 		// patch ownerReferences
-		args := []string{binary, "patch", resource, "--namespace", namespace, "--type=json", patch}
-		log.Debugf("Exec bash -c %v", args)
-		cmd := exec.Command("bash", "-c", strings.Join(args, " "))
-		out, err := cmd.CombinedOutput()
-		log.Debugf("%s", string(out))
+		err := patchAppInfoResource(resource, namespace, patch)
 		if err != nil {
-			errs = append(errs, string(out))
+			errs = append(errs, fmt.Sprintf("failed to patch %v: %v", resource, err))
 		}
 	}
 
@@ -438,3 +496,16 @@ func PatchOwnerReferenceWithAppInfoFile(name, trainingType, appInfoFile, namespa
 
 	return nil
 }
+
+func patchAppInfoResource(resource, namespace string, patch []byte) error {
+	kind, name, err := parseAppInfoResource(resource)
+	if err != nil {
+		return err
+	}
+	ri, err := resourceInterfaceFor(kind, namespace)
+	if err != nil {
+		return err
+	}
+	_, err = ri.Patch(context.TODO(), name, k8stypes.JSONPatchType, patch, metav1.PatchOptions{FieldManager: fieldManager})
+	return err
+}
@@ -0,0 +1,165 @@
+// Copyright 2024 The Kubeflow Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//       http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubectl
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+)
+
+var testManifests = `
+---
+# Source: tfjob/templates/configmap.yaml
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: tf-test-config
+data:
+  key: value
+---
+# Source: tfjob/templates/tfjob.yaml
+apiVersion: kubeflow.org/v1
+kind: TFJob
+metadata:
+  name: tf-test
+  labels:
+    app: tfjob
+spec:
+  tfReplicaSpecs: {}
+`
+
+var (
+	configMapGVR = schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	tfjobGVR     = schema.GroupVersionResource{Group: "kubeflow.org", Version: "v1", Resource: "tfjobs"}
+)
+
+func setFakeClients(t *testing.T) *dynamicfake.FakeDynamicClient {
+	mapper := meta.NewDefaultRESTMapper(nil)
+	mapper.Add(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}, meta.RESTScopeNamespace)
+	mapper.Add(schema.GroupVersionKind{Group: "kubeflow.org", Version: "v1", Kind: "TFJob"}, meta.RESTScopeNamespace)
+	dc := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+	SetClients(kubefake.NewSimpleClientset(), dc, mapper)
+	return dc
+}
+
+func writeTestFile(t *testing.T, name, content string) string {
+	fileName := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(fileName, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return fileName
+}
+
+func TestInstallAndUninstallApps(t *testing.T) {
+	dc := setFakeClients(t)
+	template := writeTestFile(t, "tf-test.yaml", testManifests)
+
+	appInfoFile, err := SaveAppInfo(template, "default")
+	if err != nil {
+		t.Fatalf("failed to save app info: %v", err)
+	}
+	defer os.Remove(appInfoFile)
+	appInfo, err := os.ReadFile(appInfoFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedAppInfo := "configmap/tf-test-config\ntfjob.kubeflow.org/tf-test"
+	if string(appInfo) != expectedAppInfo {
+		t.Fatalf("expected app info %q, got %q", expectedAppInfo, string(appInfo))
+	}
+
+	output, err := InstallApps(template, "default", true)
+	if err != nil {
+		t.Fatalf("failed to dry run installing apps: %v", err)
+	}
+	expectedOutput := "configmap/tf-test-config created (server dry run)\ntfjob.kubeflow.org/tf-test created (server dry run)\n"
+	if output != expectedOutput {
+		t.Fatalf("expected output %q, got %q", expectedOutput, output)
+	}
+
+	if _, err := InstallApps(template, "default", false); err != nil {
+		t.Fatalf("failed to install apps: %v", err)
+	}
+	job, err := dc.Resource(tfjobGVR).Namespace("default").Get(context.TODO(), "tf-test", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get the tfjob: %v", err)
+	}
+	if job.GetLabels()["app"] != "tfjob" {
+		t.Fatalf("unexpected labels of tfjob: %v", job.GetLabels())
+	}
+	output, err = InstallApps(template, "default", false)
+	if err != nil {
+		t.Fatalf("failed to install apps again: %v", err)
+	}
+	expectedOutput = "configmap/tf-test-config configured\ntfjob.kubeflow.org/tf-test configured\n"
+	if output != expectedOutput {
+		t.Fatalf("expected output %q, got %q", expectedOutput, output)
+	}
+
+	if err := UninstallAppsWithAppInfoFile(appInfoFile, "default"); err != nil {
+		t.Fatalf("failed to uninstall apps: %v", err)
+	}
+	if _, err := dc.Resource(configMapGVR).Namespace("default").Get(context.TODO(), "tf-test-config", metav1.GetOptions{}); !k8serrors.IsNotFound(err) {
+		t.Fatalf("expected the configmap is deleted, got %v", err)
+	}
+	if _, err := dc.Resource(tfjobGVR).Namespace("default").Get(context.TODO(), "tf-test", metav1.GetOptions{}); !k8serrors.IsNotFound(err) {
+		t.Fatalf("expected the tfjob is deleted, got %v", err)
+	}
+	// the resources which are not found should be ignored
+	if err := UninstallAppsWithAppInfoFile(appInfoFile, "default"); err != nil {
+		t.Fatalf("failed to uninstall apps again: %v", err)
+	}
+}
+
+func TestAppConfigMap(t *testing.T) {
+	setFakeClients(t)
+	values := writeTestFile(t, "values", "image: tensorflow\n")
+	appInfo := writeTestFile(t, "app", "tfjob.kubeflow.org/tf-test")
+
+	if err := CreateAppConfigmap("tf-test", "tfjob", "default", values, appInfo, "tfjob", "0.1.0"); err != nil {
+		t.Fatalf("failed to create configmap: %v", err)
+	}
+	if !CheckAppConfigMap("tf-test-tfjob", "default") {
+		t.Fatalf("expected configmap tf-test-tfjob is found")
+	}
+	fileName, err := SaveAppConfigMapToFile("tf-test-tfjob", "app", "default")
+	if err != nil {
+		t.Fatalf("failed to save configmap to file: %v", err)
+	}
+	defer os.Remove(fileName)
+	content, err := os.ReadFile(fileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "tfjob.kubeflow.org/tf-test" {
+		t.Fatalf("unexpected app info %q", string(content))
+	}
+	if err := DeleteAppConfigMap("tf-test-tfjob", "default"); err != nil {
+		t.Fatalf("failed to delete configmap: %v", err)
+	}
+	if CheckAppConfigMap("tf-test-tfjob", "default") {
+		t.Fatalf("expected configmap tf-test-tfjob is deleted")
+	}
+}
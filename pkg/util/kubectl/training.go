@@ -1,8 +1,6 @@
 package kubectl
 
 import (
-	"fmt"
-
 	"github.com/kubeflow/arena/pkg/apis/training"
 	"github.com/kubeflow/arena/pkg/apis/types"
 )
@@ -11,105 +9,73 @@ func AddTrainingJobLabel(job *training.Job, key string, value string) error {
 	switch job.Type() {
 	case types.TFTrainingJob:
 		args := job.Args().(*types.SubmitTFJobArgs)
-		_, err := kubectl([]string{
-			"label",
-			"-n",
-			args.Namespace,
-			"tfjobs.kubeflow.org",
-			args.Name,
-			fmt.Sprintf("%s=%s", key, value),
-		})
+		err := labelResource("tfjobs.kubeflow.org", args.Name, args.Namespace, key, value)
 		if err != nil {
 			return err
 		}
 	case types.PytorchTrainingJob:
 		args := job.Args().(*types.SubmitPyTorchJobArgs)
-		_, err := kubectl([]string{
-			"label",
-			"-n",
-			args.Namespace,
-			"pytorchjobs.kubeflow.org",
-			args.Name,
-			fmt.Sprintf("%s=%s", key, value),
-		})
+		err := labelResource("pytorchjobs.kubeflow.org", args.Name, args.Namespace, key, value)
 		if err != nil {
 			return err
 		}
 	case types.MPITrainingJob:
 		args := job.Args().(*types.SubmitMPIJobArgs)
-		_, err := kubectl([]string{
-			"label",
-			"-n",
-			args.Namespace,
-			"mpijobs.kubeflow.org",
-			args.Name,
-			fmt.Sprintf("%s=%s", key, value),
-		})
+		err := labelResource("mpijobs.kubeflow.org", args.Name, args.Namespace, key, value)
 		if err != nil {
 			return err
 		}
 	case types.HorovodTrainingJob:
 		args := job.Args().(*types.SubmitHorovodJobArgs)
-		_, err := kubectl([]string{
-			"label",
-			"-n",
-			args.Namespace,
-			"mpijobs.kubeflow.org",
-			args.Name,
-			fmt.Sprintf("%s=%s", key, value),
-		})
+		err := labelResource("mpijobs.kubeflow.org", args.Name, args.Namespace, key, value)
 		if err != nil {
 			return err
 		}
 	case types.VolcanoTrainingJob:
 		args := job.Args().(*types.SubmitVolcanoJobArgs)
-		_, err := kubectl([]string{
-			"label",
-			"-n",
-			args.Namespace,
-			"job.batch.volcano.sh",
-			args.Name,
-			fmt.Sprintf("%s=%s", key, value),
-		})
+		err := labelResource("job.batch.volcano.sh", args.Name, args.Namespace, key, value)
 		if err != nil {
 			return err
 		}
 	case types.ETTrainingJob:
 		args := job.Args().(*types.SubmitETJobArgs)
-		_, err := kubectl([]string{
-			"label",
-			"-n",
-			args.Namespace,
-			"trainingjobs.kai.alibabacloud.com",
-			args.Name,
-			fmt.Sprintf("%s=%s", key, value),
-		})
+		err := labelResource("trainingjobs.kai.alibabacloud.com", args.Name, args.Namespace, key, value)
 		if err != nil {
 			return err
 		}
 	case types.SparkTrainingJob:
 		args := job.Args().(*types.SubmitSparkJobArgs)
-		_, err := kubectl([]string{
-			"label",
-			"-n",
-			args.Namespace,
-			"sparkapplications.sparkoperator.k8s.io",
-			args.Name,
-			fmt.Sprintf("%s=%s", key, value),
-		})
+		err := labelResource("sparkapplications.sparkoperator.k8s.io", args.Name, args.Namespace, key, value)
 		if err != nil {
 			return err
 		}
 	case types.DeepSpeedTrainingJob:
 		args := job.Args().(*types.SubmitDeepSpeedJobArgs)
-		_, err := kubectl([]string{
-			"label",
-			"-n",
-			args.Namespace,
-			"trainingjobs.kai.alibabacloud.com",
-			args.Name,
-			fmt.Sprintf("%s=%s", key, value),
-		})
+		err := labelResource("trainingjobs.kai.alibabacloud.com", args.Name, args.Namespace, key, value)
+		if err != nil {
+			return err
+		}
+	case types.RayTrainingJob:
+		args := job.Args().(*types.SubmitRayJobArgs)
+		err := labelResource("rayjobs.ray.io", args.Name, args.Namespace, key, value)
+		if err != nil {
+			return err
+		}
+	case types.XGBoostTrainingJob:
+		args := job.Args().(*types.SubmitXGBoostJobArgs)
+		err := labelResource("xgboostjobs.kubeflow.org", args.Name, args.Namespace, key, value)
+		if err != nil {
+			return err
+		}
+	case types.PaddleTrainingJob:
+		args := job.Args().(*types.SubmitPaddleJobArgs)
+		err := labelResource("paddlejobs.kubeflow.org", args.Name, args.Namespace, key, value)
+		if err != nil {
+			return err
+		}
+	case types.JAXTrainingJob:
+		args := job.Args().(*types.SubmitJAXJobArgs)
+		err := labelResource("jaxjobs.kubeflow.org", args.Name, args.Namespace, key, value)
 		if err != nil {
 			return err
 		}
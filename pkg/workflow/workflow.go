@@ -3,6 +3,7 @@ package workflow
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/kubeflow/arena/pkg/util/helm"
 	"github.com/kubeflow/arena/pkg/util/kubeclient"
@@ -75,7 +76,7 @@ func SubmitOps(name string, trainingType string, namespace string, values interf
 		log.Debugf("Failed to UninstallAppsWithAppInfoFile due to %v", err)
 	}
 
-	result, err := kubectl.InstallApps(template, namespace)
+	result, err := kubectl.InstallApps(template, namespace, false)
 	fmt.Printf("%s", result)
 	if err != nil {
 		// clean configmap
@@ -109,13 +110,16 @@ func SubmitOps(name string, trainingType string, namespace string, values interf
 *	Submit training job
 **/
 
-func SubmitJob(name string, trainingType string, namespace string, values interface{}, chart string, options ...string) error {
-	_, err := kubeclient.GetConfigMap(namespace, fmt.Sprintf("%v-%v", name, trainingType))
-	if err == nil {
-		return fmt.Errorf("the job configmap %v-%v is already exist, please delete it first.", name, trainingType)
-	}
-	if !k8serrors.IsNotFound(err) {
-		return err
+func SubmitJob(name string, trainingType string, namespace string, values interface{}, chart string, dryRun types.DryRunStrategy, options ...string) error {
+	// client dry run doesn't touch the cluster
+	if dryRun != types.DryRunClient {
+		_, err := kubeclient.GetConfigMap(namespace, fmt.Sprintf("%v-%v", name, trainingType))
+		if err == nil {
+			return fmt.Errorf("the job configmap %v-%v is already exist, please delete it first.", name, trainingType)
+		}
+		if !k8serrors.IsNotFound(err) {
+			return err
+		}
 	}
 	// 1. Generate value file
 	valueFileName, err := helm.GenerateValueFile(values)
@@ -129,6 +133,17 @@ func SubmitJob(name string, trainingType string, namespace string, values interf
 		return err
 	}
 
+	switch dryRun {
+	case types.DryRunClient:
+		defer cleanupFiles(valueFileName, template)
+		return printDryRunManifests(valueFileName, template)
+	case types.DryRunServer:
+		defer cleanupFiles(valueFileName, template)
+		result, err := kubectl.InstallApps(template, namespace, true)
+		fmt.Printf("%s", result)
+		return err
+	}
+
 	// 3. Generate AppInfo file
 	appInfoFileName, err := kubectl.SaveAppInfo(template, namespace)
 	if err != nil {
@@ -158,7 +173,7 @@ func SubmitJob(name string, trainingType string, namespace string, values interf
 		log.Debugf("Failed to UninstallAppsWithAppInfoFile due to %v", err)
 	}
 
-	result, err := kubectl.InstallApps(template, namespace)
+	result, err := kubectl.InstallApps(template, namespace, false)
 	fmt.Printf("%s", result)
 	if err != nil {
 		// clean configmap
@@ -202,3 +217,32 @@ func SubmitJob(name string, trainingType string, namespace string, values interf
 
 	return nil
 }
+
+// printDryRunManifests prints the values file and the manifests rendered by the chart
+func printDryRunManifests(valueFileName, template string) error {
+	values, err := os.ReadFile(valueFileName)
+	if err != nil {
+		return err
+	}
+	manifests, err := os.ReadFile(template)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("# Source: values.yaml\n")
+	for _, line := range strings.Split(strings.TrimSpace(string(values)), "\n") {
+		fmt.Printf("# %s\n", line)
+	}
+	fmt.Printf("%s", manifests)
+	return nil
+}
+
+func cleanupFiles(files ...string) {
+	if log.GetLevel() == log.DebugLevel {
+		return
+	}
+	for _, file := range files {
+		if err := os.Remove(file); err != nil {
+			log.Warnf("Failed to delete %s due to %v", file, err)
+		}
+	}
+}